@@ -6,6 +6,7 @@ import (
 	"os"
 
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -39,6 +40,7 @@ type Factory struct {
 	gasPrices          sdk.DecCoins
 	signMode           signing.SignMode
 	simulateAndExecute bool
+	isUpgraded         func(name string) bool
 }
 
 // NewFactoryCLI creates a new Factory.
@@ -225,6 +227,41 @@ func (f Factory) WithSignMode(mode signing.SignMode) Factory {
 	return f
 }
 
+// UpgradeChecker returns the upgrade checker of the signer data, it selects the
+// EIP712 domain version in SIGN_MODE_EIP_712.
+func (f Factory) UpgradeChecker() func(name string) bool {
+	return f.isUpgraded
+}
+
+// WithUpgradeChecker returns a copy of the Factory with an updated upgrade checker.
+func (f Factory) WithUpgradeChecker(isUpgraded func(name string) bool) Factory {
+	f.isUpgraded = isUpgraded
+	return f
+}
+
+// PrepareUpgradeChecker returns a copy of the Factory with the upgrade checker
+// resolved from the EIP712 domain version of the node, unless the upgrade checker
+// is already set or the tx is not signed in SIGN_MODE_EIP_712.
+func (f Factory) PrepareUpgradeChecker(clientCtx gogogrpc.ClientConn) (Factory, error) {
+	if f.isUpgraded != nil || f.txConfig == nil {
+		return f, nil
+	}
+
+	signMode := f.signMode
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		signMode = f.txConfig.SignModeHandler().DefaultMode()
+	}
+	if signMode != signing.SignMode_SIGN_MODE_EIP_712 {
+		return f, nil
+	}
+
+	isUpgraded, err := QueryEip712UpgradeChecker(clientCtx, f.txConfig)
+	if err != nil {
+		return f, err
+	}
+	return f.WithUpgradeChecker(isUpgraded), nil
+}
+
 // WithTimeoutHeight returns a copy of the Factory with an updated timeout height.
 func (f Factory) WithTimeoutHeight(height uint64) Factory {
 	f.timeoutHeight = height
@@ -389,8 +426,8 @@ func (f Factory) getSimPK() (cryptotypes.PubKey, error) {
 
 // Prepare ensures the account defined by ctx.GetFromAddress() exists and
 // if the account number and/or the account sequence number are zero (not set),
-// they will be queried for and set on the provided Factory. A new Factory with
-// the updated fields will be returned.
+// they will be queried for and set on the provided Factory, so will the upgrade
+// checker of SIGN_MODE_EIP_712. A new Factory with the updated fields will be returned.
 func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 	fc := f
 
@@ -416,5 +453,5 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 		}
 	}
 
-	return fc, nil
+	return fc.PrepareUpgradeChecker(clientCtx)
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 )
 
 // GenerateOrBroadcastTxCLI will either generate and print and unsigned transaction
@@ -158,8 +159,34 @@ func CalculateGas(
 	return simRes, uint64(txf.GasAdjustment() * float64(simRes.GasInfo.GasUsed)), nil
 }
 
+// eip712DomainConfig is implemented by the tx configs supporting SIGN_MODE_EIP_712.
+type eip712DomainConfig interface {
+	Eip712Domain() authtx.Eip712Domain
+}
+
+// QueryEip712UpgradeChecker queries the EIP712 domain version the node verifies
+// the signatures with, and returns the upgrade checker of the signer data under
+// which the domain of the tx config has that version. A nil upgrade checker is
+// returned if the tx config doesn't support SIGN_MODE_EIP_712.
+func QueryEip712UpgradeChecker(clientCtx gogogrpc.ClientConn, txConfig client.TxConfig) (func(name string) bool, error) {
+	domainConfig, ok := txConfig.(eip712DomainConfig)
+	if !ok {
+		return nil, nil
+	}
+
+	txSvcClient := tx.NewServiceClient(clientCtx)
+	res, err := txSvcClient.GetEip712Domain(context.Background(), &tx.GetEip712DomainRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return domainConfig.Eip712Domain().UpgradeCheckerForVersion(res.Version)
+}
+
 // SignWithPrivKey signs a given tx with the given private key, and returns the
-// corresponding SignatureV2 if the signing is successful.
+// corresponding SignatureV2 if the signing is successful. The IsUpgraded of the
+// signer data selects the EIP712 domain version in SIGN_MODE_EIP_712, it can be
+// resolved with QueryEip712UpgradeChecker.
 func SignWithPrivKey(
 	signMode signing.SignMode, signerData authsigning.SignerData,
 	txBuilder client.TxBuilder, priv cryptotypes.PrivKey, txConfig client.TxConfig,
@@ -266,6 +293,7 @@ func Sign(txf Factory, name string, txBuilder client.TxBuilder, overwriteSig boo
 		Sequence:      txf.sequence,
 		PubKey:        pubKey,
 		Address:       sdk.AccAddress(pubKey.Address()).String(),
+		IsUpgraded:    txf.isUpgraded,
 	}
 
 	// For SIGN_MODE_DIRECT, calling SetSignatures calls setSignerInfos on
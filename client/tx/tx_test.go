@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	ethHd "github.com/evmos/ethermint/crypto/hd"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 
@@ -311,6 +312,79 @@ func TestSign(t *testing.T) {
 	}
 }
 
+// eip712DomainContext is a mock client.Context to return an arbitrary EIP712 domain version,
+// used to unit test the signing after the domain upgrades.
+type eip712DomainContext struct {
+	version string
+}
+
+func (m eip712DomainContext) Invoke(grpcCtx gocontext.Context, method string, req, reply interface{}, opts ...grpc.CallOption) (err error) {
+	*(reply.(*txtypes.GetEip712DomainResponse)) = txtypes.GetEip712DomainResponse{Version: m.version}
+	return nil
+}
+
+func (eip712DomainContext) NewStream(gocontext.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	panic("not implemented")
+}
+
+func TestSignEip712AfterUpgrade(t *testing.T) {
+	requireT := require.New(t)
+	encCfg := simapp.MakeTestEncodingConfig()
+	protoCodec := codec.NewProtoCodec(encCfg.InterfaceRegistry)
+	domain := authtx.DefaultEip712Domain()
+	domain.VersionUpgrades = []authtx.Eip712VersionUpgrade{{UpgradeName: "v2", Version: "2.0.0"}}
+	txConfig := authtx.NewTxConfig(protoCodec, authtx.DefaultSignModes, authtx.WithEip712Domain(domain))
+	kb, err := keyring.New(t.Name(), "test", t.TempDir(), nil, encCfg.Codec, ethHd.EthSecp256k1Option())
+	requireT.NoError(err)
+
+	from := "test_key"
+	k, _, err := kb.NewMnemonic(from, keyring.English, hd.CreateHDPath(60, 0, 0).String(), keyring.DefaultBIP39Passphrase, ethHd.EthSecp256k1)
+	requireT.NoError(err)
+	pubKey, err := k.GetPubKey()
+	requireT.NoError(err)
+	addr, err := k.GetAddress()
+	requireT.NoError(err)
+
+	txf := tx.Factory{}.
+		WithTxConfig(txConfig).
+		WithKeybase(kb).
+		WithAccountNumber(50).
+		WithSequence(23).
+		WithChainID("greenfield_9000-1").
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_EIP_712)
+
+	// the upgrade checker is resolved from the domain version of the node
+	txf, err = txf.PrepareUpgradeChecker(eip712DomainContext{version: "2.0.0"})
+	requireT.NoError(err)
+	requireT.NotNil(txf.UpgradeChecker())
+	requireT.True(txf.UpgradeChecker()("v2"))
+
+	_, err = txf.WithUpgradeChecker(nil).PrepareUpgradeChecker(eip712DomainContext{version: "3.0.0"})
+	requireT.Error(err)
+
+	txb, err := txf.BuildUnsignedTx(banktypes.NewMsgSend(addr, sdk.AccAddress("to"), nil))
+	requireT.NoError(err)
+	requireT.NoError(tx.Sign(txf, from, txb, true))
+
+	sigs, err := txb.GetTx().GetSignaturesV2()
+	requireT.NoError(err)
+	requireT.Len(sigs, 1)
+
+	// the signature verifies with the upgraded domain only
+	signerData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       txf.ChainID(),
+		AccountNumber: txf.AccountNumber(),
+		Sequence:      txf.Sequence(),
+		PubKey:        pubKey,
+		IsUpgraded:    func(name string) bool { return name == "v2" },
+	}
+	requireT.NoError(signing.VerifySignature(pubKey, signerData, sigs[0].Data, txConfig.SignModeHandler(), txb.GetTx()))
+
+	signerData.IsUpgraded = nil
+	requireT.Error(signing.VerifySignature(pubKey, signerData, sigs[0].Data, txConfig.SignModeHandler(), txb.GetTx()))
+}
+
 func testSigners(require *require.Assertions, tr signing.Tx, pks ...cryptotypes.PubKey) []signingtypes.SignatureV2 {
 	sigs, err := tr.GetSignaturesV2()
 	require.Len(sigs, len(pks))
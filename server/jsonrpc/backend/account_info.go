@@ -1,12 +1,16 @@
 package backend
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	rpctypes "github.com/evmos/ethermint/rpc/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -42,3 +46,45 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 
 	return (*hexutil.Big)(balanceRes.Balance.Amount.BigInt()), nil
 }
+
+// GetTransactionCount returns the sequence of the account at the block, the sequence of an account
+// which does not exist yet is 0.
+func (b *Backend) GetTransactionCount(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Uint64, error) {
+	blockNum, err := b.BlockNumberFromTendermint(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// the latest and pending blocks are queried at the latest height
+	height := blockNum.Int64()
+	if height < 0 {
+		height = 0
+	}
+
+	account, err := b.queryAccount(rpctypes.ContextWithHeight(height), address.String())
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			n := hexutil.Uint64(0)
+			return &n, nil
+		}
+		return nil, err
+	}
+
+	n := hexutil.Uint64(account.GetSequence())
+	return &n, nil
+}
+
+// queryAccount returns the account of the address through the x/auth gRPC query
+func (b *Backend) queryAccount(ctx context.Context, address string) (authtypes.AccountI, error) {
+	queryClient := authtypes.NewQueryClient(b.clientCtx)
+	res, err := queryClient.Account(ctx, &authtypes.QueryAccountRequest{Address: address})
+	if err != nil {
+		return nil, err
+	}
+
+	var account authtypes.AccountI
+	if err := b.clientCtx.InterfaceRegistry.UnpackAny(res.Account, &account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
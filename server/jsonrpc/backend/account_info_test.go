@@ -0,0 +1,42 @@
+package backend_test
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+)
+
+func (s *IntegrationTestSuite) TestGetTransactionCount() {
+	val := s.network.Validators[0]
+	_, _, addr := testdata.KeyEthSecp256k1TestPubAddr()
+	latestBlock := rpctypes.EthLatestBlockNumber
+	latest := rpctypes.BlockNumberOrHash{BlockNumber: &latestBlock}
+
+	// the nonce of an account which does not exist yet is 0
+	nonce, err := s.backend.GetTransactionCount(common.BytesToAddress(addr), latest)
+	s.Require().NoError(err)
+	s.Require().Equal(hexutil.Uint64(0), *nonce)
+
+	// the block hash is resolved to its height
+	resBlock, err := val.RPCClient.Block(context.Background(), nil)
+	s.Require().NoError(err)
+	blockHash := common.BytesToHash(resBlock.Block.Hash())
+	byHash := rpctypes.BlockNumberOrHash{BlockHash: &blockHash}
+	blockNumber := rpctypes.BlockNumber(resBlock.Block.Height)
+	byNumber := rpctypes.BlockNumberOrHash{BlockNumber: &blockNumber}
+
+	nonceByHash, err := s.backend.GetTransactionCount(common.BytesToAddress(val.Address), byHash)
+	s.Require().NoError(err)
+	nonceByNumber, err := s.backend.GetTransactionCount(common.BytesToAddress(val.Address), byNumber)
+	s.Require().NoError(err)
+	s.Require().Equal(*nonceByNumber, *nonceByHash)
+
+	// the unknown block hash is rejected
+	unknownHash := common.BytesToHash([]byte("unknown"))
+	_, err = s.backend.GetTransactionCount(common.BytesToAddress(val.Address), rpctypes.BlockNumberOrHash{BlockHash: &unknownHash})
+	s.Require().Error(err)
+}
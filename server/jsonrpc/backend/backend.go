@@ -2,6 +2,7 @@ package backend
 
 import (
 	"context"
+	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -25,6 +26,12 @@ type EVMBackend interface {
 	GetBlockByNumber(blockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error)
 	GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error)
 	ChainID() (*hexutil.Big, error)
+	GetEip712TypedData(txBytes hexutil.Bytes, accountNumber, sequence hexutil.Uint64) (json.RawMessage, error)
+	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransaction, error)
+	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	GetTransactionCount(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Uint64, error)
+	EstimateGas(txBytes hexutil.Bytes) (hexutil.Uint64, error)
 }
 
 var _ EVMBackend = (*Backend)(nil)
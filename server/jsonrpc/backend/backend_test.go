@@ -0,0 +1,162 @@
+package backend_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/libs/log"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/server/jsonrpc/backend"
+	"github.com/cosmos/cosmos-sdk/testutil/network"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     network.Config
+	network *network.Network
+	backend *backend.Backend
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	s.T().Log("setting up integration test suite")
+
+	cfg := network.DefaultConfig()
+	cfg.NumValidators = 1
+	s.cfg = cfg
+
+	var err error
+	s.network, err = network.New(s.T(), s.T().TempDir(), s.cfg)
+	s.Require().NoError(err)
+
+	_, err = s.network.WaitForHeight(1)
+	s.Require().NoError(err)
+
+	s.backend = newBackend(s.network.Validators[0].ClientCtx)
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) TestTransactionByHashAndReceipt() {
+	val := s.network.Validators[0]
+	latestBlock := rpctypes.EthLatestBlockNumber
+	latest := rpctypes.BlockNumberOrHash{BlockNumber: &latestBlock}
+
+	nonce, err := s.backend.GetTransactionCount(common.BytesToAddress(val.Address), latest)
+	s.Require().NoError(err)
+
+	txBuilder := s.mkSignedTxBuilder()
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	hash, err := s.backend.SendRawTransaction(txBytes)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	// the nonce is the sequence of the signer
+	rpcTx, err := s.backend.GetTransactionByHash(hash)
+	s.Require().NoError(err)
+	s.Require().NotNil(rpcTx)
+	s.Require().Equal(hash, rpcTx.Hash)
+	s.Require().Equal(*nonce, rpcTx.Nonce)
+	s.Require().Equal(common.BytesToAddress(val.Address), rpcTx.From)
+	s.Require().Equal(hexutil.Uint64(txBuilder.GetTx().GetGas()), rpcTx.Gas)
+	s.Require().Equal(hexutil.Bytes(txBytes), rpcTx.Input)
+	s.Require().NotNil(rpcTx.BlockNumber)
+	s.Require().NotNil(rpcTx.R)
+	s.Require().NotNil(rpcTx.S)
+	s.Require().NotNil(rpcTx.V)
+
+	newNonce, err := s.backend.GetTransactionCount(common.BytesToAddress(val.Address), latest)
+	s.Require().NoError(err)
+	s.Require().Equal(*nonce+1, *newNonce)
+
+	receipt, err := s.backend.GetTransactionReceipt(hash)
+	s.Require().NoError(err)
+	s.Require().NotNil(receipt)
+	s.Require().Equal(hash, receipt["transactionHash"])
+	s.Require().Equal(hexutil.Uint(ethtypes.ReceiptStatusSuccessful), receipt["status"])
+	s.Require().Equal(hexutil.Uint64(rpcTx.BlockNumber.ToInt().Uint64()), receipt["blockNumber"])
+	s.Require().Equal(common.BytesToAddress(val.Address), receipt["from"])
+	s.Require().NotZero(receipt["gasUsed"])
+}
+
+func (s *IntegrationTestSuite) TestTransactionNotFound() {
+	hash := common.BytesToHash([]byte("unknown"))
+
+	rpcTx, err := s.backend.GetTransactionByHash(hash)
+	s.Require().NoError(err)
+	s.Require().Nil(rpcTx)
+
+	receipt, err := s.backend.GetTransactionReceipt(hash)
+	s.Require().NoError(err)
+	s.Require().Nil(receipt)
+
+	// the errors other than not found are returned
+	rpcClient, err := rpchttp.New("tcp://127.0.0.1:1", "/websocket")
+	s.Require().NoError(err)
+	unreachableBackend := newBackend(s.network.Validators[0].ClientCtx.WithClient(rpcClient))
+
+	_, err = unreachableBackend.GetTransactionByHash(hash)
+	s.Require().Error(err)
+	_, err = unreachableBackend.GetTransactionReceipt(hash)
+	s.Require().Error(err)
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
+
+func newBackend(clientCtx client.Context) *backend.Backend {
+	v := viper.New()
+	v.Set("telemetry.global-labels", []interface{}{})
+	return backend.NewBackend(v, log.NewNopLogger(), clientCtx)
+}
+
+func (s *IntegrationTestSuite) mkSignedTxBuilder() client.TxBuilder {
+	val := s.network.Validators[0]
+	txBuilder := s.mkUnsignedTxBuilder()
+
+	txFactory := clienttx.Factory{}.
+		WithChainID(val.ClientCtx.ChainID).
+		WithKeybase(val.ClientCtx.Keyring).
+		WithTxConfig(val.ClientCtx.TxConfig).
+		WithSignMode(signing.SignMode_SIGN_MODE_EIP_712)
+	s.Require().NoError(authclient.SignTx(txFactory, val.ClientCtx, val.Moniker, txBuilder, false, true))
+
+	return txBuilder
+}
+
+func (s *IntegrationTestSuite) mkUnsignedTxBuilder() client.TxBuilder {
+	val := s.network.Validators[0]
+
+	txBuilder := val.ClientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(
+		txBuilder.SetMsgs(&banktypes.MsgSend{
+			FromAddress: val.Address.String(),
+			ToAddress:   val.Address.String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin(s.cfg.BondDenom, 10)},
+		}),
+	)
+	txBuilder.SetFeeAmount(sdk.Coins{sdk.NewInt64Coin(s.cfg.BondDenom, 10)})
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	return txBuilder
+}
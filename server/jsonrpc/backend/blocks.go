@@ -103,7 +103,16 @@ func (b *Backend) BlockNumberFromTendermint(blockNrOrHash rpctypes.BlockNumberOr
 	case blockNrOrHash.BlockNumber != nil:
 		return *blockNrOrHash.BlockNumber, nil
 	default:
-		return rpctypes.EthEarliestBlockNumber, nil
+		hash := *blockNrOrHash.BlockHash
+		resBlock, err := b.clientCtx.Client.BlockByHash(b.ctx, hash.Bytes())
+		if err != nil {
+			b.logger.Debug("tendermint client failed to get block", "hash", hash.Hex(), "error", err.Error())
+			return rpctypes.EthEarliestBlockNumber, err
+		}
+		if resBlock.Block == nil {
+			return rpctypes.EthEarliestBlockNumber, fmt.Errorf("block not found for hash %s", hash.Hex())
+		}
+		return rpctypes.BlockNumber(resBlock.Block.Height), nil
 	}
 }
 
@@ -0,0 +1,153 @@
+package backend
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	gashubtypes "github.com/cosmos/cosmos-sdk/x/gashub/types"
+)
+
+// SendRawTransaction broadcasts a protobuf encoded cosmos tx signed with SIGN_MODE_EIP_712 in sync mode,
+// and returns the tendermint hash of the tx.
+func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	tx, err := b.clientCtx.TxConfig.TxDecoder()(data)
+	if err != nil {
+		b.logger.Error("transaction decoding failed", "error", err.Error())
+		return common.Hash{}, err
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return common.Hash{}, fmt.Errorf("expected a signed tx, got %T", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(sigs) == 0 {
+		return common.Hash{}, fmt.Errorf("tx is not signed")
+	}
+	for _, sig := range sigs {
+		sigData, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok || sigData.SignMode != signing.SignMode_SIGN_MODE_EIP_712 {
+			return common.Hash{}, fmt.Errorf("only the txs signed with %s are allowed over RPC", signing.SignMode_SIGN_MODE_EIP_712)
+		}
+	}
+
+	txHash := common.BytesToHash(tmtypes.Tx(data).Hash())
+
+	syncCtx := b.clientCtx.WithBroadcastMode(flags.BroadcastSync)
+	rsp, err := syncCtx.BroadcastTx(data)
+	if rsp != nil && rsp.Code != 0 {
+		err = errors.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
+	}
+	if err != nil {
+		b.logger.Error("failed to broadcast tx", "error", err.Error())
+		return txHash, err
+	}
+
+	return txHash, nil
+}
+
+// EstimateGas returns the gas used by simulating a protobuf encoded cosmos tx, the signatures of
+// an unsigned tx are filled with placeholders at the current sequences of its signers, or at sequence 0
+// for the signers having no account on chain yet.
+func (b *Backend) EstimateGas(txBytes hexutil.Bytes) (hexutil.Uint64, error) {
+	tx, err := b.clientCtx.TxConfig.TxDecoder()(txBytes)
+	if err != nil {
+		b.logger.Error("transaction decoding failed", "error", err.Error())
+		return 0, err
+	}
+
+	if !isFullySigned(tx) {
+		txBytes, err = b.buildSimTx(tx)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	queryClient := txtypes.NewServiceClient(b.clientCtx)
+	res, err := queryClient.Simulate(b.ctx, &txtypes.SimulateRequest{TxBytes: txBytes})
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(res.GasInfo.GasUsed), nil
+}
+
+// buildSimTx returns the encoded tx signed with placeholder signatures which is only valid for simulation,
+// the placeholder public key is used for the signers having no public key on chain.
+func (b *Backend) buildSimTx(tx sdk.Tx) ([]byte, error) {
+	txBuilder, err := b.clientCtx.TxConfig.WrapTxBuilder(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	signers := txBuilder.GetTx().GetSigners()
+	sigs := make([]signing.SignatureV2, len(signers))
+	for i, signer := range signers {
+		// the signer which has no account on chain yet signs at sequence 0
+		var (
+			pubKey   cryptotypes.PubKey
+			sequence uint64
+		)
+		account, err := b.queryAccount(b.ctx, signer.String())
+		switch {
+		case err == nil:
+			pubKey = account.GetPubKey()
+			sequence = account.GetSequence()
+		case status.Code(err) != codes.NotFound:
+			return nil, err
+		}
+
+		if pubKey == nil {
+			pubKey = &ethsecp256k1.PubKey{Key: make([]byte, ethsecp256k1.PubKeySize)}
+		}
+		sigs[i] = signing.SignatureV2{
+			PubKey: pubKey,
+			Data: &signing.SingleSignatureData{
+				SignMode:  signing.SignMode_SIGN_MODE_EIP_712,
+				Signature: make([]byte, gashubtypes.EthSecp256k1SigSize),
+			},
+			Sequence: sequence,
+		}
+	}
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
+		return nil, err
+	}
+
+	return b.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+}
+
+// isFullySigned returns true if the tx carries a signature for each of its signers
+func isFullySigned(tx sdk.Tx) bool {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return false
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil || len(sigs) == 0 || len(sigs) != len(sigTx.GetSigners()) {
+		return false
+	}
+	for _, sig := range sigs {
+		sigData, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok || len(sigData.Signature) == 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,55 @@
+package backend_test
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *IntegrationTestSuite) TestEstimateGas() {
+	val := s.network.Validators[0]
+
+	// the unsigned tx is simulated with placeholder signatures
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(s.mkUnsignedTxBuilder().GetTx())
+	s.Require().NoError(err)
+	unsignedGas, err := s.backend.EstimateGas(txBytes)
+	s.Require().NoError(err)
+	s.Require().NotZero(unsignedGas)
+
+	txBytes, err = val.ClientCtx.TxConfig.TxEncoder()(s.mkSignedTxBuilder().GetTx())
+	s.Require().NoError(err)
+	signedGas, err := s.backend.EstimateGas(txBytes)
+	s.Require().NoError(err)
+	s.Require().NotZero(signedGas)
+
+	_, err = s.backend.EstimateGas([]byte("invalid"))
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestEstimateGasUnknownSender() {
+	val := s.network.Validators[0]
+	_, _, addr := testdata.KeyEthSecp256k1TestPubAddr()
+
+	txBuilder := val.ClientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(
+		txBuilder.SetMsgs(&banktypes.MsgSend{
+			FromAddress: addr.String(),
+			ToAddress:   val.Address.String(),
+			Amount:      sdk.Coins{sdk.NewInt64Coin(s.cfg.BondDenom, 10)},
+		}),
+	)
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	// the sim tx of the sender without an account is built at sequence 0, so the simulation itself
+	// reports the unknown account instead of the account query
+	_, err = s.backend.EstimateGas(txBytes)
+	s.Require().Error(err)
+	s.Require().NotEqual(codes.NotFound, status.Code(err))
+	s.Require().Contains(err.Error(), sdkerrors.ErrUnknownAddress.Error())
+}
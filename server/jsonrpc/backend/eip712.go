@@ -0,0 +1,25 @@
+package backend
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// GetEip712TypedData returns the canonical EIP712 typed data of an unsigned tx which is
+// ready to be passed to eth_signTypedData_v4.
+func (b *Backend) GetEip712TypedData(txBytes hexutil.Bytes, accountNumber, sequence hexutil.Uint64) (json.RawMessage, error) {
+	queryClient := txtypes.NewServiceClient(b.clientCtx)
+	res, err := queryClient.GetEip712TypedData(b.ctx, &txtypes.GetEip712TypedDataRequest{
+		TxBytes:       txBytes,
+		AccountNumber: uint64(accountNumber),
+		Sequence:      uint64(sequence),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(res.TypedData), nil
+}
@@ -0,0 +1,35 @@
+package backend_test
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func (s *IntegrationTestSuite) TestGetEip712TypedData() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkUnsignedTxBuilder()
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	bz, err := s.backend.GetEip712TypedData(txBytes, 1, 2)
+	s.Require().NoError(err)
+
+	// the typed data hashes to the sign bytes verified by the chain
+	var typedData apitypes.TypedData
+	s.Require().NoError(json.Unmarshal(bz, &typedData))
+	sigHash, err := authtx.ComputeTypedDataHash(typedData)
+	s.Require().NoError(err)
+
+	signBytes, err := val.ClientCtx.TxConfig.SignModeHandler().GetSignBytes(signing.SignMode_SIGN_MODE_EIP_712, authsigning.SignerData{
+		ChainID:       val.ClientCtx.ChainID,
+		AccountNumber: 1,
+		Sequence:      2,
+	}, txBuilder.GetTx())
+	s.Require().NoError(err)
+	s.Require().Equal(signBytes, sigHash)
+}
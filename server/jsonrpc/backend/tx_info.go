@@ -0,0 +1,194 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetTransactionByHash returns the cosmos tx identified by the tendermint hash in the JSON-RPC
+// transaction format, nil is returned if the tx is not found.
+func (b *Backend) GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransaction, error) {
+	res, resBlock, tx, err := b.cosmosTxByHash(hash)
+	if errors.Is(err, errTxNotFound) {
+		b.logger.Debug("tx not found", "hash", hash.Hex())
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, fmt.Errorf("expected a fee tx, got %T", tx)
+	}
+
+	gasPrice, err := b.gasPrice(feeTx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Header.Hash())
+	txIndex := hexutil.Uint64(res.Index)
+	rpcTx := &rpctypes.RPCTransaction{
+		BlockHash:        &blockHash,
+		BlockNumber:      (*hexutil.Big)(big.NewInt(res.Height)),
+		From:             common.BytesToAddress(feeTx.FeePayer()),
+		Gas:              hexutil.Uint64(feeTx.GetGas()),
+		GasPrice:         (*hexutil.Big)(gasPrice),
+		Hash:             hash,
+		Input:            hexutil.Bytes(res.Tx),
+		TransactionIndex: &txIndex,
+		Value:            (*hexutil.Big)(big.NewInt(0)),
+		Type:             hexutil.Uint64(ethtypes.LegacyTxType),
+		ChainID:          (*hexutil.Big)(b.chainID),
+	}
+
+	// the nonce and the signature values are taken from the first signer, an EIP712 signature
+	// is encoded as R || S || V
+	if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+		sigs, err := sigTx.GetSignaturesV2()
+		if err != nil {
+			return nil, err
+		}
+		if len(sigs) > 0 {
+			rpcTx.Nonce = hexutil.Uint64(sigs[0].Sequence)
+			if sigData, ok := sigs[0].Data.(*signing.SingleSignatureData); ok && len(sigData.Signature) == 65 {
+				rpcTx.R = (*hexutil.Big)(new(big.Int).SetBytes(sigData.Signature[:32]))
+				rpcTx.S = (*hexutil.Big)(new(big.Int).SetBytes(sigData.Signature[32:64]))
+				rpcTx.V = (*hexutil.Big)(new(big.Int).SetBytes(sigData.Signature[64:]))
+			}
+		}
+	}
+
+	return rpcTx, nil
+}
+
+// GetTransactionReceipt returns the receipt of the cosmos tx identified by the tendermint hash,
+// nil is returned if the tx is not found.
+func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
+	res, resBlock, tx, err := b.cosmosTxByHash(hash)
+	if errors.Is(err, errTxNotFound) {
+		b.logger.Debug("tx not found", "hash", hash.Hex())
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, fmt.Errorf("expected a fee tx, got %T", tx)
+	}
+
+	gasPrice, err := b.gasPrice(feeTx)
+	if err != nil {
+		return nil, err
+	}
+
+	blockRes, err := b.clientCtx.Client.BlockResults(b.ctx, &res.Height)
+	if err != nil {
+		b.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, err
+	}
+
+	cumulativeGasUsed := uint64(0)
+	for _, txResult := range blockRes.TxsResults[0:res.Index] {
+		cumulativeGasUsed += uint64(txResult.GasUsed)
+	}
+	cumulativeGasUsed += uint64(res.TxResult.GasUsed)
+
+	status := hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
+	if res.TxResult.IsErr() {
+		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
+	}
+
+	receipt := map[string]interface{}{
+		// Consensus fields: These fields are defined by the Yellow Paper
+		"status":            status,
+		"cumulativeGasUsed": hexutil.Uint64(cumulativeGasUsed),
+		"logsBloom":         ethtypes.Bloom{},
+		"logs":              []*ethtypes.Log{},
+
+		// Implementation fields: These fields are added by geth when processing a transaction.
+		// They are stored in the chain database.
+		"transactionHash": hash,
+		"contractAddress": nil,
+		"gasUsed":         hexutil.Uint64(res.TxResult.GasUsed),
+
+		// Inclusion information: These fields provide information about the inclusion of the
+		// transaction corresponding to this receipt.
+		"blockHash":        common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
+		"blockNumber":      hexutil.Uint64(res.Height),
+		"transactionIndex": hexutil.Uint64(res.Index),
+
+		// sender and receiver addresses, a cosmos tx has no receiver
+		"from": common.BytesToAddress(feeTx.FeePayer()),
+		"to":   nil,
+
+		"type":              hexutil.Uint(ethtypes.LegacyTxType),
+		"effectiveGasPrice": (*hexutil.Big)(gasPrice),
+	}
+
+	return receipt, nil
+}
+
+// errTxNotFound is returned by cosmosTxByHash if the tx is not indexed by tendermint
+var errTxNotFound = errors.New("tx not found")
+
+// cosmosTxByHash returns the indexed result, the block and the decoded cosmos tx identified by
+// the tendermint hash, errTxNotFound is returned if the tx is not found.
+func (b *Backend) cosmosTxByHash(hash common.Hash) (*tmrpctypes.ResultTx, *tmrpctypes.ResultBlock, sdk.Tx, error) {
+	// tendermint reports a missing tx of the tx query with an untyped error only, the search by hash
+	// returns an empty result instead
+	resSearch, err := b.clientCtx.Client.TxSearch(b.ctx, fmt.Sprintf("tx.hash='%X'", hash.Bytes()), false, nil, nil, "")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(resSearch.Txs) == 0 {
+		return nil, nil, nil, errTxNotFound
+	}
+	res := resSearch.Txs[0]
+
+	resBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(res.Height))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if resBlock == nil {
+		return nil, nil, nil, fmt.Errorf("block not found for height %d", res.Height)
+	}
+
+	tx, err := b.clientCtx.TxConfig.TxDecoder()(res.Tx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to decode tx: %w", err)
+	}
+
+	return res, resBlock, tx, nil
+}
+
+// gasPrice returns the price per gas of the tx fee paid in the bond denom
+func (b *Backend) gasPrice(feeTx sdk.FeeTx) (*big.Int, error) {
+	if feeTx.GetGas() == 0 {
+		return big.NewInt(0), nil
+	}
+
+	queryStakingClient := stakingtypes.NewQueryClient(b.clientCtx)
+	paramsRes, err := queryStakingClient.Params(b.ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	fee := feeTx.GetFee().AmountOf(paramsRes.Params.BondDenom)
+	return fee.QuoRaw(int64(feeTx.GetGas())).BigInt(), nil
+}
@@ -2,6 +2,7 @@ package eth
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -16,6 +17,12 @@ type EthereumAPI interface {
 	GetBlockByNumber(ethBlockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error)
 	GetBalance(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Big, error)
 	ChainId() (*hexutil.Big, error)
+	GetEip712TypedData(txBytes hexutil.Bytes, accountNumber, sequence hexutil.Uint64) (json.RawMessage, error)
+	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransaction, error)
+	GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error)
+	GetTransactionCount(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Uint64, error)
+	EstimateGas(txBytes hexutil.Bytes) (hexutil.Uint64, error)
 }
 
 var _ EthereumAPI = (*PublicAPI)(nil)
@@ -61,3 +68,39 @@ func (e *PublicAPI) ChainId() (*hexutil.Big, error) {
 	e.logger.Debug("eth_chainId")
 	return e.backend.ChainID()
 }
+
+// GetEip712TypedData returns the EIP712 typed data of an unsigned tx to be signed with eth_signTypedData_v4.
+func (e *PublicAPI) GetEip712TypedData(txBytes hexutil.Bytes, accountNumber, sequence hexutil.Uint64) (json.RawMessage, error) {
+	e.logger.Debug("eth_getEip712TypedData", "account number", accountNumber, "sequence", sequence)
+	return e.backend.GetEip712TypedData(txBytes, accountNumber, sequence)
+}
+
+// SendRawTransaction broadcasts a protobuf encoded cosmos tx signed with EIP712 and returns its tendermint hash.
+func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	e.logger.Debug("eth_sendRawTransaction", "length", len(data))
+	return e.backend.SendRawTransaction(data)
+}
+
+// GetTransactionByHash returns the transaction identified by the tendermint hash.
+func (e *PublicAPI) GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransaction, error) {
+	e.logger.Debug("eth_getTransactionByHash", "hash", hash.Hex())
+	return e.backend.GetTransactionByHash(hash)
+}
+
+// GetTransactionReceipt returns the transaction receipt identified by the tendermint hash.
+func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
+	e.logger.Debug("eth_getTransactionReceipt", "hash", hash.Hex())
+	return e.backend.GetTransactionReceipt(hash)
+}
+
+// GetTransactionCount returns the number of transactions the given address has sent for the given block number.
+func (e *PublicAPI) GetTransactionCount(address common.Address, blockNrOrHash rpctypes.BlockNumberOrHash) (*hexutil.Uint64, error) {
+	e.logger.Debug("eth_getTransactionCount", "address", address.Hex(), "block number or hash", blockNrOrHash)
+	return e.backend.GetTransactionCount(address, blockNrOrHash)
+}
+
+// EstimateGas returns the gas needed by a protobuf encoded cosmos tx which does not need to be signed.
+func (e *PublicAPI) EstimateGas(txBytes hexutil.Bytes) (hexutil.Uint64, error) {
+	e.logger.Debug("eth_estimateGas", "length", len(txBytes))
+	return e.backend.EstimateGas(txBytes)
+}
@@ -35,6 +35,10 @@ type CrossChainApplication interface {
 	ExecuteAckPackage(ctx Context, header *CrossChainAppContext, payload []byte) ExecuteResult
 	// When the ack application crash, payload is the payload of the origin package.
 	ExecuteFailAckPackage(ctx Context, header *CrossChainAppContext, payload []byte) ExecuteResult
+	// When a syn package is timed out before being received by the dest chain, payload is the payload of the origin
+	// package. The dest chain rejects timed out packages with fail ack packages, only one of ExecuteFailAckPackage and
+	// ExecuteTimeoutPackage is called for such a package, depending on which happens first.
+	ExecuteTimeoutPackage(ctx Context, header *CrossChainAppContext, payload []byte) ExecuteResult
 }
 
 type CrossChainAppContext struct {
@@ -62,12 +66,47 @@ const (
 	CrossChainFeeLength = 32
 	PackageTypeLength   = 1
 	TimestampLength     = 8
+	SequenceLength      = 8
 
-	SynPackageHeaderLength = 2*CrossChainFeeLength + TimestampLength + PackageTypeLength
-	AckPackageHeaderLength = CrossChainFeeLength + TimestampLength + PackageTypeLength
+	SynPackageHeaderLength = 2*CrossChainFeeLength + 2*TimestampLength + PackageTypeLength
+	AckPackageHeaderLength = CrossChainFeeLength + TimestampLength + PackageTypeLength + SequenceLength
+
+	// the legacy package headers carry no timeout timestamp and syn sequence
+	SynPackageHeaderLengthV1 = 2*CrossChainFeeLength + TimestampLength + PackageTypeLength
+	AckPackageHeaderLengthV1 = CrossChainFeeLength + TimestampLength + PackageTypeLength
+)
+
+// PackageHeaderVersion is the layout version of the cross chain package header. The header does not encode its
+// version, both chains switch to a new layout at the same upgrade.
+type PackageHeaderVersion uint8
+
+const (
+	// PackageHeaderV1 is the legacy layout without the timeout timestamp of syn packages and the syn sequence of ack
+	// and fail ack packages
+	PackageHeaderV1 PackageHeaderVersion = 1
+	// PackageHeaderV2 appends the timeout timestamp to the header of syn packages and the syn sequence to the header
+	// of ack and fail ack packages
+	PackageHeaderV2 PackageHeaderVersion = 2
+
+	LatestPackageHeaderVersion = PackageHeaderV2
 )
 
+func IsValidPackageHeaderVersion(version PackageHeaderVersion) bool {
+	return version == PackageHeaderV1 || version == PackageHeaderV2
+}
+
 func GetPackageHeaderLength(packageType CrossChainPackageType) int {
+	return GetPackageHeaderLengthWithVersion(packageType, LatestPackageHeaderVersion)
+}
+
+func GetPackageHeaderLengthWithVersion(packageType CrossChainPackageType, version PackageHeaderVersion) int {
+	if version == PackageHeaderV1 {
+		if packageType == SynCrossChainPackageType {
+			return SynPackageHeaderLengthV1
+		}
+		return AckPackageHeaderLengthV1
+	}
+
 	if packageType == SynCrossChainPackageType {
 		return SynPackageHeaderLength
 	}
@@ -81,31 +120,62 @@ type PackageHeader struct {
 	// ack relayer fee is the relayer fee paid to relayer for the ack or fail ack package if there is any
 	// Ack and FailAck packages don't have ack relayer fee, since there is no corresponding ack or fail ack packages
 	AckRelayerFee *big.Int
+	// syn sequence is the sequence of the syn package which the ack or fail ack package responds to, it is only
+	// encoded into the header of ack and fail ack packages
+	SynSequence uint64
+	// timeout timestamp is the block time in unix seconds from which the syn package is timed out, it is only encoded
+	// into the header of syn packages. The dest chain rejects the package once its block time reaches the timeout
+	// timestamp, while the source chain executes the timeout callback of the package. 0 means no timeout.
+	TimeoutTimestamp uint64
 }
 
 var NilAckRelayerFee = big.NewInt(0) // For ack packages, the ack relayer fee should be nil, and it would not be encoded into package header
 
 func EncodePackageHeader(header PackageHeader) []byte {
-	packageHeader := make([]byte, GetPackageHeaderLength(header.PackageType))
+	return EncodePackageHeaderWithVersion(header, LatestPackageHeaderVersion)
+}
+
+// EncodePackageHeaderWithVersion encodes the package header in the layout of the given version, the timeout timestamp
+// and syn sequence are dropped for PackageHeaderV1
+func EncodePackageHeaderWithVersion(header PackageHeader, version PackageHeaderVersion) []byte {
+	packageHeader := make([]byte, GetPackageHeaderLengthWithVersion(header.PackageType, version))
 	packageHeader[0] = uint8(header.PackageType)
 
 	timestampBytes := make([]byte, TimestampLength)
 	binary.BigEndian.PutUint64(timestampBytes, header.Timestamp)
 	copy(packageHeader[PackageTypeLength:PackageTypeLength+TimestampLength], timestampBytes)
 
+	relayerFeeEnd := PackageTypeLength + TimestampLength + CrossChainFeeLength
 	relayerFeeLength := len(header.RelayerFee.Bytes())
-	copy(packageHeader[AckPackageHeaderLength-relayerFeeLength:AckPackageHeaderLength], header.RelayerFee.Bytes())
+	copy(packageHeader[relayerFeeEnd-relayerFeeLength:relayerFeeEnd], header.RelayerFee.Bytes())
 
-	// add ack relayer fee to header for syn package
 	if header.PackageType == SynCrossChainPackageType {
+		// add ack relayer fee and timeout timestamp to header for syn package
+		ackRelayerFeeEnd := relayerFeeEnd + CrossChainFeeLength
 		ackRelayerFeeLength := len(header.AckRelayerFee.Bytes())
-		copy(packageHeader[SynPackageHeaderLength-ackRelayerFeeLength:SynPackageHeaderLength], header.AckRelayerFee.Bytes())
+		copy(packageHeader[ackRelayerFeeEnd-ackRelayerFeeLength:ackRelayerFeeEnd], header.AckRelayerFee.Bytes())
+		if version != PackageHeaderV1 {
+			binary.BigEndian.PutUint64(packageHeader[ackRelayerFeeEnd:SynPackageHeaderLength], header.TimeoutTimestamp)
+		}
+	} else if version != PackageHeaderV1 {
+		// add the sequence of the responded syn package to header for ack and fail ack package
+		binary.BigEndian.PutUint64(packageHeader[relayerFeeEnd:relayerFeeEnd+SequenceLength], header.SynSequence)
 	}
 
 	return packageHeader
 }
 
 func DecodePackageHeader(packageHeader []byte) (PackageHeader, error) {
+	return DecodePackageHeaderWithVersion(packageHeader, LatestPackageHeaderVersion)
+}
+
+// DecodePackageHeaderWithVersion decodes the package header in the layout of the given version, the timeout
+// timestamp and syn sequence are 0 for PackageHeaderV1
+func DecodePackageHeaderWithVersion(packageHeader []byte, version PackageHeaderVersion) (PackageHeader, error) {
+	if !IsValidPackageHeaderVersion(version) {
+		return PackageHeader{}, fmt.Errorf("package header version %d is invalid", version)
+	}
+
 	if len(packageHeader) == 0 {
 		return PackageHeader{}, fmt.Errorf("empty package header")
 	}
@@ -115,7 +185,7 @@ func DecodePackageHeader(packageHeader []byte) (PackageHeader, error) {
 		return PackageHeader{}, fmt.Errorf("package type %d is invalid", packageType)
 	}
 
-	headerLength := GetPackageHeaderLength(packageType)
+	headerLength := GetPackageHeaderLengthWithVersion(packageType, version)
 	if len(packageHeader) < headerLength {
 		err := fmt.Errorf("length of packageHeader is less than %d", headerLength)
 		return PackageHeader{}, err
@@ -123,7 +193,8 @@ func DecodePackageHeader(packageHeader []byte) (PackageHeader, error) {
 
 	timestamp := binary.BigEndian.Uint64(packageHeader[PackageTypeLength : PackageTypeLength+TimestampLength])
 
-	relayerFee := big.NewInt(0).SetBytes(packageHeader[PackageTypeLength+TimestampLength : AckPackageHeaderLength])
+	relayerFeeEnd := PackageTypeLength + TimestampLength + CrossChainFeeLength
+	relayerFee := big.NewInt(0).SetBytes(packageHeader[PackageTypeLength+TimestampLength : relayerFeeEnd])
 
 	header := PackageHeader{
 		PackageType:   packageType,
@@ -133,7 +204,13 @@ func DecodePackageHeader(packageHeader []byte) (PackageHeader, error) {
 	}
 
 	if packageType == SynCrossChainPackageType {
-		header.AckRelayerFee = big.NewInt(0).SetBytes(packageHeader[AckPackageHeaderLength:SynPackageHeaderLength])
+		ackRelayerFeeEnd := relayerFeeEnd + CrossChainFeeLength
+		header.AckRelayerFee = big.NewInt(0).SetBytes(packageHeader[relayerFeeEnd:ackRelayerFeeEnd])
+		if version != PackageHeaderV1 {
+			header.TimeoutTimestamp = binary.BigEndian.Uint64(packageHeader[ackRelayerFeeEnd:SynPackageHeaderLength])
+		}
+	} else if version != PackageHeaderV1 {
+		header.SynSequence = binary.BigEndian.Uint64(packageHeader[relayerFeeEnd : relayerFeeEnd+SequenceLength])
 	}
 
 	return header, nil
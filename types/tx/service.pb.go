@@ -714,6 +714,240 @@ func (m *GetBlockWithTxsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// GetEip712DomainRequest is the request type for the Service.GetEip712Domain
+// RPC method.
+type GetEip712DomainRequest struct {
+}
+
+func (m *GetEip712DomainRequest) Reset()         { *m = GetEip712DomainRequest{} }
+func (m *GetEip712DomainRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEip712DomainRequest) ProtoMessage()    {}
+func (*GetEip712DomainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{10}
+}
+func (m *GetEip712DomainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEip712DomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEip712DomainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetEip712DomainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEip712DomainRequest.Merge(m, src)
+}
+func (m *GetEip712DomainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEip712DomainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEip712DomainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEip712DomainRequest proto.InternalMessageInfo
+
+// GetEip712DomainResponse is the response type for the Service.GetEip712Domain
+// RPC method.
+type GetEip712DomainResponse struct {
+	// name is the name of the signing domain.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// version is the version of the signing domain in effect.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// chain_id is the EIP155 chain id of the signing domain.
+	ChainId uint64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// verifying_contract is the verifying contract of the signing domain.
+	VerifyingContract string `protobuf:"bytes,4,opt,name=verifying_contract,json=verifyingContract,proto3" json:"verifying_contract,omitempty"`
+	// salt is the salt of the signing domain.
+	Salt string `protobuf:"bytes,5,opt,name=salt,proto3" json:"salt,omitempty"`
+}
+
+func (m *GetEip712DomainResponse) Reset()         { *m = GetEip712DomainResponse{} }
+func (m *GetEip712DomainResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEip712DomainResponse) ProtoMessage()    {}
+func (*GetEip712DomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{11}
+}
+func (m *GetEip712DomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEip712DomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEip712DomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetEip712DomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEip712DomainResponse.Merge(m, src)
+}
+func (m *GetEip712DomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEip712DomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEip712DomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEip712DomainResponse proto.InternalMessageInfo
+
+func (m *GetEip712DomainResponse) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetEip712DomainResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *GetEip712DomainResponse) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *GetEip712DomainResponse) GetVerifyingContract() string {
+	if m != nil {
+		return m.VerifyingContract
+	}
+	return ""
+}
+
+func (m *GetEip712DomainResponse) GetSalt() string {
+	if m != nil {
+		return m.Salt
+	}
+	return ""
+}
+
+// GetEip712TypedDataRequest is the request type for the Service.GetEip712TypedData
+// RPC method.
+type GetEip712TypedDataRequest struct {
+	// tx_bytes is the raw bytes of the unsigned tx.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// account_number is the account number of the signer.
+	AccountNumber uint64 `protobuf:"varint,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	// sequence is the sequence of the signer.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *GetEip712TypedDataRequest) Reset()         { *m = GetEip712TypedDataRequest{} }
+func (m *GetEip712TypedDataRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEip712TypedDataRequest) ProtoMessage()    {}
+func (*GetEip712TypedDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{12}
+}
+func (m *GetEip712TypedDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEip712TypedDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEip712TypedDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetEip712TypedDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEip712TypedDataRequest.Merge(m, src)
+}
+func (m *GetEip712TypedDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEip712TypedDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEip712TypedDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEip712TypedDataRequest proto.InternalMessageInfo
+
+func (m *GetEip712TypedDataRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *GetEip712TypedDataRequest) GetAccountNumber() uint64 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *GetEip712TypedDataRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// GetEip712TypedDataResponse is the response type for the Service.GetEip712TypedData
+// RPC method.
+type GetEip712TypedDataResponse struct {
+	// typed_data is the JSON encoded EIP712 typed data which is ready to be passed
+	// to eth_signTypedData_v4.
+	TypedData string `protobuf:"bytes,1,opt,name=typed_data,json=typedData,proto3" json:"typed_data,omitempty"`
+}
+
+func (m *GetEip712TypedDataResponse) Reset()         { *m = GetEip712TypedDataResponse{} }
+func (m *GetEip712TypedDataResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEip712TypedDataResponse) ProtoMessage()    {}
+func (*GetEip712TypedDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{13}
+}
+func (m *GetEip712TypedDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEip712TypedDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEip712TypedDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetEip712TypedDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEip712TypedDataResponse.Merge(m, src)
+}
+func (m *GetEip712TypedDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEip712TypedDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEip712TypedDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEip712TypedDataResponse proto.InternalMessageInfo
+
+func (m *GetEip712TypedDataResponse) GetTypedData() string {
+	if m != nil {
+		return m.TypedData
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
 	proto.RegisterEnum("cosmos.tx.v1beta1.BroadcastMode", BroadcastMode_name, BroadcastMode_value)
@@ -727,75 +961,94 @@ func init() {
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
 	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
 	proto.RegisterType((*GetBlockWithTxsResponse)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsResponse")
+	proto.RegisterType((*GetEip712DomainRequest)(nil), "cosmos.tx.v1beta1.GetEip712DomainRequest")
+	proto.RegisterType((*GetEip712DomainResponse)(nil), "cosmos.tx.v1beta1.GetEip712DomainResponse")
+	proto.RegisterType((*GetEip712TypedDataRequest)(nil), "cosmos.tx.v1beta1.GetEip712TypedDataRequest")
+	proto.RegisterType((*GetEip712TypedDataResponse)(nil), "cosmos.tx.v1beta1.GetEip712TypedDataResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1008 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1a, 0xc7,
-	0x17, 0xf7, 0x2e, 0x60, 0xc8, 0xc3, 0x4e, 0xc8, 0xd8, 0x5f, 0x7b, 0x43, 0xf2, 0xc5, 0x64, 0x53,
-	0x6c, 0x82, 0xe4, 0x5d, 0x85, 0xa6, 0x52, 0x55, 0x55, 0xaa, 0xcc, 0x8f, 0x50, 0x9a, 0x26, 0x44,
-	0x8b, 0xab, 0x28, 0x55, 0x25, 0xb4, 0xc0, 0x04, 0x56, 0x81, 0x1d, 0xcc, 0x0c, 0xd6, 0x22, 0xc7,
-	0xaa, 0xd4, 0x63, 0x4f, 0x55, 0x7b, 0xe8, 0xbf, 0xd4, 0x63, 0xa4, 0x5e, 0xda, 0x5b, 0x65, 0xf7,
-	0xd4, 0x53, 0xff, 0x84, 0x6a, 0x67, 0x07, 0x58, 0x60, 0x89, 0x93, 0x5c, 0xec, 0x19, 0xe6, 0xf3,
-	0xde, 0xfb, 0xbc, 0xcf, 0x9b, 0xf7, 0x66, 0x61, 0xaf, 0x45, 0x68, 0x9f, 0x50, 0x9d, 0x39, 0xfa,
-	0xe9, 0x83, 0x26, 0x66, 0xe6, 0x03, 0x9d, 0xe2, 0xe1, 0xa9, 0xd5, 0xc2, 0xda, 0x60, 0x48, 0x18,
-	0x41, 0x37, 0x3d, 0x80, 0xc6, 0x1c, 0x4d, 0x00, 0x92, 0x77, 0x3a, 0x84, 0x74, 0x7a, 0x58, 0x37,
-	0x07, 0x96, 0x6e, 0xda, 0x36, 0x61, 0x26, 0xb3, 0x88, 0x4d, 0x3d, 0x83, 0xe4, 0x3d, 0xe1, 0xb1,
-	0x69, 0x52, 0xac, 0x9b, 0xcd, 0x96, 0x35, 0x75, 0xec, 0x6e, 0x04, 0x28, 0xb9, 0x1c, 0x96, 0x39,
-	0xe2, 0x2c, 0xe7, 0x77, 0x70, 0x32, 0xc2, 0xc3, 0xf1, 0x14, 0x33, 0x30, 0x3b, 0x96, 0xcd, 0xa3,
-	0x09, 0xec, 0x1d, 0x86, 0xed, 0x36, 0x1e, 0xf6, 0x2d, 0x9b, 0xe9, 0x6c, 0x3c, 0xc0, 0x54, 0x6f,
-	0xf6, 0x48, 0xeb, 0xd5, 0xca, 0x53, 0xfe, 0xd7, 0x3b, 0x55, 0xff, 0x94, 0x00, 0x55, 0x30, 0x3b,
-	0x76, 0x68, 0xf9, 0x14, 0xdb, 0xcc, 0xc0, 0x27, 0x23, 0x4c, 0x19, 0xda, 0x81, 0x75, 0xec, 0xee,
-	0xa9, 0x22, 0xa5, 0x43, 0xd9, 0x6b, 0x86, 0xd8, 0xa1, 0xaf, 0x00, 0x66, 0xe1, 0x15, 0x39, 0x2d,
-	0x65, 0xe3, 0xf9, 0x7d, 0x4d, 0xa8, 0xe3, 0x72, 0xd5, 0x38, 0xd7, 0x89, 0x4a, 0xda, 0x33, 0xb3,
-	0x83, 0x85, 0xcf, 0x82, 0xac, 0x48, 0x86, 0xcf, 0x1a, 0x7d, 0x02, 0x31, 0x32, 0x6c, 0xe3, 0x61,
-	0xa3, 0x39, 0x56, 0x42, 0x69, 0x29, 0x7b, 0x3d, 0x9f, 0xd4, 0x96, 0x74, 0xd6, 0x6a, 0x2e, 0xa4,
-	0x30, 0x36, 0xa2, 0xc4, 0x5b, 0x20, 0x04, 0xe1, 0x81, 0xd9, 0xc1, 0x4a, 0x38, 0x2d, 0x65, 0xc3,
-	0x06, 0x5f, 0xa3, 0x6d, 0x88, 0xf4, 0xac, 0xbe, 0xc5, 0x94, 0x08, 0xff, 0xd1, 0xdb, 0xa8, 0xff,
-	0x48, 0xb0, 0x35, 0x97, 0x1b, 0x1d, 0x10, 0x9b, 0x62, 0x74, 0x00, 0x21, 0xe6, 0x78, 0x99, 0xc5,
-	0xf3, 0xff, 0x0b, 0x88, 0x79, 0xec, 0x18, 0x2e, 0x02, 0x55, 0x60, 0x83, 0x39, 0x8d, 0xa1, 0xb0,
-	0xa3, 0x8a, 0xcc, 0x2d, 0x3e, 0x9a, 0xcb, 0x97, 0xd7, 0xd3, 0x67, 0x28, 0xc0, 0x46, 0x9c, 0x4d,
-	0xd7, 0x14, 0x3d, 0x9e, 0x93, 0x2d, 0xc4, 0x65, 0x3b, 0xb8, 0x52, 0x36, 0xcf, 0x7a, 0x49, 0xb7,
-	0x6d, 0x88, 0x30, 0xc2, 0xcc, 0x9e, 0x50, 0xc0, 0xdb, 0xa8, 0x18, 0x50, 0x61, 0x48, 0xcc, 0x76,
-	0xcb, 0xa4, 0xcc, 0xa5, 0xe1, 0xd5, 0xf1, 0x16, 0xc4, 0x98, 0xd3, 0x68, 0x8e, 0x19, 0x76, 0xf3,
-	0x95, 0xb2, 0x1b, 0x46, 0x94, 0x39, 0x05, 0x77, 0x8b, 0x1e, 0x42, 0xb8, 0x4f, 0xda, 0x98, 0x17,
-	0xf1, 0x7a, 0x3e, 0x1d, 0x20, 0xc3, 0xd4, 0xdf, 0x13, 0xd2, 0xc6, 0x06, 0x47, 0xab, 0xdf, 0xc1,
-	0xd6, 0x5c, 0x18, 0x21, 0x69, 0x19, 0xe2, 0x3e, 0xa5, 0x78, 0xa8, 0x77, 0x15, 0x0a, 0x66, 0x42,
-	0xa9, 0xcf, 0xe1, 0x46, 0xdd, 0xea, 0x8f, 0x7a, 0x26, 0x9b, 0xdc, 0x1a, 0x74, 0x1f, 0x64, 0xe6,
-	0x08, 0x87, 0xc1, 0xb5, 0xe2, 0x02, 0xc9, 0xcc, 0x99, 0x4b, 0x56, 0x9e, 0x4b, 0x56, 0xfd, 0x51,
-	0x82, 0xc4, 0xcc, 0xb3, 0x20, 0xfd, 0x39, 0xc4, 0x3a, 0x26, 0x6d, 0x58, 0xf6, 0x4b, 0x22, 0x02,
-	0xdc, 0x5d, 0xcd, 0xb8, 0x62, 0xd2, 0xaa, 0xfd, 0x92, 0x18, 0xd1, 0x8e, 0xb7, 0x40, 0x9f, 0xc2,
-	0xfa, 0x10, 0xd3, 0x51, 0x8f, 0x89, 0x36, 0x48, 0xaf, 0xb6, 0x35, 0x38, 0xce, 0x10, 0x78, 0x55,
-	0x85, 0x0d, 0x7e, 0x2d, 0x27, 0x29, 0x22, 0x08, 0x77, 0x4d, 0xda, 0xe5, 0x1c, 0xae, 0x19, 0x7c,
-	0xad, 0x9e, 0xc3, 0xa6, 0xc0, 0x08, 0xb2, 0x99, 0x2b, 0x75, 0xe0, 0x1a, 0x2c, 0x14, 0x42, 0xfe,
-	0xc0, 0x42, 0x38, 0xb0, 0x53, 0xc1, 0xac, 0xe0, 0x8e, 0x91, 0xe7, 0x16, 0xeb, 0x1e, 0x3b, 0xd4,
-	0x37, 0x19, 0xba, 0xd8, 0xea, 0x74, 0x19, 0xe7, 0x12, 0x32, 0xc4, 0x0e, 0x3d, 0xfa, 0xf0, 0xc9,
-	0xe0, 0xbf, 0xdd, 0xea, 0xbf, 0x12, 0xec, 0x2e, 0x85, 0x7e, 0xdf, 0xc6, 0x7d, 0x08, 0x31, 0x3e,
-	0x02, 0x1b, 0x56, 0x5b, 0x50, 0xb9, 0xa5, 0xcd, 0xc6, 0xa0, 0xe6, 0x0d, 0x40, 0x1e, 0xa2, 0x5a,
-	0x32, 0xa2, 0x1c, 0x5a, 0x6d, 0xa3, 0x43, 0x88, 0xf0, 0xa5, 0x68, 0xd0, 0xdd, 0x15, 0x26, 0x86,
-	0x87, 0x42, 0x95, 0xb9, 0x8c, 0xc3, 0xef, 0xd5, 0xd4, 0xfe, 0x94, 0x73, 0x5f, 0x42, 0x54, 0x4c,
-	0x39, 0xa4, 0xc0, 0x76, 0xcd, 0x28, 0x95, 0x8d, 0x46, 0xe1, 0x45, 0xe3, 0x9b, 0xa7, 0xf5, 0x67,
-	0xe5, 0x62, 0xf5, 0x51, 0xb5, 0x5c, 0x4a, 0xac, 0xa1, 0x04, 0x6c, 0x4c, 0x4f, 0x8e, 0xea, 0xc5,
-	0x84, 0x84, 0x6e, 0xc2, 0xe6, 0xf4, 0x97, 0x52, 0xb9, 0x5e, 0x4c, 0xc8, 0xb9, 0xd7, 0xb0, 0x39,
-	0xd7, 0xb4, 0x28, 0x05, 0xc9, 0x82, 0x51, 0x3b, 0x2a, 0x15, 0x8f, 0xea, 0xc7, 0x8d, 0x27, 0xb5,
-	0x52, 0x79, 0xc1, 0xab, 0x02, 0xdb, 0x0b, 0xe7, 0x85, 0xaf, 0x6b, 0xc5, 0xc7, 0x09, 0x09, 0xed,
-	0xc2, 0xd6, 0xc2, 0x49, 0xfd, 0xc5, 0xd3, 0x62, 0x42, 0x0e, 0x30, 0x39, 0xe2, 0x27, 0xa1, 0xfc,
-	0xcf, 0x11, 0x88, 0xd6, 0xbd, 0x77, 0x13, 0x9d, 0x41, 0x6c, 0xd2, 0x6f, 0x48, 0x0d, 0xa8, 0xd4,
-	0x42, 0x9b, 0x27, 0xef, 0xbd, 0x15, 0x23, 0x6e, 0xe5, 0xfe, 0x0f, 0xbf, 0xff, 0xfd, 0x8b, 0x9c,
-	0x56, 0x6f, 0xeb, 0x01, 0x0f, 0xb6, 0x00, 0x7f, 0x26, 0xe5, 0xd0, 0x09, 0x44, 0x78, 0xf3, 0xa0,
-	0xbd, 0x00, 0xaf, 0xfe, 0xd6, 0x4b, 0xa6, 0x57, 0x03, 0x44, 0xcc, 0x0c, 0x8f, 0xb9, 0x87, 0xfe,
-	0xaf, 0x07, 0xbd, 0xd6, 0x54, 0x3f, 0x73, 0xdb, 0xf5, 0x1c, 0x7d, 0x0f, 0x71, 0xdf, 0x5c, 0x44,
-	0x99, 0xb7, 0x8d, 0xd3, 0x59, 0xf8, 0xfd, 0xab, 0x60, 0x82, 0xc4, 0x5d, 0x4e, 0xe2, 0xb6, 0xba,
-	0x13, 0x4c, 0xc2, 0xcd, 0xf9, 0x35, 0xc4, 0x7d, 0x6f, 0x5d, 0x20, 0x81, 0xe5, 0x77, 0x3e, 0x90,
-	0x40, 0xc0, 0x93, 0xa9, 0xa6, 0x38, 0x01, 0x05, 0xad, 0x20, 0x80, 0x7e, 0x95, 0xe0, 0xc6, 0x42,
-	0xd7, 0xa2, 0xfb, 0xc1, 0xbe, 0x03, 0x86, 0x4a, 0x32, 0xf7, 0x2e, 0x50, 0x41, 0xe5, 0x90, 0x53,
-	0x39, 0x40, 0x99, 0x15, 0x05, 0xe1, 0xcd, 0xa9, 0x9f, 0x79, 0x63, 0xe9, 0xbc, 0xf0, 0xc5, 0x6f,
-	0x17, 0x29, 0xe9, 0xcd, 0x45, 0x4a, 0xfa, 0xeb, 0x22, 0x25, 0xfd, 0x74, 0x99, 0x5a, 0x7b, 0x73,
-	0x99, 0x5a, 0xfb, 0xe3, 0x32, 0xb5, 0xf6, 0x6d, 0xa6, 0x63, 0xb1, 0xee, 0xa8, 0xa9, 0xb5, 0x48,
-	0x7f, 0xe2, 0xca, 0xfb, 0x77, 0x48, 0xdb, 0xaf, 0x26, 0x9f, 0x4a, 0x4e, 0x73, 0x9d, 0x7f, 0x28,
-	0x7d, 0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x12, 0x2b, 0xbc, 0x25, 0x0a, 0x00, 0x00,
+	// 1236 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x17, 0x35, 0x25, 0x39, 0x92, 0xae, 0xec, 0x44, 0x99, 0xf8, 0x4b, 0x18, 0x25, 0x51, 0x14, 0x26,
+	0xb2, 0x65, 0xe3, 0xb3, 0x08, 0xab, 0x29, 0x5a, 0xb4, 0x05, 0x0a, 0xeb, 0x27, 0xae, 0x9b, 0xc6,
+	0x0e, 0x28, 0x17, 0x41, 0x8a, 0x02, 0xc4, 0x88, 0x1c, 0x4b, 0x44, 0x24, 0x52, 0xe6, 0x8c, 0x0c,
+	0x0a, 0x8e, 0x51, 0xa0, 0xcb, 0xae, 0x8a, 0x76, 0xd1, 0x7d, 0x81, 0xbe, 0x4b, 0x17, 0x5d, 0x04,
+	0xe8, 0xa6, 0xdd, 0x15, 0x76, 0x57, 0x5d, 0xf5, 0x11, 0x0a, 0x0e, 0x47, 0xbf, 0xa6, 0x6c, 0x27,
+	0x1b, 0x69, 0x86, 0x73, 0xee, 0xbd, 0xe7, 0x9e, 0xe1, 0x1c, 0x0e, 0xdc, 0x37, 0x1c, 0xda, 0x71,
+	0xa8, 0xca, 0x3c, 0xf5, 0x70, 0xa3, 0x41, 0x18, 0xde, 0x50, 0x29, 0x71, 0x0f, 0x2d, 0x83, 0x14,
+	0xbb, 0xae, 0xc3, 0x1c, 0x74, 0x3d, 0x00, 0x14, 0x99, 0x57, 0x14, 0x80, 0xcc, 0xdd, 0xa6, 0xe3,
+	0x34, 0xdb, 0x44, 0xc5, 0x5d, 0x4b, 0xc5, 0xb6, 0xed, 0x30, 0xcc, 0x2c, 0xc7, 0xa6, 0x41, 0x40,
+	0xe6, 0xa1, 0xc8, 0xd8, 0xc0, 0x94, 0xa8, 0xb8, 0x61, 0x58, 0xc3, 0xc4, 0xfe, 0x44, 0x80, 0x32,
+	0x67, 0xcb, 0x32, 0x4f, 0xac, 0xad, 0x8d, 0x27, 0x38, 0xe8, 0x11, 0xb7, 0x3f, 0xc4, 0x74, 0x71,
+	0xd3, 0xb2, 0x79, 0x35, 0x81, 0xbd, 0xcb, 0x88, 0x6d, 0x12, 0xb7, 0x63, 0xd9, 0x4c, 0x65, 0xfd,
+	0x2e, 0xa1, 0x6a, 0xa3, 0xed, 0x18, 0xaf, 0x66, 0xae, 0xf2, 0xdf, 0x60, 0x55, 0xf9, 0x53, 0x02,
+	0xb4, 0x45, 0xd8, 0x9e, 0x47, 0x6b, 0x87, 0xc4, 0x66, 0x1a, 0x39, 0xe8, 0x11, 0xca, 0xd0, 0x4d,
+	0xb8, 0x42, 0xfc, 0x39, 0x95, 0xa5, 0x5c, 0xb4, 0x90, 0xd4, 0xc4, 0x0c, 0x7d, 0x0e, 0x30, 0x2a,
+	0x2f, 0x47, 0x72, 0x52, 0x21, 0x55, 0x5a, 0x2e, 0x0a, 0x75, 0x7c, 0xae, 0x45, 0xce, 0x75, 0xa0,
+	0x52, 0xf1, 0x39, 0x6e, 0x12, 0x91, 0xb3, 0x1c, 0x91, 0x25, 0x6d, 0x2c, 0x1a, 0xbd, 0x0f, 0x09,
+	0xc7, 0x35, 0x89, 0xab, 0x37, 0xfa, 0x72, 0x34, 0x27, 0x15, 0xae, 0x96, 0x32, 0xc5, 0x33, 0x3a,
+	0x17, 0x77, 0x7d, 0x48, 0xb9, 0xaf, 0xc5, 0x9d, 0x60, 0x80, 0x10, 0xc4, 0xba, 0xb8, 0x49, 0xe4,
+	0x58, 0x4e, 0x2a, 0xc4, 0x34, 0x3e, 0x46, 0x4b, 0x30, 0xdf, 0xb6, 0x3a, 0x16, 0x93, 0xe7, 0xf9,
+	0xc3, 0x60, 0xa2, 0xfc, 0x23, 0xc1, 0x8d, 0x89, 0xde, 0x68, 0xd7, 0xb1, 0x29, 0x41, 0x2b, 0x10,
+	0x65, 0x5e, 0xd0, 0x59, 0xaa, 0xf4, 0xbf, 0x90, 0x9a, 0x7b, 0x9e, 0xe6, 0x23, 0xd0, 0x16, 0x2c,
+	0x30, 0x4f, 0x77, 0x45, 0x1c, 0x95, 0x23, 0x3c, 0xe2, 0xd1, 0x44, 0xbf, 0x7c, 0x3f, 0xc7, 0x02,
+	0x05, 0x58, 0x4b, 0xb1, 0xe1, 0x98, 0xa2, 0xa7, 0x13, 0xb2, 0x45, 0xb9, 0x6c, 0x2b, 0x17, 0xca,
+	0x16, 0x44, 0x9f, 0xd1, 0x6d, 0x09, 0xe6, 0x99, 0xc3, 0x70, 0x5b, 0x28, 0x10, 0x4c, 0x14, 0x02,
+	0xa8, 0xec, 0x3a, 0xd8, 0x34, 0x30, 0x65, 0x3e, 0x8d, 0x60, 0x1f, 0x6f, 0x43, 0x82, 0x79, 0x7a,
+	0xa3, 0xcf, 0x88, 0xdf, 0xaf, 0x54, 0x58, 0xd0, 0xe2, 0xcc, 0x2b, 0xfb, 0x53, 0xf4, 0x18, 0x62,
+	0x1d, 0xc7, 0x24, 0x7c, 0x13, 0xaf, 0x96, 0x72, 0x21, 0x32, 0x0c, 0xf3, 0x3d, 0x73, 0x4c, 0xa2,
+	0x71, 0xb4, 0xf2, 0x35, 0xdc, 0x98, 0x28, 0x23, 0x24, 0xad, 0x41, 0x6a, 0x4c, 0x29, 0x5e, 0xea,
+	0xb2, 0x42, 0xc1, 0x48, 0x28, 0xe5, 0x05, 0x5c, 0xab, 0x5b, 0x9d, 0x5e, 0x1b, 0xb3, 0xc1, 0x5b,
+	0x83, 0x56, 0x21, 0xc2, 0x3c, 0x91, 0x30, 0x7c, 0xaf, 0xb8, 0x40, 0x11, 0xe6, 0x4d, 0x34, 0x1b,
+	0x99, 0x68, 0x56, 0xf9, 0x4e, 0x82, 0xf4, 0x28, 0xb3, 0x20, 0xfd, 0x09, 0x24, 0x9a, 0x98, 0xea,
+	0x96, 0xbd, 0xef, 0x88, 0x02, 0x0f, 0x66, 0x33, 0xde, 0xc2, 0x74, 0xdb, 0xde, 0x77, 0xb4, 0x78,
+	0x33, 0x18, 0xa0, 0x0f, 0xe1, 0x8a, 0x4b, 0x68, 0xaf, 0xcd, 0xc4, 0x31, 0xc8, 0xcd, 0x8e, 0xd5,
+	0x38, 0x4e, 0x13, 0x78, 0x45, 0x81, 0x05, 0xfe, 0x5a, 0x0e, 0x5a, 0x44, 0x10, 0x6b, 0x61, 0xda,
+	0xe2, 0x1c, 0x92, 0x1a, 0x1f, 0x2b, 0xc7, 0xb0, 0x28, 0x30, 0x82, 0x6c, 0xfe, 0x42, 0x1d, 0xb8,
+	0x06, 0x53, 0x1b, 0x11, 0x79, 0xc7, 0x8d, 0xf0, 0xe0, 0xe6, 0x16, 0x61, 0x65, 0xdf, 0x46, 0x5e,
+	0x58, 0xac, 0xb5, 0xe7, 0xd1, 0x31, 0x67, 0x68, 0x11, 0xab, 0xd9, 0x62, 0x9c, 0x4b, 0x54, 0x13,
+	0x33, 0xf4, 0xe4, 0xdd, 0x9d, 0x61, 0xfc, 0xed, 0x56, 0xfe, 0x95, 0xe0, 0xd6, 0x99, 0xd2, 0x6f,
+	0x7b, 0x70, 0x1f, 0x43, 0x82, 0x5b, 0xa0, 0x6e, 0x99, 0x82, 0xca, 0xed, 0xe2, 0xc8, 0x06, 0x8b,
+	0x81, 0x01, 0xf2, 0x12, 0xdb, 0x55, 0x2d, 0xce, 0xa1, 0xdb, 0x26, 0x5a, 0x87, 0x79, 0x3e, 0x14,
+	0x07, 0xf4, 0xd6, 0x8c, 0x10, 0x2d, 0x40, 0xa1, 0xad, 0x89, 0x8e, 0x63, 0x6f, 0x75, 0xa8, 0x27,
+	0x5a, 0x96, 0xb9, 0xd8, 0x35, 0xab, 0xfb, 0xc1, 0x46, 0xa9, 0xea, 0x74, 0xb0, 0x65, 0x0b, 0x61,
+	0x94, 0x5f, 0x02, 0x31, 0x26, 0x97, 0x84, 0x18, 0x08, 0x62, 0x36, 0xee, 0x90, 0xc1, 0x5b, 0xe3,
+	0x8f, 0x91, 0x0c, 0xf1, 0x43, 0xe2, 0xd2, 0xc1, 0x0e, 0x24, 0xb5, 0xc1, 0xd4, 0x3f, 0x1b, 0x46,
+	0x0b, 0x5b, 0xb6, 0xaf, 0x48, 0x94, 0xfb, 0x46, 0x9c, 0xcf, 0x79, 0xdb, 0xe8, 0x90, 0xb8, 0xd6,
+	0x7e, 0xdf, 0xb2, 0x9b, 0xba, 0xe1, 0xd8, 0xcc, 0xc5, 0x06, 0xe3, 0xfd, 0x24, 0xb5, 0xeb, 0xc3,
+	0x95, 0x8a, 0x58, 0xf0, 0xeb, 0x52, 0xdc, 0x0e, 0xac, 0x36, 0xa9, 0xf1, 0xb1, 0xd2, 0x87, 0xdb,
+	0x43, 0x9a, 0x7b, 0xfd, 0x2e, 0x31, 0xab, 0x98, 0xe1, 0x4b, 0x78, 0x50, 0x1e, 0xae, 0x62, 0xc3,
+	0x70, 0x7a, 0x36, 0xd3, 0xed, 0x5e, 0xa7, 0x41, 0x5c, 0x4e, 0x3b, 0xa6, 0x2d, 0x8a, 0xa7, 0x3b,
+	0xfc, 0x21, 0xca, 0x40, 0x82, 0xfa, 0xc9, 0x6c, 0x83, 0x08, 0xf2, 0xc3, 0xb9, 0xf2, 0x31, 0x64,
+	0xc2, 0x4a, 0x0b, 0x91, 0xee, 0x01, 0xf8, 0x3b, 0x67, 0xea, 0x26, 0x66, 0x58, 0x48, 0x95, 0x64,
+	0x03, 0xd8, 0xda, 0x67, 0x10, 0x17, 0xdf, 0x17, 0x24, 0xc3, 0xd2, 0xae, 0x56, 0xad, 0x69, 0x7a,
+	0xf9, 0xa5, 0xfe, 0xe5, 0x4e, 0xfd, 0x79, 0xad, 0xb2, 0xfd, 0x64, 0xbb, 0x56, 0x4d, 0xcf, 0xa1,
+	0x34, 0x2c, 0x0c, 0x57, 0x36, 0xeb, 0x95, 0xb4, 0x84, 0xae, 0xc3, 0xe2, 0xf0, 0x49, 0xb5, 0x56,
+	0xaf, 0xa4, 0x23, 0x6b, 0xaf, 0x61, 0x71, 0xc2, 0x2e, 0x51, 0x16, 0x32, 0x65, 0x6d, 0x77, 0xb3,
+	0x5a, 0xd9, 0xac, 0xef, 0xe9, 0xcf, 0x76, 0xab, 0xb5, 0xa9, 0xac, 0x32, 0x2c, 0x4d, 0xad, 0x97,
+	0xbf, 0xd8, 0xad, 0x3c, 0x4d, 0x4b, 0xe8, 0x16, 0xdc, 0x98, 0x5a, 0xa9, 0xbf, 0xdc, 0xa9, 0xa4,
+	0x23, 0x21, 0x21, 0x9b, 0x7c, 0x25, 0x5a, 0xfa, 0x2d, 0x0e, 0xf1, 0x7a, 0x70, 0x63, 0x41, 0x47,
+	0x90, 0x18, 0x38, 0x1d, 0x52, 0x42, 0xce, 0xc8, 0x94, 0xc1, 0x66, 0x1e, 0x9e, 0x8b, 0x11, 0x7e,
+	0xb0, 0xfc, 0xed, 0xef, 0x7f, 0xff, 0x18, 0xc9, 0x7d, 0x24, 0xad, 0x29, 0x77, 0xd4, 0x90, 0xdb,
+	0xd2, 0xa0, 0xe0, 0x01, 0xcc, 0x73, 0xdb, 0x42, 0xf7, 0x43, 0xb2, 0x8e, 0x9b, 0x5e, 0x26, 0x37,
+	0x1b, 0x20, 0x6a, 0xe6, 0x79, 0xcd, 0xfb, 0xe8, 0x9e, 0x1a, 0x76, 0x4f, 0xa2, 0xea, 0x91, 0x6f,
+	0x94, 0xc7, 0xe8, 0x1b, 0x48, 0x8d, 0x7d, 0x91, 0x50, 0xfe, 0xbc, 0x0f, 0xd9, 0xa8, 0xfc, 0xf2,
+	0x45, 0x30, 0x41, 0xe2, 0x01, 0x27, 0x71, 0xc7, 0x6f, 0xfc, 0x66, 0x38, 0x0f, 0xf4, 0x1a, 0x52,
+	0x63, 0xb7, 0x8c, 0x50, 0x02, 0x67, 0x6f, 0x58, 0xa1, 0x04, 0x42, 0x2e, 0x2b, 0x4a, 0x96, 0x13,
+	0x90, 0xd1, 0xac, 0xea, 0x3f, 0x49, 0x70, 0x6d, 0xca, 0x2f, 0xd1, 0x6a, 0x78, 0xee, 0x10, 0x3b,
+	0xcf, 0xac, 0x5d, 0x06, 0x2a, 0xa8, 0xac, 0x73, 0x2a, 0x2b, 0x28, 0x3f, 0x63, 0x43, 0xb8, 0x2d,
+	0xaa, 0x47, 0xc1, 0x07, 0xe1, 0x18, 0xfd, 0x10, 0x30, 0x1b, 0x37, 0xaf, 0x59, 0xcc, 0x42, 0xbc,
+	0x6f, 0x16, 0xb3, 0x30, 0x2f, 0x54, 0x0a, 0x9c, 0x99, 0x82, 0x72, 0x21, 0xcc, 0x08, 0x0f, 0xd0,
+	0xcd, 0x80, 0xc0, 0xcf, 0xc1, 0x7d, 0x77, 0xca, 0x2f, 0xd0, 0xff, 0xcf, 0x2b, 0x36, 0xed, 0x68,
+	0x99, 0xf5, 0x4b, 0xa2, 0x05, 0x3b, 0x95, 0xb3, 0x5b, 0xf5, 0xdf, 0xa1, 0x47, 0xb3, 0x09, 0x8e,
+	0x7c, 0xaa, 0xfc, 0xe9, 0xaf, 0x27, 0x59, 0xe9, 0xcd, 0x49, 0x56, 0xfa, 0xeb, 0x24, 0x2b, 0x7d,
+	0x7f, 0x9a, 0x9d, 0x7b, 0x73, 0x9a, 0x9d, 0xfb, 0xe3, 0x34, 0x3b, 0xf7, 0x55, 0xbe, 0x69, 0xb1,
+	0x56, 0xaf, 0x51, 0x34, 0x9c, 0xce, 0x20, 0x53, 0xf0, 0xb7, 0x4e, 0xcd, 0x57, 0x83, 0xeb, 0xbd,
+	0xd7, 0xb8, 0xc2, 0x2f, 0xf7, 0xef, 0xfd, 0x17, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x76, 0x1b, 0xb8,
+	0xd9, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -822,6 +1075,11 @@ type ServiceClient interface {
 	//
 	// Since: cosmos-sdk 0.45.2
 	GetBlockWithTxs(ctx context.Context, in *GetBlockWithTxsRequest, opts ...grpc.CallOption) (*GetBlockWithTxsResponse, error)
+	// GetEip712Domain fetches the EIP712 domain of SIGN_MODE_EIP_712 in effect.
+	GetEip712Domain(ctx context.Context, in *GetEip712DomainRequest, opts ...grpc.CallOption) (*GetEip712DomainResponse, error)
+	// GetEip712TypedData generates the EIP712 typed data of an unsigned tx to be
+	// signed with eth_signTypedData_v4.
+	GetEip712TypedData(ctx context.Context, in *GetEip712TypedDataRequest, opts ...grpc.CallOption) (*GetEip712TypedDataResponse, error)
 }
 
 type serviceClient struct {
@@ -877,6 +1135,24 @@ func (c *serviceClient) GetBlockWithTxs(ctx context.Context, in *GetBlockWithTxs
 	return out, nil
 }
 
+func (c *serviceClient) GetEip712Domain(ctx context.Context, in *GetEip712DomainRequest, opts ...grpc.CallOption) (*GetEip712DomainResponse, error) {
+	out := new(GetEip712DomainResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GetEip712Domain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceClient) GetEip712TypedData(ctx context.Context, in *GetEip712TypedDataRequest, opts ...grpc.CallOption) (*GetEip712TypedDataResponse, error) {
+	out := new(GetEip712TypedDataResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GetEip712TypedData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
@@ -891,6 +1167,11 @@ type ServiceServer interface {
 	//
 	// Since: cosmos-sdk 0.45.2
 	GetBlockWithTxs(context.Context, *GetBlockWithTxsRequest) (*GetBlockWithTxsResponse, error)
+	// GetEip712Domain fetches the EIP712 domain of SIGN_MODE_EIP_712 in effect.
+	GetEip712Domain(context.Context, *GetEip712DomainRequest) (*GetEip712DomainResponse, error)
+	// GetEip712TypedData generates the EIP712 typed data of an unsigned tx to be
+	// signed with eth_signTypedData_v4.
+	GetEip712TypedData(context.Context, *GetEip712TypedDataRequest) (*GetEip712TypedDataResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -912,6 +1193,12 @@ func (*UnimplementedServiceServer) GetTxsEvent(ctx context.Context, req *GetTxsE
 func (*UnimplementedServiceServer) GetBlockWithTxs(ctx context.Context, req *GetBlockWithTxsRequest) (*GetBlockWithTxsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockWithTxs not implemented")
 }
+func (*UnimplementedServiceServer) GetEip712Domain(ctx context.Context, req *GetEip712DomainRequest) (*GetEip712DomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEip712Domain not implemented")
+}
+func (*UnimplementedServiceServer) GetEip712TypedData(ctx context.Context, req *GetEip712TypedDataRequest) (*GetEip712TypedDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEip712TypedData not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -1007,6 +1294,42 @@ func _Service_GetBlockWithTxs_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GetEip712Domain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEip712DomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetEip712Domain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/GetEip712Domain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetEip712Domain(ctx, req.(*GetEip712DomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Service_GetEip712TypedData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEip712TypedDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GetEip712TypedData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/GetEip712TypedData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GetEip712TypedData(ctx, req.(*GetEip712TypedDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.tx.v1beta1.Service",
 	HandlerType: (*ServiceServer)(nil),
@@ -1031,6 +1354,14 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockWithTxs",
 			Handler:    _Service_GetBlockWithTxs_Handler,
 		},
+		{
+			MethodName: "GetEip712Domain",
+			Handler:    _Service_GetEip712Domain_Handler,
+		},
+		{
+			MethodName: "GetEip712TypedData",
+			Handler:    _Service_GetEip712TypedData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/tx/v1beta1/service.proto",
@@ -1512,56 +1843,205 @@ func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetEip712DomainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GetTxsEventRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *GetEip712DomainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEip712DomainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetEip712DomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEip712DomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEip712DomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Events) > 0 {
-		for _, s := range m.Events {
-			l = len(s)
-			n += 1 + l + sovService(uint64(l))
-		}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.VerifyingContract) > 0 {
+		i -= len(m.VerifyingContract)
+		copy(dAtA[i:], m.VerifyingContract)
+		i = encodeVarintService(dAtA, i, uint64(len(m.VerifyingContract)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.OrderBy != 0 {
-		n += 1 + sovService(uint64(m.OrderBy))
+	if m.ChainId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Page != 0 {
-		n += 1 + sovService(uint64(m.Page))
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Limit != 0 {
-		n += 1 + sovService(uint64(m.Limit))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetTxsEventResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetEip712TypedDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Txs) > 0 {
-		for _, e := range m.Txs {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *GetEip712TypedDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEip712TypedDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetEip712TypedDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEip712TypedDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEip712TypedDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TypedData) > 0 {
+		i -= len(m.TypedData)
+		copy(dAtA[i:], m.TypedData)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TypedData)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetTxsEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, s := range m.Events {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.OrderBy != 0 {
+		n += 1 + sovService(uint64(m.OrderBy))
+	}
+	if m.Page != 0 {
+		n += 1 + sovService(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovService(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *GetTxsEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
 	}
 	if len(m.TxResponses) > 0 {
 		for _, e := range m.TxResponses {
@@ -1715,6 +2195,75 @@ func (m *GetBlockWithTxsResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetEip712DomainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetEip712DomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovService(uint64(m.ChainId))
+	}
+	l = len(m.VerifyingContract)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetEip712TypedDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovService(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovService(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *GetEip712TypedDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypedData)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3001,6 +3550,457 @@ func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetEip712DomainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEip712DomainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEip712DomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEip712DomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEip712DomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEip712DomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyingContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyingContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEip712TypedDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEip712TypedDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEip712TypedDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEip712TypedDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEip712TypedDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEip712TypedDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypedData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypedData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -378,6 +378,9 @@ type SignDocEip712 struct {
 	// This field is ignored if the chain didn't enable tips, i.e. didn't add the
 	// `TipDecorator` in its posthandler.
 	Tip *Tip `protobuf:"bytes,8,opt,name=tip,proto3" json:"tip,omitempty"`
+	// msgs are the msgs in the EIP712 transaction with more than one msg, msg is
+	// left empty if msgs is set.
+	Msgs []*types.Any `protobuf:"bytes,9,rep,name=msgs,proto3" json:"msgs,omitempty"`
 }
 
 func (m *SignDocEip712) Reset()         { *m = SignDocEip712{} }
@@ -469,6 +472,13 @@ func (m *SignDocEip712) GetTip() *Tip {
 	return nil
 }
 
+func (m *SignDocEip712) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
 // TxBody is the body of a transaction that all signers sign over.
 type TxBody struct {
 	// messages is a list of messages to be executed. The required signers of
@@ -716,6 +726,7 @@ type ModeInfo struct {
 	// multisig signer
 	//
 	// Types that are valid to be assigned to Sum:
+	//
 	//	*ModeInfo_Single_
 	//	*ModeInfo_Multi_
 	Sum isModeInfo_Sum `protobuf_oneof:"sum"`
@@ -1142,78 +1153,79 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 1127 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x7a, 0xfd, 0xf9, 0x9a, 0xf4, 0x63, 0x54, 0x21, 0xc7, 0xa1, 0x4e, 0x70, 0x95, 0xd6,
-	0x97, 0xac, 0x93, 0xf4, 0xd0, 0x16, 0x21, 0xc0, 0xdb, 0xb4, 0x4a, 0x55, 0x0a, 0xd2, 0x26, 0xa7,
-	0x5e, 0x56, 0xeb, 0xf5, 0x64, 0x3d, 0xaa, 0x77, 0x66, 0xd9, 0x99, 0x05, 0xfb, 0x8f, 0x40, 0xaa,
-	0x90, 0x10, 0x17, 0x0e, 0x9c, 0x39, 0xf3, 0x47, 0xf4, 0x84, 0x2a, 0x4e, 0x9c, 0x42, 0x95, 0xdc,
-	0x82, 0xc4, 0x99, 0x1b, 0x68, 0x66, 0x67, 0x37, 0xce, 0x97, 0x0d, 0x02, 0x71, 0xb1, 0xe7, 0xcd,
-	0xfc, 0xde, 0x9b, 0xdf, 0xfb, 0x98, 0xf7, 0x16, 0x9a, 0x3e, 0xe3, 0x21, 0xe3, 0x5d, 0x31, 0xee,
-	0x7e, 0xb1, 0xd9, 0xc7, 0xc2, 0xdb, 0xec, 0x8a, 0xb1, 0x15, 0xc5, 0x4c, 0x30, 0x74, 0x23, 0x3d,
-	0xb3, 0xc4, 0xd8, 0xd2, 0x67, 0xcd, 0x9b, 0x01, 0x0b, 0x98, 0x3a, 0xed, 0xca, 0x55, 0x0a, 0x6c,
-	0xae, 0x6b, 0x23, 0x7e, 0x3c, 0x89, 0x04, 0xeb, 0x86, 0xc9, 0x48, 0x10, 0x4e, 0x82, 0xdc, 0x62,
-	0xb6, 0xa1, 0xe1, 0x2d, 0x0d, 0xef, 0x7b, 0x1c, 0xe7, 0x18, 0x9f, 0x11, 0xaa, 0xcf, 0xef, 0x9e,
-	0x70, 0xe2, 0x24, 0xa0, 0x84, 0x9e, 0x58, 0xd2, 0xb2, 0x06, 0x2e, 0x05, 0x8c, 0x05, 0x23, 0xdc,
-	0x55, 0x52, 0x3f, 0xd9, 0xef, 0x7a, 0x74, 0x92, 0x1d, 0xa5, 0x36, 0xdc, 0x94, 0xab, 0x76, 0x44,
-	0x09, 0xed, 0xaf, 0x0c, 0x28, 0xee, 0x8d, 0xd1, 0x3a, 0x94, 0xfa, 0x6c, 0x30, 0x69, 0x18, 0xab,
-	0x46, 0xe7, 0xca, 0xd6, 0x92, 0x75, 0xce, 0x59, 0x6b, 0x6f, 0x6c, 0xb3, 0xc1, 0xc4, 0x51, 0x30,
-	0xf4, 0x00, 0xea, 0x5e, 0x22, 0x86, 0x2e, 0xa1, 0xfb, 0xac, 0x51, 0x54, 0x3a, 0xcb, 0x17, 0xe8,
-	0xf4, 0x12, 0x31, 0x7c, 0x4a, 0xf7, 0x99, 0x53, 0xf3, 0xf4, 0x0a, 0xb5, 0x00, 0x24, 0x6d, 0x4f,
-	0x24, 0x31, 0xe6, 0x0d, 0x73, 0xd5, 0xec, 0x2c, 0x38, 0x53, 0x3b, 0x6d, 0x0a, 0xe5, 0xbd, 0xb1,
-	0xe3, 0x7d, 0x89, 0x6e, 0x01, 0xc8, 0xab, 0xdc, 0xfe, 0x44, 0x60, 0xae, 0x78, 0x2d, 0x38, 0x75,
-	0xb9, 0x63, 0xcb, 0x0d, 0x74, 0x07, 0xae, 0xe5, 0x0c, 0x34, 0xa6, 0xa8, 0x30, 0x8b, 0xd9, 0x55,
-	0x29, 0x6e, 0xde, 0x7d, 0x5f, 0x1b, 0x50, 0xdd, 0x25, 0x01, 0xdd, 0x66, 0xfe, 0x7f, 0x75, 0xe5,
-	0x12, 0xd4, 0xfc, 0xa1, 0x47, 0xa8, 0x4b, 0x06, 0x0d, 0x73, 0xd5, 0xe8, 0xd4, 0x9d, 0xaa, 0x92,
-	0x9f, 0x0e, 0xd0, 0x1a, 0x5c, 0xf5, 0x7c, 0x9f, 0x25, 0x54, 0xb8, 0x34, 0x09, 0xfb, 0x38, 0x6e,
-	0x94, 0x56, 0x8d, 0x4e, 0xc9, 0x59, 0xd4, 0xbb, 0x9f, 0xaa, 0xcd, 0xf6, 0xef, 0x06, 0x5c, 0xd7,
-	0xa4, 0xb6, 0x49, 0x8c, 0x7d, 0xd1, 0x4b, 0xc6, 0xf3, 0xd8, 0xdd, 0x03, 0x88, 0x92, 0xfe, 0x88,
-	0xf8, 0xee, 0x4b, 0x3c, 0xd1, 0x39, 0xb9, 0x69, 0xa5, 0x35, 0x61, 0x65, 0x35, 0x61, 0xf5, 0xe8,
-	0xc4, 0xa9, 0xa7, 0xb8, 0x67, 0x78, 0xf2, 0xef, 0xa9, 0xa2, 0x26, 0xd4, 0x38, 0xfe, 0x3c, 0xc1,
-	0xd4, 0xc7, 0x8d, 0xb2, 0x02, 0xe4, 0x32, 0xea, 0x80, 0x29, 0x48, 0xd4, 0xa8, 0x28, 0x2e, 0xef,
-	0x5c, 0x54, 0x53, 0x24, 0x72, 0x24, 0xa4, 0xfd, 0x47, 0x11, 0x16, 0xb5, 0xc3, 0x8f, 0x49, 0x74,
-	0x7f, 0x73, 0x0b, 0xdd, 0x9d, 0x62, 0x26, 0x7d, 0x2d, 0xd9, 0x0b, 0xc7, 0x07, 0x2b, 0xf9, 0xde,
-	0x09, 0xcf, 0x87, 0xe7, 0x78, 0x16, 0x15, 0x1c, 0x1d, 0x1f, 0xac, 0x9c, 0x39, 0x39, 0xcb, 0xbd,
-	0x33, 0xc5, 0xdd, 0x3c, 0xb9, 0x23, 0xdb, 0x9b, 0xf2, 0xc4, 0x02, 0x73, 0x1f, 0x63, 0x15, 0x81,
-	0x8b, 0x3d, 0x79, 0x82, 0xb1, 0x5d, 0x7a, 0x7d, 0xb0, 0x52, 0x70, 0x24, 0x10, 0xdd, 0x01, 0x33,
-	0xe4, 0x81, 0x0a, 0xc8, 0x65, 0x59, 0x90, 0x00, 0x49, 0x5e, 0x90, 0x10, 0xb3, 0x44, 0xb8, 0x43,
-	0x4c, 0x82, 0xa1, 0x50, 0xc1, 0xd2, 0xe4, 0x4f, 0x9f, 0x38, 0x8b, 0x5a, 0xde, 0x51, 0x22, 0x7a,
-	0x17, 0x4a, 0x21, 0x0e, 0x59, 0xa3, 0x2a, 0xd3, 0x66, 0xd7, 0x8e, 0x0f, 0x56, 0x94, 0xec, 0xa8,
-	0xdf, 0x2c, 0xf4, 0xb5, 0xf9, 0xa1, 0xff, 0xa6, 0x08, 0x95, 0xf4, 0x6d, 0xa3, 0x0d, 0xa8, 0x85,
-	0x98, 0x73, 0x2f, 0x50, 0xf5, 0x65, 0x5e, 0x4a, 0x3d, 0x47, 0x21, 0xa4, 0x49, 0x14, 0x55, 0xed,
-	0xa4, 0x57, 0xaf, 0x9d, 0xf3, 0xc9, 0x4c, 0x0b, 0xe7, 0x34, 0x7f, 0x1b, 0x6e, 0xe0, 0xb1, 0xc0,
-	0x94, 0x13, 0x46, 0x5d, 0x16, 0x09, 0xc2, 0x28, 0x6f, 0xfc, 0x59, 0x9d, 0x71, 0xed, 0xf5, 0x1c,
-	0xff, 0x59, 0x0a, 0x47, 0x2f, 0xa0, 0x45, 0x19, 0x75, 0xfd, 0x98, 0x08, 0xe2, 0x7b, 0x23, 0xf7,
-	0x02, 0x83, 0xd7, 0x66, 0x18, 0x5c, 0xa6, 0x8c, 0x3e, 0xd2, 0xba, 0x8f, 0xcf, 0xd8, 0x6e, 0x7f,
-	0x6f, 0x40, 0x2d, 0xeb, 0x5f, 0xe8, 0x63, 0x58, 0x90, 0x3d, 0x03, 0xc7, 0xea, 0xf1, 0x67, 0xd1,
-	0xb9, 0x75, 0x41, 0x5c, 0x77, 0x15, 0x4c, 0x35, 0xbd, 0x2b, 0x3c, 0x5f, 0x73, 0x99, 0x10, 0x59,
-	0x41, 0xc5, 0x59, 0x15, 0x94, 0xd6, 0x8e, 0x4e, 0x9d, 0x39, 0x3f, 0x75, 0xdf, 0x1a, 0x00, 0x27,
-	0xf7, 0x9d, 0xe9, 0x00, 0xc6, 0xdf, 0xeb, 0x00, 0x0f, 0xa0, 0x1e, 0xb2, 0x01, 0x9e, 0xd7, 0xc9,
-	0x9f, 0xb3, 0x01, 0x4e, 0x3b, 0x79, 0xa8, 0x57, 0xa7, 0x5e, 0xbe, 0x79, 0xfa, 0xe5, 0xb7, 0xdf,
-	0x16, 0xa1, 0x96, 0xa9, 0xa0, 0x0f, 0xa0, 0xc2, 0x09, 0x0d, 0x46, 0x58, 0x73, 0x6a, 0xcf, 0xb0,
-	0x6f, 0xed, 0x2a, 0xe4, 0x4e, 0xc1, 0xd1, 0x3a, 0xe8, 0x21, 0x94, 0xd5, 0xc4, 0xd4, 0xe4, 0xde,
-	0x9b, 0xa5, 0xfc, 0x5c, 0x02, 0x77, 0x0a, 0x4e, 0xaa, 0xd1, 0xec, 0x41, 0x25, 0x35, 0x87, 0xee,
-	0x43, 0x49, 0xf2, 0x56, 0x04, 0xae, 0x6e, 0xdd, 0x9e, 0xb2, 0x91, 0xcd, 0xd0, 0xe9, 0xfc, 0x49,
-	0x7b, 0x8e, 0x52, 0x68, 0xbe, 0x32, 0xa0, 0xac, 0xac, 0xa2, 0x67, 0x50, 0xeb, 0x13, 0xe1, 0xc5,
-	0xb1, 0x97, 0xc5, 0xb6, 0x9b, 0x99, 0x49, 0x27, 0xbd, 0x95, 0x0f, 0xf6, 0xcc, 0xd6, 0x23, 0x16,
-	0x46, 0x9e, 0x2f, 0x6c, 0x22, 0x7a, 0x52, 0xcd, 0xc9, 0x0d, 0xa0, 0xf7, 0x01, 0xf2, 0xa8, 0xcb,
-	0x29, 0x62, 0xce, 0x0b, 0x7b, 0x3d, 0x0b, 0x3b, 0xb7, 0xcb, 0x60, 0xf2, 0x24, 0x6c, 0xff, 0x66,
-	0x80, 0xf9, 0x04, 0x63, 0xe4, 0x43, 0xc5, 0x0b, 0x65, 0x53, 0xd3, 0x45, 0x99, 0xcf, 0x6e, 0xf9,
-	0x41, 0x31, 0x45, 0x85, 0x50, 0x7b, 0x43, 0x36, 0xa8, 0x1f, 0x7e, 0x5d, 0xe9, 0x04, 0x44, 0x0c,
-	0x93, 0xbe, 0xe5, 0xb3, 0xb0, 0x9b, 0x7d, 0xac, 0xa8, 0xbf, 0x75, 0x3e, 0x78, 0xd9, 0x15, 0x93,
-	0x08, 0x73, 0xa5, 0xc0, 0x1d, 0x6d, 0x1a, 0x2d, 0x43, 0x3d, 0xf0, 0xb8, 0x3b, 0x22, 0x21, 0x11,
-	0x69, 0x7f, 0x75, 0x6a, 0x81, 0xc7, 0x3f, 0x91, 0x32, 0xb2, 0xa0, 0x1c, 0x79, 0x13, 0x1c, 0xa7,
-	0x13, 0xc4, 0x6e, 0xfc, 0xfc, 0xe3, 0xfa, 0x4d, 0xcd, 0xa1, 0x37, 0x18, 0xc4, 0x98, 0xf3, 0x5d,
-	0x11, 0x13, 0x1a, 0x38, 0x29, 0x0c, 0x6d, 0x41, 0x35, 0x88, 0x3d, 0x2a, 0xf4, 0x48, 0x99, 0xa5,
-	0x91, 0x01, 0xdb, 0xdf, 0x19, 0x60, 0xee, 0x91, 0xe8, 0xff, 0xf1, 0x76, 0x03, 0x2a, 0x82, 0x44,
-	0x91, 0x1e, 0x25, 0xb3, 0xf8, 0x69, 0x5c, 0xfb, 0x27, 0x03, 0x16, 0x7b, 0xc9, 0x38, 0x7d, 0x8c,
-	0xdb, 0x9e, 0xf0, 0xa4, 0x93, 0x5e, 0x0a, 0x55, 0xd5, 0x32, 0xd3, 0x49, 0x0d, 0x44, 0x1f, 0x42,
-	0x4d, 0x96, 0xa3, 0x3b, 0x60, 0xbe, 0xae, 0xf6, 0xdb, 0x97, 0x74, 0x98, 0xe9, 0x0f, 0x03, 0xa7,
-	0xca, 0xf5, 0xf7, 0x4b, 0x56, 0xe5, 0xe6, 0x3f, 0xac, 0x72, 0x74, 0x1d, 0x4c, 0x4e, 0x02, 0x95,
-	0x8d, 0x05, 0x47, 0x2e, 0xed, 0x8f, 0x5e, 0x1f, 0xb6, 0x8c, 0x37, 0x87, 0x2d, 0xe3, 0xed, 0x61,
-	0xcb, 0x78, 0x75, 0xd4, 0x2a, 0xbc, 0x39, 0x6a, 0x15, 0x7e, 0x39, 0x6a, 0x15, 0x5e, 0xac, 0xcd,
-	0x0f, 0x67, 0x57, 0x8c, 0xfb, 0x15, 0xd5, 0x70, 0xee, 0xfd, 0x15, 0x00, 0x00, 0xff, 0xff, 0xda,
-	0xa8, 0x01, 0xa2, 0x53, 0x0b, 0x00, 0x00,
+	// 1142 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x7a, 0xfd, 0xf7, 0x35, 0xe9, 0x9f, 0x51, 0x85, 0x1c, 0x87, 0x3a, 0xc1, 0x55, 0x5a,
+	0x5f, 0xb2, 0x4e, 0xd2, 0x43, 0x5b, 0x84, 0x00, 0x6f, 0xd3, 0x2a, 0x55, 0x29, 0x48, 0x9b, 0x9c,
+	0x7a, 0x59, 0xad, 0xd7, 0x93, 0xf5, 0xa8, 0xde, 0x99, 0x65, 0x67, 0x16, 0xec, 0x0f, 0x81, 0x54,
+	0x21, 0x21, 0x2e, 0x1c, 0x10, 0x47, 0xce, 0x7c, 0x88, 0x9e, 0x50, 0xc5, 0x89, 0x53, 0xa8, 0x92,
+	0x5b, 0x90, 0xf8, 0x0a, 0xa0, 0x99, 0x9d, 0xdd, 0x38, 0xff, 0x6c, 0x10, 0x88, 0x8b, 0x3d, 0x6f,
+	0xe6, 0xf7, 0xde, 0xfc, 0xde, 0x9b, 0xb7, 0xbf, 0x19, 0x68, 0xfa, 0x8c, 0x87, 0x8c, 0x77, 0xc5,
+	0xb8, 0xfb, 0xc5, 0x66, 0x1f, 0x0b, 0x6f, 0xb3, 0x2b, 0xc6, 0x56, 0x14, 0x33, 0xc1, 0xd0, 0x8d,
+	0x74, 0xcd, 0x12, 0x63, 0x4b, 0xaf, 0x35, 0x6f, 0x06, 0x2c, 0x60, 0x6a, 0xb5, 0x2b, 0x47, 0x29,
+	0xb0, 0xb9, 0xae, 0x83, 0xf8, 0xf1, 0x24, 0x12, 0xac, 0x1b, 0x26, 0x23, 0x41, 0x38, 0x09, 0xf2,
+	0x88, 0xd9, 0x84, 0x86, 0xb7, 0x34, 0xbc, 0xef, 0x71, 0x9c, 0x63, 0x7c, 0x46, 0xa8, 0x5e, 0xbf,
+	0x7b, 0xc2, 0x89, 0x93, 0x80, 0x12, 0x7a, 0x12, 0x49, 0xdb, 0x1a, 0xb8, 0x14, 0x30, 0x16, 0x8c,
+	0x70, 0x57, 0x59, 0xfd, 0x64, 0xbf, 0xeb, 0xd1, 0x49, 0xb6, 0x94, 0xc6, 0x70, 0x53, 0xae, 0x3a,
+	0x11, 0x65, 0xb4, 0xbf, 0x32, 0xa0, 0xb8, 0x37, 0x46, 0xeb, 0x50, 0xea, 0xb3, 0xc1, 0xa4, 0x61,
+	0xac, 0x1a, 0x9d, 0x2b, 0x5b, 0x4b, 0xd6, 0xb9, 0x64, 0xad, 0xbd, 0xb1, 0xcd, 0x06, 0x13, 0x47,
+	0xc1, 0xd0, 0x03, 0xa8, 0x7b, 0x89, 0x18, 0xba, 0x84, 0xee, 0xb3, 0x46, 0x51, 0xf9, 0x2c, 0x5f,
+	0xe0, 0xd3, 0x4b, 0xc4, 0xf0, 0x29, 0xdd, 0x67, 0x4e, 0xcd, 0xd3, 0x23, 0xd4, 0x02, 0x90, 0xb4,
+	0x3d, 0x91, 0xc4, 0x98, 0x37, 0xcc, 0x55, 0xb3, 0xb3, 0xe0, 0x4c, 0xcd, 0xb4, 0x29, 0x94, 0xf7,
+	0xc6, 0x8e, 0xf7, 0x25, 0xba, 0x05, 0x20, 0xb7, 0x72, 0xfb, 0x13, 0x81, 0xb9, 0xe2, 0xb5, 0xe0,
+	0xd4, 0xe5, 0x8c, 0x2d, 0x27, 0xd0, 0x1d, 0xb8, 0x96, 0x33, 0xd0, 0x98, 0xa2, 0xc2, 0x2c, 0x66,
+	0x5b, 0xa5, 0xb8, 0x79, 0xfb, 0x7d, 0x6d, 0x40, 0x75, 0x97, 0x04, 0x74, 0x9b, 0xf9, 0xff, 0xd5,
+	0x96, 0x4b, 0x50, 0xf3, 0x87, 0x1e, 0xa1, 0x2e, 0x19, 0x34, 0xcc, 0x55, 0xa3, 0x53, 0x77, 0xaa,
+	0xca, 0x7e, 0x3a, 0x40, 0x6b, 0x70, 0xd5, 0xf3, 0x7d, 0x96, 0x50, 0xe1, 0xd2, 0x24, 0xec, 0xe3,
+	0xb8, 0x51, 0x5a, 0x35, 0x3a, 0x25, 0x67, 0x51, 0xcf, 0x7e, 0xaa, 0x26, 0xdb, 0x7f, 0x18, 0x70,
+	0x5d, 0x93, 0xda, 0x26, 0x31, 0xf6, 0x45, 0x2f, 0x19, 0xcf, 0x63, 0x77, 0x0f, 0x20, 0x4a, 0xfa,
+	0x23, 0xe2, 0xbb, 0x2f, 0xf1, 0x44, 0x9f, 0xc9, 0x4d, 0x2b, 0xed, 0x09, 0x2b, 0xeb, 0x09, 0xab,
+	0x47, 0x27, 0x4e, 0x3d, 0xc5, 0x3d, 0xc3, 0x93, 0x7f, 0x4f, 0x15, 0x35, 0xa1, 0xc6, 0xf1, 0xe7,
+	0x09, 0xa6, 0x3e, 0x6e, 0x94, 0x15, 0x20, 0xb7, 0x51, 0x07, 0x4c, 0x41, 0xa2, 0x46, 0x45, 0x71,
+	0x79, 0xe7, 0xa2, 0x9e, 0x22, 0x91, 0x23, 0x21, 0xed, 0x1f, 0x4c, 0x58, 0xd4, 0x09, 0x3f, 0x26,
+	0xd1, 0xfd, 0xcd, 0x2d, 0x74, 0x77, 0x8a, 0x99, 0xcc, 0xb5, 0x64, 0x2f, 0x1c, 0x1f, 0xac, 0xe4,
+	0x73, 0x27, 0x3c, 0x1f, 0x9e, 0xe3, 0x59, 0x54, 0x70, 0x74, 0x7c, 0xb0, 0x72, 0x66, 0xe5, 0x2c,
+	0xf7, 0xce, 0x14, 0x77, 0xf3, 0x64, 0x8f, 0x6c, 0x6e, 0x2a, 0x13, 0x0b, 0xcc, 0x7d, 0x8c, 0x55,
+	0x05, 0x2e, 0xce, 0xe4, 0x09, 0xc6, 0x76, 0xe9, 0xf5, 0xc1, 0x4a, 0xc1, 0x91, 0x40, 0x74, 0x07,
+	0xcc, 0x90, 0x07, 0xaa, 0x20, 0x97, 0x9d, 0x82, 0x04, 0x48, 0xf2, 0x82, 0x84, 0x98, 0x25, 0xc2,
+	0x1d, 0x62, 0x12, 0x0c, 0x85, 0x2a, 0x96, 0x26, 0x7f, 0x7a, 0xc5, 0x59, 0xd4, 0xf6, 0x8e, 0x32,
+	0xd1, 0xbb, 0x50, 0x0a, 0x71, 0xc8, 0x1a, 0x55, 0x79, 0x6c, 0x76, 0xed, 0xf8, 0x60, 0x45, 0xd9,
+	0x8e, 0xfa, 0xcd, 0x4a, 0x5f, 0x9b, 0x5b, 0x7a, 0xd4, 0x81, 0x52, 0xc8, 0x03, 0xde, 0xa8, 0xaf,
+	0x9a, 0x97, 0x72, 0x55, 0x88, 0xf6, 0x37, 0x45, 0xa8, 0xa4, 0x2a, 0x80, 0x36, 0xa0, 0x16, 0x62,
+	0xce, 0xbd, 0x40, 0x75, 0xe2, 0xe5, 0x8e, 0x39, 0x0a, 0x21, 0x4d, 0xb7, 0xa8, 0xba, 0x2c, 0x25,
+	0xb9, 0x76, 0x2e, 0x7b, 0x33, 0x6d, 0xb1, 0xd3, 0x99, 0xda, 0x70, 0x03, 0x8f, 0x05, 0xa6, 0x9c,
+	0x30, 0xea, 0xb2, 0x48, 0x10, 0x46, 0x79, 0xe3, 0xcf, 0xea, 0x8c, 0x6d, 0xaf, 0xe7, 0xf8, 0xcf,
+	0x52, 0x38, 0x7a, 0x01, 0x2d, 0xca, 0xa8, 0xeb, 0xc7, 0x44, 0x10, 0xdf, 0x1b, 0xb9, 0x17, 0x04,
+	0xbc, 0x36, 0x23, 0xe0, 0x32, 0x65, 0xf4, 0x91, 0xf6, 0x7d, 0x7c, 0x26, 0x76, 0xfb, 0x7b, 0x03,
+	0x6a, 0x99, 0xd2, 0xa1, 0x8f, 0x61, 0x41, 0xaa, 0x0b, 0x8e, 0x95, 0x4c, 0x64, 0xd5, 0xb9, 0x75,
+	0xc1, 0x09, 0xec, 0x2a, 0x98, 0x92, 0xc7, 0x2b, 0x3c, 0x1f, 0x73, 0x79, 0x74, 0xb2, 0xd7, 0x8a,
+	0xb3, 0x7a, 0x2d, 0xed, 0x32, 0x7d, 0xc8, 0xe6, 0xfc, 0xef, 0xeb, 0x5b, 0x03, 0xe0, 0x64, 0xbf,
+	0x33, 0x5a, 0x61, 0xfc, 0x3d, 0xad, 0x78, 0x00, 0xf5, 0x90, 0x0d, 0xf0, 0x3c, 0xcd, 0x7f, 0xce,
+	0x06, 0x38, 0xd5, 0xfc, 0x50, 0x8f, 0x4e, 0x69, 0x84, 0x79, 0x5a, 0x23, 0xda, 0x6f, 0x8b, 0x50,
+	0xcb, 0x5c, 0xd0, 0x07, 0x50, 0xe1, 0x84, 0x06, 0x23, 0xac, 0x39, 0xb5, 0x67, 0xc4, 0xb7, 0x76,
+	0x15, 0x72, 0xa7, 0xe0, 0x68, 0x1f, 0xf4, 0x10, 0xca, 0xea, 0x6e, 0xd5, 0xe4, 0xde, 0x9b, 0xe5,
+	0xfc, 0x5c, 0x02, 0x77, 0x0a, 0x4e, 0xea, 0xd1, 0xec, 0x41, 0x25, 0x0d, 0x87, 0xee, 0x43, 0x49,
+	0xf2, 0x56, 0x04, 0xae, 0x6e, 0xdd, 0x9e, 0x8a, 0x91, 0xdd, 0xb6, 0xd3, 0xe7, 0x27, 0xe3, 0x39,
+	0xca, 0xa1, 0xf9, 0xca, 0x80, 0xb2, 0x8a, 0x8a, 0x9e, 0x41, 0xad, 0x4f, 0x84, 0x17, 0xc7, 0x5e,
+	0x56, 0xdb, 0x6e, 0x16, 0x26, 0x7d, 0x13, 0x58, 0xf9, 0x13, 0x20, 0x8b, 0xf5, 0x88, 0x85, 0x91,
+	0xe7, 0x0b, 0x9b, 0x88, 0x9e, 0x74, 0x73, 0xf2, 0x00, 0xe8, 0x7d, 0x80, 0xbc, 0xea, 0xf2, 0xbe,
+	0x31, 0xe7, 0x95, 0xbd, 0x9e, 0x95, 0x9d, 0xdb, 0x65, 0x30, 0x79, 0x12, 0xb6, 0x7f, 0x37, 0xc0,
+	0x7c, 0x82, 0x31, 0xf2, 0xa1, 0xe2, 0x85, 0x52, 0xfe, 0x74, 0x53, 0xe6, 0xb7, 0xbc, 0x7c, 0x7a,
+	0x4c, 0x51, 0x21, 0xd4, 0xde, 0x90, 0x52, 0xf6, 0xe3, 0x6f, 0x2b, 0x9d, 0x80, 0x88, 0x61, 0xd2,
+	0xb7, 0x7c, 0x16, 0x76, 0xb3, 0x67, 0x8d, 0xfa, 0x5b, 0xe7, 0x83, 0x97, 0x5d, 0x31, 0x89, 0x30,
+	0x57, 0x0e, 0xdc, 0xd1, 0xa1, 0xd1, 0x32, 0xd4, 0x03, 0x8f, 0xbb, 0x23, 0x12, 0x12, 0x91, 0x2a,
+	0xb1, 0x53, 0x0b, 0x3c, 0xfe, 0x89, 0xb4, 0x91, 0x05, 0xe5, 0xc8, 0x9b, 0xe0, 0x38, 0xbd, 0x6b,
+	0xec, 0xc6, 0x2f, 0x3f, 0xad, 0xdf, 0xd4, 0x1c, 0x7a, 0x83, 0x41, 0x8c, 0x39, 0xdf, 0x15, 0x31,
+	0xa1, 0x81, 0x93, 0xc2, 0xd0, 0x16, 0x54, 0x83, 0xd8, 0xa3, 0x42, 0x5f, 0x3e, 0xb3, 0x3c, 0x32,
+	0x60, 0xfb, 0x3b, 0x03, 0xcc, 0x3d, 0x12, 0xfd, 0x3f, 0xd9, 0x6e, 0x40, 0x45, 0x90, 0x28, 0xd2,
+	0x97, 0xce, 0x2c, 0x7e, 0x1a, 0xd7, 0xfe, 0xd9, 0x80, 0xc5, 0x5e, 0x32, 0x4e, 0x3f, 0xc6, 0x6d,
+	0x4f, 0x78, 0x32, 0x49, 0x2f, 0x85, 0xaa, 0x6e, 0x99, 0x99, 0xa4, 0x06, 0xa2, 0x0f, 0xa1, 0x26,
+	0xdb, 0xd1, 0x1d, 0x30, 0x5f, 0x77, 0xfb, 0xed, 0x4b, 0x14, 0x66, 0xfa, 0x09, 0xe1, 0x54, 0xb9,
+	0x7e, 0xe9, 0x64, 0x5d, 0x6e, 0xfe, 0xc3, 0x2e, 0x47, 0xd7, 0xc1, 0xe4, 0x24, 0x50, 0xa7, 0xb1,
+	0xe0, 0xc8, 0xa1, 0xfd, 0xd1, 0xeb, 0xc3, 0x96, 0xf1, 0xe6, 0xb0, 0x65, 0xbc, 0x3d, 0x6c, 0x19,
+	0xaf, 0x8e, 0x5a, 0x85, 0x37, 0x47, 0xad, 0xc2, 0xaf, 0x47, 0xad, 0xc2, 0x8b, 0xb5, 0xf9, 0xe5,
+	0xec, 0x8a, 0x71, 0xbf, 0xa2, 0x04, 0xe7, 0xde, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xcc,
+	0x33, 0xd9, 0x7d, 0x0b, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1458,6 +1470,20 @@ func (m *SignDocEip712) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Tip != nil {
 		{
 			size, err := m.Tip.MarshalToSizedBuffer(dAtA[:i])
@@ -2165,6 +2191,12 @@ func (m *SignDocEip712) Size() (n int) {
 		l = m.Tip.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3328,6 +3360,40 @@ func (m *SignDocEip712) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,61 @@
+package ante
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BaseFeeDecorator checks that the fee of a tx covers the EIP-1559 style base fee of the gashub module, which is
+// the base fee per gas multiplied by the gas limit, and burns the part of the base fee defined by the burn ratio,
+// the rest is left to the fee collector. The check is performed in both CheckTx and DeliverTx.
+// CONTRACT: BaseFeeDecorator must be called after DeductFeeDecorator, so that the fee has been deducted into the
+// fee collector before burning.
+type BaseFeeDecorator struct {
+	bk  BurnKeeper
+	ghk GashubKeeper
+}
+
+func NewBaseFeeDecorator(bk BurnKeeper, ghk GashubKeeper) BaseFeeDecorator {
+	return BaseFeeDecorator{
+		bk:  bk,
+		ghk: ghk,
+	}
+}
+
+func (bfd BaseFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// the gentxs are not charged the base fee
+	if simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	params := bfd.ghk.GetParams(ctx)
+	if !params.BaseFeeEnabled {
+		return next(ctx, tx, simulate)
+	}
+
+	baseFee := bfd.ghk.GetBaseFee(ctx)
+	requiredFee := sdk.NewCoin(params.BaseFeeDenom, baseFee.Mul(sdk.NewIntFromUint64(feeTx.GetGas())))
+
+	fee := feeTx.GetFee()
+	if fee.AmountOf(params.BaseFeeDenom).LT(requiredFee.Amount) {
+		return ctx, errors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees for base fee; got: %s required: %s", fee, requiredFee)
+	}
+
+	burnAmount := params.BaseFeeBurnRatio.MulInt(requiredFee.Amount).TruncateInt()
+	if burnAmount.IsPositive() {
+		burnCoins := sdk.NewCoins(sdk.NewCoin(params.BaseFeeDenom, burnAmount))
+		if err := bfd.bk.BurnCoins(ctx, types.FeeCollectorName, burnCoins); err != nil {
+			return ctx, errors.Wrapf(err, "failed to burn base fee %s", burnCoins)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,71 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+)
+
+func (s *AnteTestSuite) TestBaseFeeDecorator() {
+	s.SetupTest(false) // setup
+
+	params := s.app.GashubKeeper.GetParams(s.ctx)
+	params.BaseFeeEnabled = true
+	params.BaseFeeDenom = "atom"
+	params.BaseFeeBurnRatio = sdk.NewDecWithPrec(5, 1)
+	s.app.GashubKeeper.SetParams(s.ctx, params)
+	s.app.GashubKeeper.SetBaseFee(s.ctx, sdk.NewInt(2))
+
+	dfd := ante.NewDeductFeeDecorator(s.app.AccountKeeper, s.app.BankKeeper, nil, nil)
+	bfd := ante.NewBaseFeeDecorator(s.app.BankKeeper, s.app.GashubKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd, bfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyEthSecp256k1TestPubAddr()
+	acc := s.app.AccountKeeper.NewAccountWithAddress(s.ctx, addr1)
+	s.app.AccountKeeper.SetAccount(s.ctx, acc)
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))))
+
+	testCases := []struct {
+		name   string
+		fee    int64
+		simTx  bool
+		expErr bool
+		burned int64
+	}{
+		{"fee below the base fee", 150, false, true, 0},
+		{"fee below the base fee in simulate mode", 150, true, false, 0},
+		{"fee covering the base fee", 300, false, false, 100},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(s.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+			s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", tc.fee)))
+			s.txBuilder.SetGasLimit(100)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+			s.Require().NoError(err)
+
+			ctx, _ := s.ctx.CacheContext()
+			supplyBefore := s.app.BankKeeper.GetSupply(ctx, "atom")
+			_, err = antehandler(ctx, tx, tc.simTx)
+			if tc.expErr {
+				s.Require().ErrorContains(err, "insufficient fees for base fee")
+				return
+			}
+			s.Require().NoError(err)
+
+			supplyAfter := s.app.BankKeeper.GetSupply(ctx, "atom")
+			s.Require().Equal(tc.burned, supplyBefore.Amount.Sub(supplyAfter.Amount).Int64())
+
+			feeCollector := s.app.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+			s.Require().Equal(tc.fee-tc.burned, s.app.BankKeeper.GetBalance(ctx, feeCollector, "atom").Amount.Int64())
+		})
+	}
+}
@@ -22,4 +22,10 @@ type FeegrantKeeper interface {
 
 type GashubKeeper interface {
 	GetParams(ctx sdk.Context) (params gashubtypes.Params)
+	GetBaseFee(ctx sdk.Context) sdk.Int
+}
+
+// BurnKeeper defines the expected bank keeper used to burn the base fee.
+type BurnKeeper interface {
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
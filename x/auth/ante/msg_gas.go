@@ -1,8 +1,6 @@
 package ante
 
 import (
-	"fmt"
-
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,9 +10,9 @@ import (
 
 const (
 	// Length of the protobuf encoded bytes
-	EthSecp256k1PubkeySize = 79
-	EthSecp256k1SigSize    = 65
-	FeeSize                = 42
+	EthSecp256k1PubkeySize = types.EthSecp256k1PubkeySize
+	EthSecp256k1SigSize    = types.EthSecp256k1SigSize
+	FeeSize                = types.FeeSize
 )
 
 // ValidateTxSizeDecorator will validate tx bytes length given the parameters passed in
@@ -51,20 +49,22 @@ func (vtsd ValidateTxSizeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		}
 		n := len(sigs)
 
+		missingSigs, missingPubKeys := 0, 0
 		for i := range sigTx.GetSigners() {
 			if i < n {
 				if isIncompleteSignature(sigs[i].Data) {
-					txSize += EthSecp256k1SigSize
+					missingSigs++
 				}
 				if sigs[i].PubKey == nil {
-					txSize += EthSecp256k1PubkeySize
+					missingPubKeys++
 				}
 			} else {
-				txSize += EthSecp256k1SigSize + EthSecp256k1PubkeySize
+				missingSigs++
+				missingPubKeys++
 			}
 		}
 
-		txSize += FeeSize
+		txSize = types.SimulatedTxSize(txSize, missingSigs, missingPubKeys)
 		newCtx = ctx.WithTxSize(txSize)
 	}
 
@@ -97,8 +97,8 @@ func (cmfg ConsumeMsgGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	}
 
 	params := cmfg.ghk.GetParams(ctx)
-	gasByTxSize := cmfg.getTxSizeGas(params, ctx)
-	gasByMsgType, err := cmfg.getMsgGas(params, sigTx)
+	gasByTxSize := types.GetTxSizeGas(params, ctx.TxSize())
+	gasByMsgType, err := types.GetMsgsGas(params, sigTx.GetMsgs())
 	if err != nil {
 		return ctx, err
 	}
@@ -111,29 +111,3 @@ func (cmfg ConsumeMsgGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 
 	return next(ctx, tx, simulate)
 }
-
-func (cmfg ConsumeMsgGasDecorator) getMsgGas(params types.Params, tx sdk.Tx) (uint64, error) {
-	msgs := tx.GetMsgs()
-	totalGas := uint64(0)
-	for _, msg := range msgs {
-		feeCalcGen := types.GetGasCalculatorGen(sdk.MsgTypeURL(msg))
-		if feeCalcGen == nil {
-			return 0, fmt.Errorf("failed to find fee calculator")
-		}
-		feeCalc := feeCalcGen(params)
-		gas, err := feeCalc(msg)
-		if err != nil {
-			return 0, err
-		}
-		totalGas += gas
-	}
-	return totalGas, nil
-}
-
-func (cmfg ConsumeMsgGasDecorator) getTxSizeGas(params types.Params, ctx sdk.Context) uint64 {
-	txSize := ctx.TxSize()
-	if txSize < params.GetMaxTxSize()/2 {
-		return 0
-	}
-	return params.GetMinGasPerByte() * txSize
-}
@@ -267,6 +267,7 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			AccountNumber: accNum,
 			Sequence:      acc.GetSequence(),
 			PubKey:        pubKey,
+			IsUpgraded:    ctx.IsUpgraded,
 		}
 
 		// no need to verify signatures on recheck tx
@@ -116,6 +116,11 @@ func makeSignBatchCmd() func(cmd *cobra.Command, args []string) error {
 			} else {
 				txFactory = txFactory.WithAccountNumber(0).WithSequence(0)
 			}
+
+			txFactory, err = txFactory.PrepareUpgradeChecker(clientCtx)
+			if err != nil {
+				return err
+			}
 		}
 
 		appendMessagesToSingleTx, _ := cmd.Flags().GetBool(flagAppend)
@@ -179,7 +179,7 @@ func populateAccountFromState(
 		return txBldr, err
 	}
 
-	return txBldr.WithAccountNumber(num).WithSequence(seq), nil
+	return txBldr.WithAccountNumber(num).WithSequence(seq).PrepareUpgradeChecker(clientCtx)
 }
 
 // GetTxEncoder return tx encoder from global sdk configuration if ones is defined.
@@ -50,4 +50,11 @@ type SignerData struct {
 	// In case of multisigs, this should be the pubkey of the member of the
 	// multisig that is signing the current sign doc.
 	PubKey cryptotypes.PubKey
+
+	// IsUpgraded reports whether the named upgrade has been applied when the
+	// signature is verified. It is used by the sign modes whose sign bytes change
+	// with upgrades, e.g. the EIP712 domain version of SIGN_MODE_EIP_712.
+	//
+	// A nil IsUpgraded is treated as none of the upgrades have been applied.
+	IsUpgraded func(name string) bool
 }
@@ -13,31 +13,62 @@ import (
 )
 
 type config struct {
-	handler     signing.SignModeHandler
-	decoder     sdk.TxDecoder
-	encoder     sdk.TxEncoder
-	jsonDecoder sdk.TxDecoder
-	jsonEncoder sdk.TxEncoder
-	protoCodec  codec.ProtoCodecMarshaler
+	handler      signing.SignModeHandler
+	decoder      sdk.TxDecoder
+	encoder      sdk.TxEncoder
+	jsonDecoder  sdk.TxDecoder
+	jsonEncoder  sdk.TxEncoder
+	protoCodec   codec.ProtoCodecMarshaler
+	eip712Domain Eip712Domain
+}
+
+// ConfigOptions defines the options of the protobuf TxConfig.
+type ConfigOptions struct {
+	// Eip712Domain is the EIP712 domain of SIGN_MODE_EIP_712.
+	Eip712Domain Eip712Domain
+}
+
+// ConfigOption configures the options of the protobuf TxConfig.
+type ConfigOption func(*ConfigOptions)
+
+// WithEip712Domain sets the EIP712 domain of SIGN_MODE_EIP_712, so that forks and
+// testnets do not share the signing domain.
+func WithEip712Domain(domain Eip712Domain) ConfigOption {
+	return func(opts *ConfigOptions) {
+		opts.Eip712Domain = domain
+	}
 }
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
 // first enabled sign mode will become the default sign mode.
 // NOTE: Use NewTxConfigWithHandler to provide a custom signing handler in case the sign mode
 // is not supported by default (eg: SignMode_SIGN_MODE_EIP_191).
-func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode) client.TxConfig {
-	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(enabledSignModes))
+func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode, options ...ConfigOption) client.TxConfig {
+	opts := ConfigOptions{
+		Eip712Domain: DefaultEip712Domain(),
+	}
+	for _, option := range options {
+		option(&opts)
+	}
+	if err := opts.Eip712Domain.Validate(); err != nil {
+		panic(err)
+	}
+
+	txConfig := NewTxConfigWithHandler(protoCodec, makeSignModeHandler(enabledSignModes, opts)).(*config)
+	txConfig.eip712Domain = opts.Eip712Domain
+	return txConfig
 }
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and signing handler.
 func NewTxConfigWithHandler(protoCodec codec.ProtoCodecMarshaler, handler signing.SignModeHandler) client.TxConfig {
 	return &config{
-		handler:     handler,
-		decoder:     DefaultTxDecoder(protoCodec),
-		encoder:     DefaultTxEncoder(),
-		jsonDecoder: DefaultJSONTxDecoder(protoCodec),
-		jsonEncoder: DefaultJSONTxEncoder(protoCodec),
-		protoCodec:  protoCodec,
+		handler:      handler,
+		decoder:      DefaultTxDecoder(protoCodec),
+		encoder:      DefaultTxEncoder(),
+		jsonDecoder:  DefaultJSONTxDecoder(protoCodec),
+		jsonEncoder:  DefaultJSONTxEncoder(protoCodec),
+		protoCodec:   protoCodec,
+		eip712Domain: DefaultEip712Domain(),
 	}
 }
 
@@ -74,3 +105,8 @@ func (g config) TxJSONEncoder() sdk.TxEncoder {
 func (g config) TxJSONDecoder() sdk.TxDecoder {
 	return g.jsonDecoder
 }
+
+// Eip712Domain returns the EIP712 domain of SIGN_MODE_EIP_712.
+func (g config) Eip712Domain() Eip712Domain {
+	return g.eip712Domain
+}
@@ -6,13 +6,14 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
 	"cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"golang.org/x/text/cases"
@@ -30,18 +31,18 @@ import (
 	"github.com/gogo/protobuf/jsonpb"
 )
 
-var domain = &apitypes.TypedDataDomain{
-	Name:              "Greenfield Tx",
-	Version:           "1.0.0",
-	VerifyingContract: "greenfield",
-	Salt:              "0",
+// signModeEip712Handler defines the SIGN_MODE_EIP_712 SignModeHandler
+type signModeEip712Handler struct {
+	domain Eip712Domain
 }
 
-// signModeEip712Handler defines the SIGN_MODE_DIRECT SignModeHandler
-type signModeEip712Handler struct{}
-
 var _ signing.SignModeHandler = signModeEip712Handler{}
 
+// newSignModeEip712Handler returns a SIGN_MODE_EIP_712 SignModeHandler signing with the domain
+func newSignModeEip712Handler(domain Eip712Domain) signModeEip712Handler {
+	return signModeEip712Handler{domain: domain}
+}
+
 // DefaultMode implements SignModeHandler.DefaultMode
 func (signModeEip712Handler) DefaultMode() signingtypes.SignMode {
 	return signingtypes.SignMode_SIGN_MODE_EIP_712
@@ -53,36 +54,48 @@ func (signModeEip712Handler) Modes() []signingtypes.SignMode {
 }
 
 // GetSignBytes implements SignModeHandler.GetSignBytes
-func (signModeEip712Handler) GetSignBytes(mode signingtypes.SignMode, signerData signing.SignerData, tx sdk.Tx) ([]byte, error) {
+func (h signModeEip712Handler) GetSignBytes(mode signingtypes.SignMode, signerData signing.SignerData, tx sdk.Tx) ([]byte, error) {
 	if mode != signingtypes.SignMode_SIGN_MODE_EIP_712 {
 		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_EIP_712, mode)
 	}
 
+	typedData, err := GetTypedData(h.domain, signerData, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// compute the hash
+	sigHash, err := ComputeTypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+
+	return sigHash, nil
+}
+
+// GetTypedData returns the EIP712 typed data of a tx signed by the signer with the domain, it is
+// what SIGN_MODE_EIP_712 hashes and what eth_signTypedData_v4 expects.
+func GetTypedData(domain Eip712Domain, signerData signing.SignerData, tx sdk.Tx) (apitypes.TypedData, error) {
 	// get the EIP155 chainID from the signerData
 	chainID, err := sdk.ParseChainID(signerData.ChainID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse chainID: %s", signerData.ChainID)
+		return apitypes.TypedData{}, fmt.Errorf("failed to parse chainID: %s", signerData.ChainID)
 	}
 
 	// get the EIP712 types and signDoc from the tx
 	msgTypes, signDoc, err := GetMsgTypes(signerData, tx, chainID)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get msg types")
+		return apitypes.TypedData{}, errors.Wrapf(err, "failed to get msg types")
 	}
 
 	// pack the tx data in EIP712 object
-	typedData, err := WrapTxToTypedData(chainID.Uint64(), signDoc, msgTypes)
+	typedDataDomain := domain.TypedDataDomain(chainID.Uint64(), signerData.IsUpgraded)
+	typedData, err := WrapTxToTypedData(typedDataDomain, signDoc, msgTypes)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to pack tx data in EIP712 object")
+		return apitypes.TypedData{}, errors.Wrapf(err, "failed to pack tx data in EIP712 object")
 	}
 
-	// compute the hash
-	sigHash, err := ComputeTypedDataHash(typedData)
-	if err != nil {
-		return nil, err
-	}
-
-	return sigHash, nil
+	return typedData, nil
 }
 
 func GetMsgTypes(signerData signing.SignerData, tx sdk.Tx, typedChainID *big.Int) (apitypes.Types, *types.SignDocEip712, error) {
@@ -91,8 +104,13 @@ func GetMsgTypes(signerData signing.SignerData, tx sdk.Tx, typedChainID *big.Int
 		return nil, nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
 	}
 
-	// construct the signDoc
-	msgAny, _ := codectypes.NewAnyWithValue(protoTx.GetMsgs()[0])
+	msgs := protoTx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, nil, fmt.Errorf("tx has no msgs")
+	}
+
+	// construct the signDoc, the single msg is kept in the msg field so that the sign bytes of
+	// the single msg txs are unchanged
 	signDoc := &types.SignDocEip712{
 		AccountNumber: signerData.AccountNumber,
 		Sequence:      signerData.Sequence,
@@ -106,33 +124,22 @@ func GetMsgTypes(signerData signing.SignerData, tx sdk.Tx, typedChainID *big.Int
 		},
 		Memo: protoTx.GetMemo(),
 		Tip:  protoTx.GetTip(),
-		Msg:  msgAny,
+	}
+	if len(msgs) == 1 {
+		signDoc.Msg, _ = codectypes.NewAnyWithValue(msgs[0])
+	} else {
+		signDoc.Msgs = make([]*codectypes.Any, len(msgs))
+		for i, msg := range msgs {
+			signDoc.Msgs[i], _ = codectypes.NewAnyWithValue(msg)
+		}
 	}
 
 	// extract the msg types
-	msgTypes, err := extractMsgTypes(protoTx.GetMsgs()[0])
+	msgTypes, err := extractMsgTypes(msgs, signDoc.Tip != nil)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// patch the msg types to include `Tip` if it's not empty
-	if signDoc.Tip != nil {
-		msgTypes["Tx"] = []apitypes.Type{
-			{Name: "account_number", Type: "uint256"},
-			{Name: "chain_id", Type: "uint256"},
-			{Name: "fee", Type: "Fee"},
-			{Name: "memo", Type: "string"},
-			{Name: "msg", Type: "Msg"},
-			{Name: "sequence", Type: "uint256"},
-			{Name: "timeout_height", Type: "uint256"},
-			{Name: "tip", Type: "Tip"},
-		}
-		msgTypes["Tip"] = []apitypes.Type{
-			{Name: "amount", Type: "Coin[]"},
-			{Name: "tipper", Type: "string"},
-		}
-	}
-
 	return msgTypes, signDoc, nil
 }
 
@@ -154,8 +161,33 @@ func ComputeTypedDataHash(typedData apitypes.TypedData) ([]byte, error) {
 	return crypto.Keccak256(rawData), nil
 }
 
+// MarshalTypedData returns the JSON encoding of the typed data expected by eth_signTypedData_v4.
+// The bytes values of the message are encoded as 0x-prefixed hex instead of base64, so that the
+// typed data decoded from the JSON hashes the same. The message of the typed data is updated in place.
+func MarshalTypedData(typedData apitypes.TypedData) ([]byte, error) {
+	hexEncodeBytes(typedData.Message)
+	return json.Marshal(typedData)
+}
+
+// hexEncodeBytes replaces the bytes values nested in the value with hexutil.Bytes
+func hexEncodeBytes(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []byte:
+		return hexutil.Bytes(v)
+	case map[string]interface{}:
+		for key, item := range v {
+			v[key] = hexEncodeBytes(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = hexEncodeBytes(item)
+		}
+	}
+	return value
+}
+
 func WrapTxToTypedData(
-	chainID uint64,
+	domain apitypes.TypedDataDomain,
 	signDoc *types.SignDocEip712,
 	msgTypes apitypes.Types,
 ) (apitypes.TypedData, error) {
@@ -177,22 +209,34 @@ func WrapTxToTypedData(
 		delete(txData, "tip")
 	}
 
-	// filling nil value and do other clean up
-	cleanTypesAndMsgValue(msgTypes, "Msg", txData["msg"].(map[string]interface{}))
+	// filling nil value and do other clean up, the msgs of a multi-msg tx are
+	// flattened into the msg1..msgN fields
+	if len(signDoc.Msgs) == 0 {
+		cleanTypesAndMsgValue(msgTypes, "Msg", txData["msg"].(map[string]interface{}))
+	} else {
+		delete(txData, "msg")
+		for i, msgValue := range txData["msgs"].([]interface{}) {
+			txData[msgFieldName(i)] = msgValue
+			cleanTypesAndMsgValue(msgTypes, msgTypeName(i), msgValue.(map[string]interface{}))
+		}
+	}
+	delete(txData, "msgs")
 
-	tempDomain := *domain
-	tempDomain.ChainId = math.NewHexOrDecimal256(int64(chainID))
 	typedData := apitypes.TypedData{
 		Types:       msgTypes,
 		PrimaryType: "Tx",
-		Domain:      tempDomain,
+		Domain:      domain,
 		Message:     txData,
 	}
 
 	return typedData, nil
 }
 
-func extractMsgTypes(msg sdk.Msg) (apitypes.Types, error) {
+// extractMsgTypes returns the EIP712 types of a tx. The msg of a single msg tx is typed as `Msg`,
+// the msgs of a multi-msg tx are typed as `Msg1`..`MsgN`. The nested type definitions of the i-th msg
+// are prefixed with `TypeMsgI`, a tx is still rejected if the type definitions of its msgs conflict with
+// each other or with the root types.
+func extractMsgTypes(msgs []sdk.Msg, hasTip bool) (apitypes.Types, error) {
 	rootTypes := apitypes.Types{
 		"EIP712Domain": {
 			{
@@ -216,15 +260,7 @@ func extractMsgTypes(msg sdk.Msg) (apitypes.Types, error) {
 				Type: "string",
 			},
 		},
-		"Tx": {
-			{Name: "account_number", Type: "uint256"},
-			{Name: "chain_id", Type: "uint256"},
-			{Name: "fee", Type: "Fee"},
-			{Name: "memo", Type: "string"},
-			{Name: "msg", Type: "Msg"},
-			{Name: "sequence", Type: "uint256"},
-			{Name: "timeout_height", Type: "uint256"},
-		},
+		"Tx": txTypes(len(msgs), hasTip),
 		"Fee": {
 			{Name: "amount", Type: "Coin[]"},
 			{Name: "gas_limit", Type: "uint256"},
@@ -235,21 +271,96 @@ func extractMsgTypes(msg sdk.Msg) (apitypes.Types, error) {
 			{Name: "denom", Type: "string"},
 			{Name: "amount", Type: "uint256"},
 		},
-		"Msg": {
-			{Name: "type", Type: "string"},
-		},
+	}
+	if hasTip {
+		rootTypes["Tip"] = []apitypes.Type{
+			{Name: "amount", Type: "Coin[]"},
+			{Name: "tipper", Type: "string"},
+		}
 	}
 
-	if err := walkFields(rootTypes, msg); err != nil {
-		return nil, err
+	if len(msgs) == 1 {
+		rootTypes["Msg"] = []apitypes.Type{{Name: "type", Type: "string"}}
+		if err := walkFields(rootTypes, "Msg", typeDefPrefix, msgs[0]); err != nil {
+			return nil, err
+		}
+		return rootTypes, nil
+	}
+
+	for i, msg := range msgs {
+		typeName := msgTypeName(i)
+		msgTypes := apitypes.Types{
+			typeName: {{Name: "type", Type: "string"}},
+		}
+		if err := walkFields(msgTypes, typeName, fmt.Sprintf("%s.%s", typeDefPrefix, msgFieldName(i)), msg); err != nil {
+			return nil, err
+		}
+		if err := mergeMsgTypes(rootTypes, msgTypes, i); err != nil {
+			return nil, err
+		}
 	}
 
 	return rootTypes, nil
 }
 
+// mergeMsgTypes merges the type definitions of the i-th msg into the root types. The names are merged
+// in sorted order, so the same conflict is always reported for the same tx.
+func mergeMsgTypes(rootTypes, msgTypes apitypes.Types, i int) error {
+	names := make([]string, 0, len(msgTypes))
+	for name := range msgTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fields := msgTypes[name]
+		if existing, ok := rootTypes[name]; ok && !reflect.DeepEqual(existing, fields) {
+			return fmt.Errorf("conflicting EIP712 type definitions of %s in msg %d", name, i+1)
+		}
+		rootTypes[name] = fields
+	}
+
+	return nil
+}
+
+// txTypes returns the EIP712 type of the tx with the given number of msgs
+func txTypes(msgCount int, hasTip bool) []apitypes.Type {
+	fields := []apitypes.Type{
+		{Name: "account_number", Type: "uint256"},
+		{Name: "chain_id", Type: "uint256"},
+		{Name: "fee", Type: "Fee"},
+		{Name: "memo", Type: "string"},
+	}
+	if msgCount == 1 {
+		fields = append(fields, apitypes.Type{Name: "msg", Type: "Msg"})
+	} else {
+		for i := 0; i < msgCount; i++ {
+			fields = append(fields, apitypes.Type{Name: msgFieldName(i), Type: msgTypeName(i)})
+		}
+	}
+	fields = append(fields,
+		apitypes.Type{Name: "sequence", Type: "uint256"},
+		apitypes.Type{Name: "timeout_height", Type: "uint256"},
+	)
+	if hasTip {
+		fields = append(fields, apitypes.Type{Name: "tip", Type: "Tip"})
+	}
+	return fields
+}
+
+// msgFieldName returns the field name of the i-th msg of a multi-msg tx, e.g. msg1
+func msgFieldName(i int) string {
+	return fmt.Sprintf("msg%d", i+1)
+}
+
+// msgTypeName returns the type name of the i-th msg of a multi-msg tx, e.g. Msg1
+func msgTypeName(i int) string {
+	return fmt.Sprintf("Msg%d", i+1)
+}
+
 const typeDefPrefix = "_"
 
-func walkFields(typeMap apitypes.Types, in interface{}) (err error) {
+func walkFields(typeMap apitypes.Types, rootType, rootPrefix string, in interface{}) (err error) {
 	defer doRecover(&err)
 
 	t := reflect.TypeOf(in)
@@ -267,7 +378,7 @@ func walkFields(typeMap apitypes.Types, in interface{}) (err error) {
 		break
 	}
 
-	return traverseFields(typeMap, typeDefPrefix, t, v)
+	return traverseFields(typeMap, rootType, rootPrefix, rootPrefix, t, v)
 }
 
 type anyWrapper struct {
@@ -277,6 +388,8 @@ type anyWrapper struct {
 
 func traverseFields(
 	typeMap apitypes.Types,
+	rootType string,
+	rootPrefix string,
 	prefix string,
 	t reflect.Type,
 	v reflect.Value,
@@ -348,8 +461,8 @@ func traverseFields(
 				}
 			}
 
-			if prefix == typeDefPrefix {
-				typeMap["Msg"] = append(typeMap["Msg"], apitypes.Type{
+			if prefix == rootPrefix {
+				typeMap[rootType] = append(typeMap[rootType], apitypes.Type{
 					Name: fieldName,
 					Type: ethTyp,
 				})
@@ -373,8 +486,8 @@ func traverseFields(
 				fieldTypedef = sanitizeTypedef(fieldPrefix)
 			}
 
-			if prefix == typeDefPrefix {
-				typeMap["Msg"] = append(typeMap["Msg"], apitypes.Type{
+			if prefix == rootPrefix {
+				typeMap[rootType] = append(typeMap[rootType], apitypes.Type{
 					Name: fieldName,
 					Type: fieldTypedef,
 				})
@@ -386,7 +499,7 @@ func traverseFields(
 				})
 			}
 
-			if err := traverseFields(typeMap, fieldPrefix, fieldType, field); err != nil {
+			if err := traverseFields(typeMap, rootType, rootPrefix, fieldPrefix, fieldType, field); err != nil {
 				return err
 			}
 			continue
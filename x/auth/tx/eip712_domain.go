@@ -0,0 +1,111 @@
+package tx
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// Eip712Domain defines the EIP712 domain of SIGN_MODE_EIP_712. The chain id of
+// the domain is not configured here, it is parsed from the chain id of the
+// signer data.
+type Eip712Domain struct {
+	Name              string
+	Version           string
+	VerifyingContract string
+	Salt              string
+
+	// VersionUpgrades bumps the version of the domain when the named upgrades of
+	// x/upgrade are applied, the signatures of the previous version stay
+	// verifiable until the upgrade height. The last applied one in the list wins.
+	VersionUpgrades []Eip712VersionUpgrade
+}
+
+// Eip712VersionUpgrade switches the version of the EIP712 domain once the named
+// upgrade is applied.
+type Eip712VersionUpgrade struct {
+	UpgradeName string
+	Version     string
+}
+
+// DefaultEip712Domain returns the default EIP712 domain of SIGN_MODE_EIP_712.
+func DefaultEip712Domain() Eip712Domain {
+	return Eip712Domain{
+		Name:              "Greenfield Tx",
+		Version:           "1.0.0",
+		VerifyingContract: "greenfield",
+		Salt:              "0",
+	}
+}
+
+// Validate checks that the domain is well-formed.
+func (d Eip712Domain) Validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("empty EIP712 domain name")
+	}
+	if d.Version == "" {
+		return fmt.Errorf("empty EIP712 domain version")
+	}
+
+	for _, upgrade := range d.VersionUpgrades {
+		if upgrade.UpgradeName == "" || upgrade.Version == "" {
+			return fmt.Errorf("invalid EIP712 domain version upgrade: %+v", upgrade)
+		}
+	}
+
+	return nil
+}
+
+// EffectiveVersion returns the version of the domain after the applied upgrades,
+// a nil isUpgraded is treated as none of the upgrades have been applied.
+func (d Eip712Domain) EffectiveVersion(isUpgraded func(name string) bool) string {
+	version := d.Version
+	if isUpgraded == nil {
+		return version
+	}
+
+	for _, upgrade := range d.VersionUpgrades {
+		if isUpgraded(upgrade.UpgradeName) {
+			version = upgrade.Version
+		}
+	}
+	return version
+}
+
+// TypedDataDomain returns the EIP712 typed data domain for the chain id after
+// the applied upgrades.
+func (d Eip712Domain) TypedDataDomain(chainID uint64, isUpgraded func(name string) bool) apitypes.TypedDataDomain {
+	return apitypes.TypedDataDomain{
+		Name:              d.Name,
+		Version:           d.EffectiveVersion(isUpgraded),
+		ChainId:           math.NewHexOrDecimal256(int64(chainID)),
+		VerifyingContract: d.VerifyingContract,
+		Salt:              d.Salt,
+	}
+}
+
+// UpgradeCheckerForVersion returns an upgrade checker under which the effective
+// version of the domain is the given version. It lets the clients, which can't
+// see the applied upgrades, sign with the version queried from a node.
+func (d Eip712Domain) UpgradeCheckerForVersion(version string) (func(name string) bool, error) {
+	// the upgrades up to the last one switching to the version are treated as applied
+	applied := -1
+	for i, upgrade := range d.VersionUpgrades {
+		if upgrade.Version == version {
+			applied = i
+		}
+	}
+	if applied < 0 && d.Version != version {
+		return nil, fmt.Errorf("unknown EIP712 domain version: %s", version)
+	}
+
+	return func(name string) bool {
+		for i := 0; i <= applied; i++ {
+			if d.VersionUpgrades[i].UpgradeName == name {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
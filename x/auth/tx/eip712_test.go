@@ -1,10 +1,12 @@
 package tx
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/stretchr/testify/require"
 
 	sdkmath "cosmossdk.io/math"
@@ -16,10 +18,13 @@ import (
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/group"
+	oracletypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestEIP712Handler(t *testing.T) {
@@ -74,7 +79,7 @@ func TestEIP712Handler(t *testing.T) {
 		PubKey:        pubkey,
 	}
 
-	modeHandler := signModeEip712Handler{}
+	modeHandler := newSignModeEip712Handler(DefaultEip712Domain())
 
 	t.Log("verify invalid chain ID")
 	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
@@ -190,7 +195,7 @@ func TestMoreMsgs(t *testing.T) {
 		Sequence:      accSeq,
 		PubKey:        pubkey,
 	}
-	modeHandler := signModeEip712Handler{}
+	modeHandler := newSignModeEip712Handler(DefaultEip712Domain())
 
 	msgSend := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1))))
 	msgProposal, _ := govtypes.NewMsgSubmitProposal([]sdk.Msg{msgSend}, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1))), "test", "test")
@@ -233,3 +238,227 @@ func TestMoreMsgs(t *testing.T) {
 		require.NotNil(t, signBytes)
 	}
 }
+
+func TestMultiMsgs(t *testing.T) {
+	_, pubkey, addr := testdata.KeyEthSecp256k1TestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &banktypes.MsgSend{})
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &stakingtypes.MsgDelegate{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	txConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712})
+	txBuilder := txConfig.NewTxBuilder()
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	txBuilder.SetGasLimit(20000)
+
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "greenfield_9000-1",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        pubkey,
+	}
+	chainID, err := sdk.ParseChainID(signingData.ChainID)
+	require.NoError(t, err)
+	modeHandler := newSignModeEip712Handler(DefaultEip712Domain())
+
+	msgSend := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1))))
+	msgDelegate := stakingtypes.NewMsgDelegate(addr, addr, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1)))
+
+	t.Log("verify the typed data of a multi-msg tx covers every msg")
+	require.NoError(t, txBuilder.SetMsgs(msgSend, msgDelegate))
+	msgTypes, signDoc, err := GetMsgTypes(signingData, txBuilder.GetTx(), chainID)
+	require.NoError(t, err)
+	typedData, err := WrapTxToTypedData(DefaultEip712Domain().TypedDataDomain(chainID.Uint64(), nil), signDoc, msgTypes)
+	require.NoError(t, err)
+
+	require.NotContains(t, typedData.Types, "Msg")
+	require.NotContains(t, typedData.Message, "msg")
+	for i, msg := range []sdk.Msg{msgSend, msgDelegate} {
+		msgValue, ok := typedData.Message[fmt.Sprintf("msg%d", i+1)].(map[string]interface{})
+		require.True(t, ok)
+		require.Equal(t, sdk.MsgTypeURL(msg), msgValue["type"])
+		require.Contains(t, typedData.Types, fmt.Sprintf("Msg%d", i+1))
+	}
+	require.Contains(t, typedData.Types, "TypeMsg1Amount")
+	require.Contains(t, typedData.Types, "TypeMsg2Amount")
+
+	multiMsgSignBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	t.Log("verify the sign bytes depend on every msg and their order")
+	require.NoError(t, txBuilder.SetMsgs(msgSend))
+	singleMsgSignBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotEqual(t, singleMsgSignBytes, multiMsgSignBytes)
+
+	require.NoError(t, txBuilder.SetMsgs(msgDelegate, msgSend))
+	reorderedSignBytes, err := modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotEqual(t, multiMsgSignBytes, reorderedSignBytes)
+
+	t.Log("verify the tx without msgs is rejected")
+	require.NoError(t, txBuilder.SetMsgs())
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.Error(t, err)
+}
+
+func TestMergeMsgTypes(t *testing.T) {
+	rootTypes := apitypes.Types{
+		"Coin": {
+			{Name: "denom", Type: "string"},
+			{Name: "amount", Type: "uint256"},
+		},
+	}
+
+	t.Log("verify the same type definitions are merged")
+	msgTypes := apitypes.Types{
+		"Msg1":           {{Name: "type", Type: "string"}, {Name: "amount", Type: "TypeMsg1Amount[]"}},
+		"TypeMsg1Amount": {{Name: "denom", Type: "string"}},
+		"Coin": {
+			{Name: "denom", Type: "string"},
+			{Name: "amount", Type: "uint256"},
+		},
+	}
+	require.NoError(t, mergeMsgTypes(rootTypes, msgTypes, 0))
+	require.Contains(t, rootTypes, "Msg1")
+	require.Contains(t, rootTypes, "TypeMsg1Amount")
+
+	t.Log("verify the conflicting type definitions are rejected in a deterministic order")
+	msgTypes = apitypes.Types{
+		"Msg2":           {{Name: "type", Type: "string"}},
+		"TypeMsg1Amount": {{Name: "amount", Type: "uint256"}},
+		"Coin":           {{Name: "denom", Type: "string"}},
+	}
+	for i := 0; i < 10; i++ {
+		err := mergeMsgTypes(rootTypes, msgTypes, 1)
+		require.EqualError(t, err, "conflicting EIP712 type definitions of Coin in msg 2")
+	}
+}
+
+func TestEip712Domain(t *testing.T) {
+	_, pubkey, addr := testdata.KeyEthSecp256k1TestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &banktypes.MsgSend{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	txConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712})
+	txBuilder := txConfig.NewTxBuilder()
+	msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1))))
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+	txBuilder.SetGasLimit(20000)
+
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "greenfield_9000-1",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        pubkey,
+	}
+
+	defaultSignBytes, err := txConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	t.Log("verify the sign bytes depend on the configured domain")
+	domain := DefaultEip712Domain()
+	domain.Name = "Greenfield Testnet Tx"
+	testnetConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712}, WithEip712Domain(domain))
+	testnetSignBytes, err := testnetConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotEqual(t, defaultSignBytes, testnetSignBytes)
+
+	t.Log("verify the version is bumped only after the upgrade is applied")
+	domain = DefaultEip712Domain()
+	domain.VersionUpgrades = []Eip712VersionUpgrade{{UpgradeName: "v2", Version: "2.0.0"}}
+	upgradeConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712}, WithEip712Domain(domain))
+	require.Equal(t, domain, upgradeConfig.(*config).Eip712Domain())
+
+	signingData.IsUpgraded = func(name string) bool { return false }
+	signBytes, err := upgradeConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Equal(t, defaultSignBytes, signBytes)
+
+	signingData.IsUpgraded = func(name string) bool { return name == "v2" }
+	signBytes, err = upgradeConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotEqual(t, defaultSignBytes, signBytes)
+	require.Equal(t, "2.0.0", domain.EffectiveVersion(signingData.IsUpgraded))
+
+	t.Log("verify the upgrade checker is resolved from the version")
+	isUpgraded, err := domain.UpgradeCheckerForVersion("1.0.0")
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0", domain.EffectiveVersion(isUpgraded))
+	isUpgraded, err = domain.UpgradeCheckerForVersion("2.0.0")
+	require.NoError(t, err)
+	require.Equal(t, "2.0.0", domain.EffectiveVersion(isUpgraded))
+	_, err = domain.UpgradeCheckerForVersion("3.0.0")
+	require.Error(t, err)
+
+	t.Log("verify the invalid domain is rejected")
+	require.Panics(t, func() {
+		NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712}, WithEip712Domain(Eip712Domain{}))
+	})
+}
+
+func TestMarshalTypedData(t *testing.T) {
+	_, pubkey, addr := testdata.KeyEthSecp256k1TestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &banktypes.MsgSend{}, &oracletypes.MsgClaim{}, &authz.MsgExec{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+	txConfig := NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712})
+
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "greenfield_9000-1",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        pubkey,
+	}
+
+	msgExec := authz.NewMsgExec(addr, []sdk.Msg{
+		banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1)))),
+	})
+	testCases := []struct {
+		name string
+		msg  sdk.Msg
+	}{
+		{
+			"msg with bytes fields",
+			&oracletypes.MsgClaim{
+				FromAddress:    addr.String(),
+				SrcChainId:     1,
+				DestChainId:    2,
+				Sequence:       3,
+				Timestamp:      4,
+				Payload:        []byte("payload"),
+				VoteAddressSet: []uint64{1, 2},
+				AggSignature:   []byte("signature"),
+			},
+		},
+		{"msg with any fields", &msgExec},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msg))
+			txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 150)))
+			txBuilder.SetGasLimit(20000)
+
+			typedData, err := GetTypedData(DefaultEip712Domain(), signingData, txBuilder.GetTx())
+			require.NoError(t, err)
+			bz, err := MarshalTypedData(typedData)
+			require.NoError(t, err)
+
+			// the typed data decoded from the JSON hashes to the sign bytes
+			var decoded apitypes.TypedData
+			require.NoError(t, json.Unmarshal(bz, &decoded))
+			sigHash, err := ComputeTypedDataHash(decoded)
+			require.NoError(t, err)
+
+			signBytes, err := txConfig.SignModeHandler().GetSignBytes(signingtypes.SignMode_SIGN_MODE_EIP_712, signingData, txBuilder.GetTx())
+			require.NoError(t, err)
+			require.Equal(t, signBytes, sigHash)
+		})
+	}
+}
@@ -16,8 +16,8 @@ var DefaultSignModes = []signingtypes.SignMode{
 }
 
 // makeSignModeHandler returns the default protobuf SignModeHandler supporting
-// SIGN_MODE_DIRECT, SIGN_MODE_DIRECT_AUX and SIGN_MODE_LEGACY_AMINO_JSON.
-func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler {
+// SIGN_MODE_DIRECT, SIGN_MODE_DIRECT_AUX, SIGN_MODE_LEGACY_AMINO_JSON and SIGN_MODE_EIP_712.
+func makeSignModeHandler(modes []signingtypes.SignMode, opts ConfigOptions) signing.SignModeHandler {
 	if len(modes) < 1 {
 		panic(fmt.Errorf("no sign modes enabled"))
 	}
@@ -33,7 +33,7 @@ func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler
 		case signingtypes.SignMode_SIGN_MODE_DIRECT_AUX:
 			handlers[i] = signModeDirectAuxHandler{}
 		case signingtypes.SignMode_SIGN_MODE_EIP_712:
-			handlers[i] = signModeEip712Handler{}
+			handlers[i] = newSignModeEip712Handler(opts.Eip712Domain)
 		default:
 			panic(fmt.Errorf("unsupported sign mode %+v", mode))
 		}
@@ -20,6 +20,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	querytypes "github.com/cosmos/cosmos-sdk/types/query"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
@@ -263,6 +264,80 @@ func (s txServer) BroadcastTx(ctx context.Context, req *txtypes.BroadcastTxReque
 	return client.TxServiceBroadcast(ctx, s.clientCtx, req)
 }
 
+// eip712DomainConfig is implemented by the TxConfigs supporting SIGN_MODE_EIP_712.
+type eip712DomainConfig interface {
+	Eip712Domain() Eip712Domain
+}
+
+// GetEip712Domain implements the ServiceServer.GetEip712Domain RPC method.
+func (s txServer) GetEip712Domain(ctx context.Context, req *txtypes.GetEip712DomainRequest) (*txtypes.GetEip712DomainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	domainConfig, ok := s.clientCtx.TxConfig.(eip712DomainConfig)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "EIP712 domain is not supported by the tx config")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	chainID, err := sdk.ParseChainID(sdkCtx.ChainID())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse chainID: %s", sdkCtx.ChainID())
+	}
+
+	domain := domainConfig.Eip712Domain()
+	return &txtypes.GetEip712DomainResponse{
+		Name:              domain.Name,
+		Version:           domain.EffectiveVersion(sdkCtx.IsUpgraded),
+		ChainId:           chainID.Uint64(),
+		VerifyingContract: domain.VerifyingContract,
+		Salt:              domain.Salt,
+	}, nil
+}
+
+// GetEip712TypedData implements the ServiceServer.GetEip712TypedData RPC method.
+func (s txServer) GetEip712TypedData(ctx context.Context, req *txtypes.GetEip712TypedDataRequest) (*txtypes.GetEip712TypedDataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if req.TxBytes == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty txBytes is not allowed")
+	}
+
+	domainConfig, ok := s.clientCtx.TxConfig.(eip712DomainConfig)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "EIP712 domain is not supported by the tx config")
+	}
+
+	tx, err := s.clientCtx.TxConfig.TxDecoder()(req.TxBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx; %v", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	signerData := signing.SignerData{
+		ChainID:       sdkCtx.ChainID(),
+		AccountNumber: req.AccountNumber,
+		Sequence:      req.Sequence,
+		IsUpgraded:    sdkCtx.IsUpgraded,
+	}
+	typedData, err := GetTypedData(domainConfig.Eip712Domain(), signerData, tx)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to get EIP712 typed data; %v", err)
+	}
+
+	bz, err := MarshalTypedData(typedData)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal EIP712 typed data; %v", err)
+	}
+
+	return &txtypes.GetEip712TypedDataResponse{
+		TypedData: string(bz),
+	}, nil
+}
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
@@ -5,9 +5,11 @@ package tx_test
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
@@ -23,6 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -757,6 +760,95 @@ func (s IntegrationTestSuite) TestGetBlockWithTxs_GRPCGateway() {
 	}
 }
 
+func (s IntegrationTestSuite) TestGetEip712Domain_GRPC() {
+	chainID, err := sdk.ParseChainID(s.cfg.ChainID)
+	s.Require().NoError(err)
+
+	domain := authtx.DefaultEip712Domain()
+	res, err := s.queryClient.GetEip712Domain(context.Background(), &tx.GetEip712DomainRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(domain.Name, res.Name)
+	s.Require().Equal(domain.Version, res.Version)
+	s.Require().Equal(chainID.Uint64(), res.ChainId)
+	s.Require().Equal(domain.VerifyingContract, res.VerifyingContract)
+	s.Require().Equal(domain.Salt, res.Salt)
+}
+
+func (s IntegrationTestSuite) TestGetEip712Domain_GRPCGateway() {
+	val := s.network.Validators[0]
+	res, err := rest.GetRequest(fmt.Sprintf("%s/cosmos/tx/v1beta1/eip712_domain", val.APIAddress))
+	s.Require().NoError(err)
+
+	var result tx.GetEip712DomainResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(res, &result))
+	s.Require().Equal(authtx.DefaultEip712Domain().Name, result.Name)
+	s.Require().Equal(authtx.DefaultEip712Domain().Version, result.Version)
+}
+
+func (s IntegrationTestSuite) TestGetEip712TypedData_GRPC() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkUnsignedTxBuilder()
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *tx.GetEip712TypedDataRequest
+		expErr    bool
+		expErrMsg string
+	}{
+		{"nil request", nil, true, "request cannot be nil"},
+		{"empty request", &tx.GetEip712TypedDataRequest{}, true, "empty txBytes is not allowed"},
+		{"invalid tx bytes", &tx.GetEip712TypedDataRequest{TxBytes: []byte("invalid")}, true, "invalid tx"},
+		{"valid request", &tx.GetEip712TypedDataRequest{TxBytes: txBytes, AccountNumber: 1, Sequence: 2}, false, ""},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.queryClient.GetEip712TypedData(context.Background(), tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			s.Require().NoError(err)
+
+			// the typed data hashes to the sign bytes verified by the chain
+			var typedData apitypes.TypedData
+			s.Require().NoError(json.Unmarshal([]byte(res.TypedData), &typedData))
+			sigHash, err := authtx.ComputeTypedDataHash(typedData)
+			s.Require().NoError(err)
+
+			signBytes, err := val.ClientCtx.TxConfig.SignModeHandler().GetSignBytes(signing.SignMode_SIGN_MODE_EIP_712, authsigning.SignerData{
+				ChainID:       s.cfg.ChainID,
+				AccountNumber: tc.req.AccountNumber,
+				Sequence:      tc.req.Sequence,
+			}, txBuilder.GetTx())
+			s.Require().NoError(err)
+			s.Require().Equal(signBytes, sigHash)
+		})
+	}
+}
+
+func (s IntegrationTestSuite) TestGetEip712TypedData_GRPCGateway() {
+	val := s.network.Validators[0]
+	txBuilder := s.mkUnsignedTxBuilder()
+	txBytes, err := val.ClientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	req, err := val.ClientCtx.Codec.MarshalJSON(&tx.GetEip712TypedDataRequest{TxBytes: txBytes, AccountNumber: 1, Sequence: 2})
+	s.Require().NoError(err)
+	res, err := rest.PostRequest(fmt.Sprintf("%s/cosmos/tx/v1beta1/eip712_typed_data", val.APIAddress), "application/json", req)
+	s.Require().NoError(err)
+
+	var result tx.GetEip712TypedDataResponse
+	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(res, &result))
+
+	var typedData apitypes.TypedData
+	s.Require().NoError(json.Unmarshal([]byte(result.TypedData), &typedData))
+	s.Require().Equal("Tx", typedData.PrimaryType)
+	s.Require().Equal("foobar", typedData.Message["memo"])
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -765,6 +857,25 @@ func (s IntegrationTestSuite) mkTxBuilder() client.TxBuilder {
 	val := s.network.Validators[0]
 	s.Require().NoError(s.network.WaitForNextBlock())
 
+	txBuilder := s.mkUnsignedTxBuilder()
+
+	// setup txFactory
+	txFactory := clienttx.Factory{}.
+		WithChainID(val.ClientCtx.ChainID).
+		WithKeybase(val.ClientCtx.Keyring).
+		WithTxConfig(val.ClientCtx.TxConfig)
+	// WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+
+	// Sign Tx.
+	err := authclient.SignTx(txFactory, val.ClientCtx, val.Moniker, txBuilder, false, true)
+	s.Require().NoError(err)
+
+	return txBuilder
+}
+
+func (s IntegrationTestSuite) mkUnsignedTxBuilder() client.TxBuilder {
+	val := s.network.Validators[0]
+
 	// prepare txBuilder with msg
 	txBuilder := val.ClientCtx.TxConfig.NewTxBuilder()
 	feeAmount := sdk.Coins{sdk.NewInt64Coin(s.cfg.BondDenom, 10)}
@@ -780,17 +891,6 @@ func (s IntegrationTestSuite) mkTxBuilder() client.TxBuilder {
 	txBuilder.SetGasLimit(gasLimit)
 	txBuilder.SetMemo("foobar")
 
-	// setup txFactory
-	txFactory := clienttx.Factory{}.
-		WithChainID(val.ClientCtx.ChainID).
-		WithKeybase(val.ClientCtx.Keyring).
-		WithTxConfig(val.ClientCtx.TxConfig)
-	// WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
-
-	// Sign Tx.
-	err := authclient.SignTx(txFactory, val.ClientCtx, val.Moniker, txBuilder, false, true)
-	s.Require().NoError(err)
-
 	return txBuilder
 }
 
@@ -0,0 +1,17 @@
+package crosschain
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/keeper"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
+)
+
+// EndBlocker called every block, executes the timeout callbacks of the timed out syn packages.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.ProcessTimeoutPackages(ctx)
+}
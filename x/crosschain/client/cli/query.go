@@ -1,12 +1,15 @@
 package cli
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
 )
 
@@ -22,6 +25,11 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		QueryParamsCmd(),
+		QueryChannelsCmd(),
+		QueryChannelCmd(),
+		QueryPackageProofCmd(),
+		QuerySendSequenceProofCmd(),
+		QueryReceiveSequenceProofCmd(),
 	)
 
 	return cmd
@@ -57,3 +65,217 @@ $ <appd> query crosschain params
 
 	return cmd
 }
+
+// QueryChannelsCmd returns the command handler for querying all the registered channels.
+func QueryChannelsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channels",
+		Short: "Query all the registered channels",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query all the registered channels along with their send permissions and sequences
+for each supported dest chain:
+
+$ <appd> query crosschain channels
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Channels(cmd.Context(), &types.QueryChannelsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryChannelCmd returns the command handler for querying a registered channel.
+func QueryChannelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "channel [channel-id]",
+		Short: "Query a registered channel",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(`Query a registered channel along with its send permissions and sequences
+for each supported dest chain:
+
+$ <appd> query crosschain channel 1
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			channelID, err := parseChannelID(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Channel(cmd.Context(), &types.QueryChannelRequest{ChannelId: uint32(channelID)})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Channel)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryPackageProofCmd returns the command handler for querying a cross chain package with its merkle proof.
+func QueryPackageProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "package-proof [src-chain-id] [dest-chain-id] [channel-id] [sequence]",
+		Short: "Query a cross chain package along with its merkle proof",
+		Args:  cobra.ExactArgs(4),
+		Long: strings.TrimSpace(`Query a cross chain package along with its ICS23 merkle proof against the app hash,
+an absence proof is returned if the package does not exist. The proof of the state at height H should be
+verified against the app hash in the header of height H+1:
+
+$ <appd> query crosschain package-proof 1 56 1 100 --height 1000
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			srcChainID, err := parseChainID(args[0])
+			if err != nil {
+				return err
+			}
+			destChainID, err := parseChainID(args[1])
+			if err != nil {
+				return err
+			}
+			channelID, err := parseChannelID(args[2])
+			if err != nil {
+				return err
+			}
+			sequence, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			proof, err := utils.QueryCrossChainPackageProof(clientCtx, srcChainID, destChainID, channelID, sequence, clientCtx.Height)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(proof)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QuerySendSequenceProofCmd returns the command handler for querying the send sequence of a channel with its merkle proof.
+func QuerySendSequenceProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-sequence-proof [dest-chain-id] [channel-id]",
+		Short: "Query the send sequence of a channel along with its merkle proof",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(`Query the send sequence of a channel along with its ICS23 merkle proof against the app hash,
+an absence proof is returned if no package has been sent in the channel:
+
+$ <appd> query crosschain send-sequence-proof 56 1 --height 1000
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			destChainID, err := parseChainID(args[0])
+			if err != nil {
+				return err
+			}
+			channelID, err := parseChannelID(args[1])
+			if err != nil {
+				return err
+			}
+
+			proof, err := utils.QuerySendSequenceProof(clientCtx, destChainID, channelID, clientCtx.Height)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(proof)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryReceiveSequenceProofCmd returns the command handler for querying the receive sequence of a channel with its merkle proof.
+func QueryReceiveSequenceProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "receive-sequence-proof [dest-chain-id] [channel-id]",
+		Short: "Query the receive sequence of a channel along with its merkle proof",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(`Query the receive sequence of a channel along with its ICS23 merkle proof against the app hash,
+an absence proof is returned if no package has been received in the channel:
+
+$ <appd> query crosschain receive-sequence-proof 56 1 --height 1000
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			destChainID, err := parseChainID(args[0])
+			if err != nil {
+				return err
+			}
+			channelID, err := parseChannelID(args[1])
+			if err != nil {
+				return err
+			}
+
+			proof, err := utils.QueryReceiveSequenceProof(clientCtx, destChainID, channelID, clientCtx.Height)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(proof)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseChainID(arg string) (sdk.ChainID, error) {
+	chainID, err := strconv.ParseUint(arg, 10, 16)
+	if err != nil {
+		return 0, err
+	}
+	return sdk.ChainID(chainID), nil
+}
+
+func parseChannelID(arg string) (sdk.ChannelID, error) {
+	channelID, err := strconv.ParseUint(arg, 10, 8)
+	if err != nil {
+		return 0, err
+	}
+	return sdk.ChannelID(channelID), nil
+}
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
+)
+
+// QueryCrossChainPackageProof queries a cross chain package at the given height along with its merkle proof,
+// an absence proof is returned if the package does not exist.
+func QueryCrossChainPackageProof(clientCtx client.Context, srcChainID, destChainID sdk.ChainID, channelID sdk.ChannelID,
+	sequence uint64, height int64,
+) (*types.CrossChainStoreProof, error) {
+	return QueryStoreProof(clientCtx, types.BuildCrossChainPackageKey(srcChainID, destChainID, channelID, sequence), height)
+}
+
+// QuerySendSequenceProof queries the send sequence of a channel at the given height along with its merkle proof,
+// an absence proof is returned if no package has been sent in the channel.
+func QuerySendSequenceProof(clientCtx client.Context, destChainID sdk.ChainID, channelID sdk.ChannelID, height int64) (*types.CrossChainStoreProof, error) {
+	return QueryStoreProof(clientCtx, types.BuildChannelSequenceKey(destChainID, channelID, types.PrefixForSendSequenceKey), height)
+}
+
+// QueryReceiveSequenceProof queries the receive sequence of a channel at the given height along with its merkle proof,
+// an absence proof is returned if no package has been received in the channel.
+func QueryReceiveSequenceProof(clientCtx client.Context, destChainID sdk.ChainID, channelID sdk.ChannelID, height int64) (*types.CrossChainStoreProof, error) {
+	return QueryStoreProof(clientCtx, types.BuildChannelSequenceKey(destChainID, channelID, types.PrefixForReceiveSequenceKey), height)
+}
+
+// QueryStoreProof queries the value of a key in the crosschain store at the given height along with its merkle proof.
+// The latest height is used if height is 0.
+func QueryStoreProof(clientCtx client.Context, key []byte, height int64) (*types.CrossChainStoreProof, error) {
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:   fmt.Sprintf("/store/%s/key", types.StoreKey),
+		Data:   key,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.ProofOps == nil || len(res.ProofOps.Ops) == 0 {
+		return nil, fmt.Errorf("empty proof for key %X at height %d", key, res.Height)
+	}
+
+	return &types.CrossChainStoreProof{
+		Key:      key,
+		Value:    res.Value,
+		ProofOps: res.ProofOps,
+		Height:   res.Height,
+	}, nil
+}
+
+// VerifyStoreProof verifies the proof against the app hash of the block at proof.Height + 1.
+// The existence of the value is verified if it is not empty, otherwise the absence of the key is verified.
+func VerifyStoreProof(proof *types.CrossChainStoreProof, appHash []byte) error {
+	if proof.ProofOps == nil {
+		return fmt.Errorf("empty proof")
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(types.StoreKey), merkle.KeyEncodingURL).
+		AppendKey(proof.Key, merkle.KeyEncodingURL).
+		String()
+
+	prt := rootmulti.DefaultProofRuntime()
+	if len(proof.Value) == 0 {
+		return prt.VerifyAbsence(proof.ProofOps, appHash, keyPath)
+	}
+	return prt.VerifyValue(proof.ProofOps, appHash, keyPath, proof.Value)
+}
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
+)
+
+func TestVerifyStoreProof(t *testing.T) {
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	store.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadVersion(0))
+
+	packageKey := types.BuildCrossChainPackageKey(sdk.ChainID(1), sdk.ChainID(56), sdk.ChannelID(1), 0)
+	sequenceKey := types.BuildChannelSequenceKey(sdk.ChainID(56), sdk.ChannelID(1), types.PrefixForSendSequenceKey)
+	sequenceBytes := make([]byte, types.SequenceLength)
+	binary.BigEndian.PutUint64(sequenceBytes, 1)
+
+	kvStore := store.GetCommitKVStore(storeKey)
+	kvStore.Set(packageKey, []byte("test package"))
+	kvStore.Set(sequenceKey, sequenceBytes)
+	cid := store.Commit()
+
+	queryProof := func(key []byte) *types.CrossChainStoreProof {
+		res := store.Query(abci.RequestQuery{
+			Path:  "/" + types.StoreKey + "/key",
+			Data:  key,
+			Prove: true,
+		})
+		require.NotNil(t, res.ProofOps)
+		return &types.CrossChainStoreProof{Key: key, Value: res.Value, ProofOps: res.ProofOps, Height: res.Height}
+	}
+
+	// existence of package
+	proof := queryProof(packageKey)
+	require.Equal(t, []byte("test package"), proof.Value)
+	require.NoError(t, VerifyStoreProof(proof, cid.Hash))
+
+	// existence of sequence
+	proof = queryProof(sequenceKey)
+	require.Equal(t, sequenceBytes, proof.Value)
+	require.NoError(t, VerifyStoreProof(proof, cid.Hash))
+
+	// tampered value
+	proof.Value = []byte("invalid value")
+	require.Error(t, VerifyStoreProof(proof, cid.Hash))
+
+	// absence of package
+	proof = queryProof(types.BuildCrossChainPackageKey(sdk.ChainID(1), sdk.ChainID(56), sdk.ChannelID(1), 1))
+	require.Empty(t, proof.Value)
+	require.NoError(t, VerifyStoreProof(proof, cid.Hash))
+
+	// wrong app hash
+	require.Error(t, VerifyStoreProof(proof, []byte("invalid app hash")))
+}
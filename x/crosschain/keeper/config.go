@@ -4,19 +4,22 @@ import sdk "github.com/cosmos/cosmos-sdk/types"
 
 type crossChainConfig struct {
 	srcChainID      sdk.ChainID
-	destChainId     sdk.ChainID
+	destChainIDs    []sdk.ChainID
 	nameToChannelID map[string]sdk.ChannelID
 	channelIDToName map[sdk.ChannelID]string
 	channelIDToApp  map[sdk.ChannelID]sdk.CrossChainApplication
+	// destChainChannelIDToApp overrides the app of a channel for a specific dest chain
+	destChainChannelIDToApp map[sdk.ChainID]map[sdk.ChannelID]sdk.CrossChainApplication
 }
 
 func newCrossChainCfg() *crossChainConfig {
 	config := &crossChainConfig{
-		srcChainID:      0,
-		destChainId:     0,
-		nameToChannelID: make(map[string]sdk.ChannelID),
-		channelIDToName: make(map[sdk.ChannelID]string),
-		channelIDToApp:  make(map[sdk.ChannelID]sdk.CrossChainApplication),
+		srcChainID:              0,
+		destChainIDs:            make([]sdk.ChainID, 0),
+		nameToChannelID:         make(map[string]sdk.ChannelID),
+		channelIDToName:         make(map[sdk.ChannelID]string),
+		channelIDToApp:          make(map[sdk.ChannelID]sdk.CrossChainApplication),
+		destChainChannelIDToApp: make(map[sdk.ChainID]map[sdk.ChannelID]sdk.CrossChainApplication),
 	}
 	return config
 }
@@ -1,9 +1,15 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"encoding/binary"
+	"math"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -25,8 +31,12 @@ func (k Keeper) CrossChainPackage(c context.Context, req *types.QueryCrossChainP
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	if !k.IsDestChainSupported(sdk.ChainID(req.DestChainId)) {
+		return nil, status.Errorf(codes.InvalidArgument, "dest chain %d is not supported", req.DestChainId)
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
-	pack, err := k.GetCrossChainPackage(ctx, sdk.ChannelID(req.ChannelId), req.Sequence)
+	pack, err := k.GetCrossChainPackage(ctx, sdk.ChainID(req.DestChainId), sdk.ChannelID(req.ChannelId), req.Sequence)
 	if err != nil {
 		return nil, err
 	}
@@ -35,14 +45,130 @@ func (k Keeper) CrossChainPackage(c context.Context, req *types.QueryCrossChainP
 	}, nil
 }
 
+// CrossChainPackages returns the cross chain packages of the channel within a sequence range
+func (k Keeper) CrossChainPackages(c context.Context, req *types.QueryCrossChainPackagesRequest) (*types.QueryCrossChainPackagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if !k.IsDestChainSupported(sdk.ChainID(req.DestChainId)) {
+		return nil, status.Errorf(codes.InvalidArgument, "dest chain %d is not supported", req.DestChainId)
+	}
+
+	if req.ChannelId > math.MaxUint8 {
+		return nil, status.Errorf(codes.InvalidArgument, "channel id %d is invalid", req.ChannelId)
+	}
+
+	if req.EndSequence != 0 && req.EndSequence <= req.StartSequence {
+		return nil, status.Errorf(codes.InvalidArgument, "end sequence %d should be larger than start sequence %d", req.EndSequence, req.StartSequence)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	packageStore := newSequenceRangeStore(prefix.NewStore(ctx.KVStore(k.storeKey),
+		types.BuildCrossChainPackagePrefix(k.GetSrcChainID(), sdk.ChainID(req.DestChainId), sdk.ChannelID(req.ChannelId))),
+		req.StartSequence, req.EndSequence)
+
+	packages := make([]types.CrossChainPackageInfo, 0)
+	pageRes, err := query.Paginate(packageStore, req.Pagination, func(key []byte, value []byte) error {
+		header, err := sdk.DecodePackageHeader(value)
+		if err != nil {
+			return err
+		}
+		packages = append(packages, types.CrossChainPackageInfo{
+			Sequence:         binary.BigEndian.Uint64(key),
+			PackageType:      uint32(header.PackageType),
+			Timestamp:        header.Timestamp,
+			RelayerFee:       header.RelayerFee.String(),
+			AckRelayerFee:    header.AckRelayerFee.String(),
+			Package:          value,
+			SynSequence:      header.SynSequence,
+			TimeoutTimestamp: header.TimeoutTimestamp,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCrossChainPackagesResponse{
+		Packages:   packages,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Channels returns all the registered channels
+func (k Keeper) Channels(c context.Context, req *types.QueryChannelsRequest) (*types.QueryChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	channelIDs := k.GetRegisteredChannelIDs()
+	channels := make([]types.ChannelInfo, 0, len(channelIDs))
+	for _, channelID := range channelIDs {
+		channels = append(channels, k.getChannelInfo(ctx, channelID))
+	}
+
+	return &types.QueryChannelsResponse{
+		Channels: channels,
+	}, nil
+}
+
+// Channel returns the specified registered channel
+func (k Keeper) Channel(c context.Context, req *types.QueryChannelRequest) (*types.QueryChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ChannelId > math.MaxUint8 {
+		return nil, status.Errorf(codes.InvalidArgument, "channel id %d is invalid", req.ChannelId)
+	}
+
+	if _, ok := k.GetChannelName(sdk.ChannelID(req.ChannelId)); !ok {
+		return nil, status.Errorf(codes.NotFound, "channel %d is not registered", req.ChannelId)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryChannelResponse{
+		Channel: k.getChannelInfo(ctx, sdk.ChannelID(req.ChannelId)),
+	}, nil
+}
+
+// getChannelInfo returns the metadata of a registered channel for all the supported dest chains
+func (k Keeper) getChannelInfo(ctx sdk.Context, channelID sdk.ChannelID) types.ChannelInfo {
+	name, _ := k.GetChannelName(channelID)
+
+	destChainIDs := k.GetDestChainIDs()
+	destChains := make([]types.ChannelDestChainInfo, 0, len(destChainIDs))
+	for _, destChainID := range destChainIDs {
+		destChains = append(destChains, types.ChannelDestChainInfo{
+			DestChainId:     uint32(destChainID),
+			SendPermission:  uint32(k.GetChannelSendPermission(ctx, destChainID, channelID)),
+			SendSequence:    k.GetSendSequence(ctx, destChainID, channelID),
+			ReceiveSequence: k.GetReceiveSequence(ctx, destChainID, channelID),
+			AppBound:        k.GetCrossChainApp(destChainID, channelID) != nil,
+		})
+	}
+
+	return types.ChannelInfo{
+		ChannelId:  uint32(channelID),
+		Name:       name,
+		DestChains: destChains,
+	}
+}
+
 // SendSequence returns the send sequence of the channel
 func (k Keeper) SendSequence(c context.Context, req *types.QuerySendSequenceRequest) (*types.QuerySendSequenceResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	if !k.IsDestChainSupported(sdk.ChainID(req.DestChainId)) {
+		return nil, status.Errorf(codes.InvalidArgument, "dest chain %d is not supported", req.DestChainId)
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
-	sequence := k.GetSendSequence(ctx, sdk.ChannelID(req.ChannelId))
+	sequence := k.GetSendSequence(ctx, sdk.ChainID(req.DestChainId), sdk.ChannelID(req.ChannelId))
 
 	return &types.QuerySendSequenceResponse{
 		Sequence: sequence,
@@ -55,10 +181,63 @@ func (k Keeper) ReceiveSequence(c context.Context, req *types.QueryReceiveSequen
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	if !k.IsDestChainSupported(sdk.ChainID(req.DestChainId)) {
+		return nil, status.Errorf(codes.InvalidArgument, "dest chain %d is not supported", req.DestChainId)
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
-	sequence := k.GetReceiveSequence(ctx, sdk.ChannelID(req.ChannelId))
+	sequence := k.GetReceiveSequence(ctx, sdk.ChainID(req.DestChainId), sdk.ChannelID(req.ChannelId))
 
 	return &types.QueryReceiveSequenceResponse{
 		Sequence: sequence,
 	}, nil
 }
+
+// sequenceRangeStore bounds the iterators of a package store, whose keys are the big endian encoded sequences, to the
+// sequences within [start, end), so that paginating over it never walks the packages out of the range
+type sequenceRangeStore struct {
+	storetypes.KVStore
+
+	start []byte
+	end   []byte
+}
+
+// newSequenceRangeStore returns a package store bounded to the sequences within [startSequence, endSequence), 0 end
+// sequence means no upper bound
+func newSequenceRangeStore(store storetypes.KVStore, startSequence, endSequence uint64) sequenceRangeStore {
+	rangeStore := sequenceRangeStore{
+		KVStore: store,
+		start:   make([]byte, types.SequenceLength),
+	}
+	binary.BigEndian.PutUint64(rangeStore.start, startSequence)
+	if endSequence != 0 {
+		rangeStore.end = make([]byte, types.SequenceLength)
+		binary.BigEndian.PutUint64(rangeStore.end, endSequence)
+	}
+	return rangeStore
+}
+
+func (s sequenceRangeStore) Iterator(start, end []byte) storetypes.Iterator {
+	start, end = s.bound(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s sequenceRangeStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	start, end = s.bound(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// bound narrows the iteration domain [start, end) into the sequence range of the store
+func (s sequenceRangeStore) bound(start, end []byte) ([]byte, []byte) {
+	if start == nil || bytes.Compare(start, s.start) < 0 {
+		start = s.start
+	}
+	if s.end != nil && (end == nil || bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+	// an empty domain
+	if end != nil && bytes.Compare(start, end) > 0 {
+		start = end
+	}
+	return start, end
+}
@@ -2,7 +2,11 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"math/big"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/testutil"
 	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
 )
 
@@ -12,3 +16,142 @@ func (s *TestSuite) TestQueryParams() {
 	s.Require().NotNil(res)
 	s.Require().Equal(s.app.CrossChainKeeper.GetParams(s.ctx), res.GetParams())
 }
+
+func (s *TestSuite) TestQueryCrossChainPackages() {
+	s.app.CrossChainKeeper.SetChannelSendPermission(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sdk.ChannelAllow)
+	for i := 0; i < 5; i++ {
+		_, err := s.app.CrossChainKeeper.CreateRawIBCPackageWithFee(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sdk.SynCrossChainPackageType,
+			[]byte("test payload"), big.NewInt(int64(i)), big.NewInt(1))
+		s.Require().NoError(err)
+	}
+
+	// unsupported dest chain
+	_, err := s.queryClient.CrossChainPackages(gocontext.Background(), &types.QueryCrossChainPackagesRequest{DestChainId: 97, ChannelId: 1})
+	s.Require().Error(err)
+
+	// channel id out of range, which must not be truncated to channel 1
+	_, err = s.queryClient.CrossChainPackages(gocontext.Background(), &types.QueryCrossChainPackagesRequest{DestChainId: 56, ChannelId: 257})
+	s.Require().Error(err)
+
+	// invalid sequence range
+	_, err = s.queryClient.CrossChainPackages(gocontext.Background(), &types.QueryCrossChainPackagesRequest{
+		DestChainId: 56, ChannelId: 1, StartSequence: 3, EndSequence: 3,
+	})
+	s.Require().Error(err)
+
+	// all packages
+	res, err := s.queryClient.CrossChainPackages(gocontext.Background(), &types.QueryCrossChainPackagesRequest{DestChainId: 56, ChannelId: 1})
+	s.Require().NoError(err)
+	s.Require().Len(res.Packages, 5)
+	s.Require().EqualValues(5, res.Pagination.Total)
+	s.Require().EqualValues(sdk.SynCrossChainPackageType, res.Packages[0].PackageType)
+	s.Require().Equal("3", res.Packages[3].RelayerFee)
+	s.Require().Equal("1", res.Packages[3].AckRelayerFee)
+
+	// sequence range with pagination
+	res, err = s.queryClient.CrossChainPackages(gocontext.Background(), &types.QueryCrossChainPackagesRequest{
+		DestChainId: 56, ChannelId: 1, StartSequence: 1, EndSequence: 4, Pagination: &query.PageRequest{Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Packages, 2)
+	s.Require().EqualValues(1, res.Packages[0].Sequence)
+	s.Require().EqualValues(2, res.Packages[1].Sequence)
+	s.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = s.queryClient.CrossChainPackages(gocontext.Background(), &types.QueryCrossChainPackagesRequest{
+		DestChainId: 56, ChannelId: 1, StartSequence: 1, EndSequence: 4, Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Packages, 1)
+	s.Require().EqualValues(3, res.Packages[0].Sequence)
+
+	// total counts the packages within the sequence range only
+	res, err = s.queryClient.CrossChainPackages(gocontext.Background(), &types.QueryCrossChainPackagesRequest{
+		DestChainId: 56, ChannelId: 1, StartSequence: 1, EndSequence: 4, Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Packages, 1)
+	s.Require().EqualValues(1, res.Packages[0].Sequence)
+	s.Require().EqualValues(3, res.Pagination.Total)
+
+	// offset and reverse pagination stay within the sequence range
+	res, err = s.queryClient.CrossChainPackages(gocontext.Background(), &types.QueryCrossChainPackagesRequest{
+		DestChainId: 56, ChannelId: 1, StartSequence: 1, EndSequence: 4, Pagination: &query.PageRequest{Offset: 2, Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Packages, 1)
+	s.Require().EqualValues(3, res.Packages[0].Sequence)
+
+	res, err = s.queryClient.CrossChainPackages(gocontext.Background(), &types.QueryCrossChainPackagesRequest{
+		DestChainId: 56, ChannelId: 1, StartSequence: 1, EndSequence: 4, Pagination: &query.PageRequest{Limit: 5, Reverse: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Packages, 3)
+	s.Require().EqualValues(3, res.Packages[0].Sequence)
+	s.Require().EqualValues(1, res.Packages[2].Sequence)
+
+	// empty sequence range
+	res, err = s.queryClient.CrossChainPackages(gocontext.Background(), &types.QueryCrossChainPackagesRequest{
+		DestChainId: 56, ChannelId: 1, StartSequence: 10, Pagination: &query.PageRequest{CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Packages, 0)
+	s.Require().EqualValues(0, res.Pagination.Total)
+
+	// the timeout timestamp of syn packages and the syn sequence of ack packages are returned
+	s.app.CrossChainKeeper.SetChannelSendPermission(s.ctx, sdk.ChainID(56), sdk.ChannelID(2), sdk.ChannelAllow)
+	timeoutTimestamp := uint64(s.ctx.BlockTime().Unix()) + 100
+	_, err = s.app.CrossChainKeeper.CreateRawIBCPackageWithTimeout(s.ctx, sdk.ChainID(56), sdk.ChannelID(2),
+		[]byte("test payload"), big.NewInt(1), big.NewInt(1), timeoutTimestamp)
+	s.Require().NoError(err)
+	_, err = s.app.CrossChainKeeper.CreateRawAckPackage(s.ctx, sdk.ChainID(56), sdk.ChannelID(2), sdk.AckCrossChainPackageType,
+		7, []byte("test payload"), big.NewInt(1))
+	s.Require().NoError(err)
+
+	res, err = s.queryClient.CrossChainPackages(gocontext.Background(), &types.QueryCrossChainPackagesRequest{DestChainId: 56, ChannelId: 2})
+	s.Require().NoError(err)
+	s.Require().Len(res.Packages, 2)
+	s.Require().Equal(timeoutTimestamp, res.Packages[0].TimeoutTimestamp)
+	s.Require().EqualValues(sdk.AckCrossChainPackageType, res.Packages[1].PackageType)
+	s.Require().EqualValues(7, res.Packages[1].SynSequence)
+}
+
+func (s *TestSuite) TestQueryChannels() {
+	err := s.app.CrossChainKeeper.RegisterChannel("test channel 2", sdk.ChannelID(102), &testutil.MockCrossChainApplication{})
+	s.Require().NoError(err)
+	err = s.app.CrossChainKeeper.RegisterChannel("test channel 1", sdk.ChannelID(101), &testutil.MockCrossChainApplication{})
+	s.Require().NoError(err)
+
+	s.app.CrossChainKeeper.SetChannelSendPermission(s.ctx, sdk.ChainID(56), sdk.ChannelID(101), sdk.ChannelAllow)
+	s.app.CrossChainKeeper.IncrSendSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(101))
+	s.app.CrossChainKeeper.IncrReceiveSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(101))
+	s.app.CrossChainKeeper.IncrReceiveSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(101))
+
+	res, err := s.queryClient.Channels(gocontext.Background(), &types.QueryChannelsRequest{})
+	s.Require().NoError(err)
+
+	var channelIDs []uint32
+	for _, channel := range res.Channels {
+		channelIDs = append(channelIDs, channel.ChannelId)
+	}
+	s.Require().Contains(channelIDs, uint32(101))
+	s.Require().Contains(channelIDs, uint32(102))
+
+	channelRes, err := s.queryClient.Channel(gocontext.Background(), &types.QueryChannelRequest{ChannelId: 101})
+	s.Require().NoError(err)
+	s.Require().Equal(types.ChannelInfo{
+		ChannelId: 101,
+		Name:      "test channel 1",
+		DestChains: []types.ChannelDestChainInfo{{
+			DestChainId:     56,
+			SendPermission:  uint32(sdk.ChannelAllow),
+			SendSequence:    1,
+			ReceiveSequence: 2,
+			AppBound:        true,
+		}},
+	}, channelRes.Channel)
+
+	// unregistered channel
+	_, err = s.queryClient.Channel(gocontext.Background(), &types.QueryChannelRequest{ChannelId: 103})
+	s.Require().Error(err)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"sort"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/tendermint/tendermint/libs/log"
@@ -12,6 +13,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -23,11 +26,14 @@ type Keeper struct {
 	cfg        *crossChainConfig
 	storeKey   storetypes.StoreKey
 	paramSpace paramtypes.Subspace
+
+	// the address capable of executing a MsgUpdateChannelPermissions message, typically the x/gov module account
+	authority string
 }
 
 // NewKeeper creates a new mint Keeper instance
 func NewKeeper(
-	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace, authority string,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -39,9 +45,15 @@ func NewKeeper(
 		storeKey:   key,
 		cfg:        newCrossChainCfg(),
 		paramSpace: paramSpace,
+		authority:  authority,
 	}
 }
 
+// GetAuthority returns the x/crosschain module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger inits the logger for cross chain module
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -52,9 +64,46 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState, bankKeep
 	k.Logger(ctx).Info("set cross chain genesis state", "params", state.Params.String())
 	k.SetParams(ctx, state.Params)
 
+	kvStore := ctx.KVStore(k.storeKey)
+	for _, pack := range state.Packages {
+		key := types.BuildCrossChainPackageKey(sdk.ChainID(pack.SrcChainId), sdk.ChainID(pack.DestChainId),
+			sdk.ChannelID(pack.ChannelId), pack.Sequence)
+		// the packages of the legacy genesis are stored in the latest layout
+		bz, err := types.UpgradePackageHeader(pack.Package, state.HeaderVersion())
+		if err != nil {
+			panic(fmt.Sprintf("upgrade header of package %d in channel %d error, err=%s", pack.Sequence, pack.ChannelId, err.Error()))
+		}
+		kvStore.Set(key, bz)
+	}
+	for _, sequence := range state.SendSequences {
+		k.setSequence(ctx, sdk.ChainID(sequence.DestChainId), sdk.ChannelID(sequence.ChannelId), types.PrefixForSendSequenceKey, sequence.Sequence)
+	}
+	for _, sequence := range state.ReceiveSequences {
+		k.setSequence(ctx, sdk.ChainID(sequence.DestChainId), sdk.ChannelID(sequence.ChannelId), types.PrefixForReceiveSequenceKey, sequence.Sequence)
+	}
+	for _, sequence := range state.AckSequences {
+		k.setSequence(ctx, sdk.ChainID(sequence.DestChainId), sdk.ChannelID(sequence.ChannelId), types.PrefixForAckSequenceKey, sequence.Sequence)
+	}
+	for _, sequence := range state.PrunedSequences {
+		k.setSequence(ctx, sdk.ChainID(sequence.DestChainId), sdk.ChannelID(sequence.ChannelId), types.PrefixForPrunedSequenceKey, sequence.Sequence)
+	}
+	for _, permission := range state.ChannelPermissions {
+		k.SetChannelSendPermission(ctx, sdk.ChainID(permission.DestChainId), sdk.ChannelID(permission.ChannelId),
+			sdk.ChannelPermission(permission.Permission))
+	}
+	for _, timeout := range state.PackageTimeouts {
+		k.setPackageTimeout(ctx, timeout)
+	}
+
 	initModuleBalance := k.GetInitModuleBalance(ctx)
 	bondDenom := stakingKeeper.BondDenom(ctx)
 
+	// the module account is already funded when the chain is restarted from an exported genesis
+	moduleBalance := bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), bondDenom)
+	if moduleBalance.IsPositive() {
+		return
+	}
+
 	err := bankKeeper.MintCoins(ctx, types.ModuleName, sdk.Coins{sdk.Coin{
 		Denom:  bondDenom,
 		Amount: initModuleBalance,
@@ -64,6 +113,55 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState, bankKeep
 	}
 }
 
+// ExportGenesis returns the genesis state of cross chain module
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	kvStore := ctx.KVStore(k.storeKey)
+
+	packages := make([]types.CrossChainPackage, 0)
+	packageIterator := sdk.KVStorePrefixIterator(kvStore, types.PrefixForIbcPackageKey)
+	defer packageIterator.Close()
+	for ; packageIterator.Valid(); packageIterator.Next() {
+		srcChainID, destChainID, channelID, sequence, err := types.ParseCrossChainPackageKey(packageIterator.Key())
+		if err != nil {
+			panic(err)
+		}
+		packages = append(packages, types.CrossChainPackage{
+			SrcChainId:  uint32(srcChainID),
+			DestChainId: uint32(destChainID),
+			ChannelId:   uint32(channelID),
+			Sequence:    sequence,
+			Package:     packageIterator.Value(),
+		})
+	}
+
+	permissions := make([]types.ChannelPermission, 0)
+	permissionIterator := sdk.KVStorePrefixIterator(kvStore, types.PrefixForChannelPermissionKey)
+	defer permissionIterator.Close()
+	for ; permissionIterator.Valid(); permissionIterator.Next() {
+		destChainID, channelID, err := types.ParseChannelKey(permissionIterator.Key())
+		if err != nil {
+			panic(err)
+		}
+		permissions = append(permissions, types.ChannelPermission{
+			DestChainId: uint32(destChainID),
+			ChannelId:   uint32(channelID),
+			Permission:  uint32(permissionIterator.Value()[0]),
+		})
+	}
+
+	return types.NewGenesisState(
+		k.GetParams(ctx),
+		packages,
+		k.getAllSequences(ctx, types.PrefixForSendSequenceKey),
+		k.getAllSequences(ctx, types.PrefixForReceiveSequenceKey),
+		permissions,
+		k.getAllPackageTimeouts(ctx),
+		k.getAllSequences(ctx, types.PrefixForAckSequenceKey),
+		sdk.LatestPackageHeaderVersion,
+		k.getAllSequences(ctx, types.PrefixForPrunedSequenceKey),
+	)
+}
+
 // GetInitModuleBalance returns the initial balance of cross chain module
 func (k Keeper) GetInitModuleBalance(ctx sdk.Context) sdkmath.Int {
 	var initModuleBalanceParam sdkmath.Int
@@ -71,48 +169,110 @@ func (k Keeper) GetInitModuleBalance(ctx sdk.Context) sdkmath.Int {
 	return initModuleBalanceParam
 }
 
+// GetPackageRetentionSequences returns the number of the latest packages to keep for each channel
+func (k Keeper) GetPackageRetentionSequences(ctx sdk.Context) uint64 {
+	var packageRetentionSequences uint64
+	k.paramSpace.Get(ctx, types.KeyParamPackageRetentionSequences, &packageRetentionSequences)
+	return packageRetentionSequences
+}
+
 // SetParams sets the params of cross chain module
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
-// CreateRawIBCPackageWithFee creates a cross chain package with given cross chain fee
-func (k Keeper) CreateRawIBCPackageWithFee(ctx sdk.Context, channelID sdk.ChannelID,
+// CreateRawIBCPackageWithFee creates a syn cross chain package to the dest chain with given cross chain fee, ack and
+// fail ack packages should be created with CreateRawAckPackage
+func (k Keeper) CreateRawIBCPackageWithFee(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID,
 	packageType sdk.CrossChainPackageType, packageLoad []byte, relayerFee *big.Int, ackRelayerFee *big.Int,
 ) (uint64, error) {
-	if packageType == sdk.SynCrossChainPackageType && k.GetChannelSendPermission(ctx, k.GetDestChainID(), channelID) != sdk.ChannelAllow {
-		return 0, fmt.Errorf("channel %d is not allowed to write syn package", channelID)
+	if packageType != sdk.SynCrossChainPackageType {
+		return 0, fmt.Errorf("package type %d is not syn, ack and fail ack packages should be created with CreateRawAckPackage", packageType)
+	}
+
+	return k.createRawSynPackage(ctx, destChainID, channelID, packageLoad, relayerFee, ackRelayerFee, 0)
+}
+
+// CreateRawAckPackage creates an ack or fail ack package to the dest chain which responds to the syn package of the
+// given sequence received from the dest chain
+func (k Keeper) CreateRawAckPackage(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID,
+	packageType sdk.CrossChainPackageType, synSequence uint64, packageLoad []byte, relayerFee *big.Int,
+) (uint64, error) {
+	if packageType != sdk.AckCrossChainPackageType && packageType != sdk.FailAckCrossChainPackageType {
+		return 0, fmt.Errorf("package type %d is not ack or fail ack", packageType)
+	}
+
+	if !k.IsDestChainSupported(destChainID) {
+		return 0, fmt.Errorf("dest chain %d is not supported", destChainID)
+	}
+
+	return k.createRawIBCPackage(ctx, destChainID, channelID, sdk.PackageHeader{
+		PackageType:   packageType,
+		Timestamp:     uint64(ctx.BlockTime().Unix()),
+		RelayerFee:    relayerFee,
+		AckRelayerFee: sdk.NilAckRelayerFee,
+		SynSequence:   synSequence,
+	}, packageLoad)
+}
+
+func (k Keeper) createRawSynPackage(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID,
+	packageLoad []byte, relayerFee *big.Int, ackRelayerFee *big.Int, timeoutTimestamp uint64,
+) (uint64, error) {
+	if !k.IsDestChainSupported(destChainID) {
+		return 0, fmt.Errorf("dest chain %d is not supported", destChainID)
+	}
+
+	if k.GetChannelSendPermission(ctx, destChainID, channelID) != sdk.ChannelAllow {
+		return 0, fmt.Errorf("channel %d is not allowed to write syn package to dest chain %d", channelID, destChainID)
 	}
 
-	sequence := k.GetSendSequence(ctx, channelID)
-	key := types.BuildCrossChainPackageKey(k.GetSrcChainID(), k.GetDestChainID(), channelID, sequence)
+	return k.createRawIBCPackage(ctx, destChainID, channelID, sdk.PackageHeader{
+		PackageType:      sdk.SynCrossChainPackageType,
+		Timestamp:        uint64(ctx.BlockTime().Unix()),
+		RelayerFee:       relayerFee,
+		AckRelayerFee:    ackRelayerFee,
+		TimeoutTimestamp: timeoutTimestamp,
+	}, packageLoad)
+}
+
+func (k Keeper) createRawIBCPackage(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID,
+	header sdk.PackageHeader, packageLoad []byte,
+) (uint64, error) {
+	sequence := k.GetSendSequence(ctx, destChainID, channelID)
+	key := types.BuildCrossChainPackageKey(k.GetSrcChainID(), destChainID, channelID, sequence)
 	kvStore := ctx.KVStore(k.storeKey)
 	if kvStore.Has(key) {
 		return 0, fmt.Errorf("duplicated sequence")
 	}
 
 	// Assemble the package header
-	packageHeader := sdk.EncodePackageHeader(sdk.PackageHeader{
-		PackageType:   packageType,
-		Timestamp:     uint64(ctx.BlockTime().Unix()),
-		RelayerFee:    relayerFee,
-		AckRelayerFee: ackRelayerFee,
-	})
+	packageHeader := sdk.EncodePackageHeader(header)
 
 	kvStore.Set(key, append(packageHeader, packageLoad...))
 
-	k.IncrSendSequence(ctx, channelID)
+	k.IncrSendSequence(ctx, destChainID, channelID)
+
+	// prune the packages which are out of the retention window
+	retention := k.GetPackageRetentionSequences(ctx)
+	if retention > 0 && sequence+1 > retention {
+		_, err := k.PruneCrossChainPackages(ctx, destChainID, channelID, sequence+1-retention)
+		if err != nil {
+			return 0, err
+		}
+	}
 
 	err := ctx.EventManager().EmitTypedEvent(&types.EventCrossChain{
-		SrcChainId:    uint32(k.GetSrcChainID()),
-		DestChainId:   uint32(k.GetDestChainID()),
-		ChannelId:     uint32(channelID),
-		Sequence:      sequence,
-		PackageType:   uint32(packageType),
-		Timestamp:     uint64(ctx.BlockTime().Unix()),
-		PackageLoad:   hex.EncodeToString(packageLoad),
-		RelayerFee:    relayerFee.String(),
-		AckRelayerFee: ackRelayerFee.String(),
+		SrcChainId:       uint32(k.GetSrcChainID()),
+		DestChainId:      uint32(destChainID),
+		ChannelId:        uint32(channelID),
+		Sequence:         sequence,
+		PackageType:      uint32(header.PackageType),
+		Timestamp:        header.Timestamp,
+		PackageLoad:      hex.EncodeToString(packageLoad),
+		RelayerFee:       header.RelayerFee.String(),
+		AckRelayerFee:    header.AckRelayerFee.String(),
+		SynSequence:      header.SynSequence,
+		TimeoutTimestamp: header.TimeoutTimestamp,
 	})
 	if err != nil {
 		return 0, err
@@ -121,6 +281,70 @@ func (k Keeper) CreateRawIBCPackageWithFee(ctx sdk.Context, channelID sdk.Channe
 	return sequence, nil
 }
 
+// PruneCrossChainPackages deletes the packages to the dest chain in the channel with sequence less than endSequence,
+// it returns the number of the pruned packages. Packages which are not yet received by the dest chain are never
+// pruned, so endSequence is capped by the ack sequence of the channel. The packages before the pruned sequence of the
+// channel are already pruned, so the pruning starts from it.
+func (k Keeper) PruneCrossChainPackages(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID, endSequence uint64) (uint64, error) {
+	if ackSequence := k.GetAckSequence(ctx, destChainID, channelID); endSequence > ackSequence {
+		endSequence = ackSequence
+	}
+
+	startSequence := k.GetPrunedSequence(ctx, destChainID, channelID)
+	if endSequence <= startSequence {
+		return 0, nil
+	}
+
+	kvStore := ctx.KVStore(k.storeKey)
+
+	startKey := types.BuildCrossChainPackageKey(k.GetSrcChainID(), destChainID, channelID, startSequence)
+	endKey := types.BuildCrossChainPackageKey(k.GetSrcChainID(), destChainID, channelID, endSequence)
+
+	// the pruned sequence stops at the first kept package, so that it is pruned later
+	prunedSequence := endSequence
+	keys := make([][]byte, 0)
+	iterator := kvStore.Iterator(startKey, endKey)
+	for ; iterator.Valid(); iterator.Next() {
+		// packages waiting for timeout are kept for the timeout callbacks
+		_, _, _, sequence, err := types.ParseCrossChainPackageKey(iterator.Key())
+		if err != nil {
+			iterator.Close()
+			return 0, err
+		}
+		if kvStore.Has(types.BuildPackageTimeoutKey(destChainID, channelID, sequence)) {
+			if sequence < prunedSequence {
+				prunedSequence = sequence
+			}
+			continue
+		}
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	k.setSequence(ctx, destChainID, channelID, types.PrefixForPrunedSequenceKey, prunedSequence)
+
+	if len(keys) == 0 {
+		return 0, nil
+	}
+
+	for _, key := range keys {
+		kvStore.Delete(key)
+	}
+
+	err := ctx.EventManager().EmitTypedEvent(&types.EventCrossChainPackagesPruned{
+		SrcChainId:  uint32(k.GetSrcChainID()),
+		DestChainId: uint32(destChainID),
+		ChannelId:   uint32(channelID),
+		EndSequence: endSequence,
+		Count:       uint64(len(keys)),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(len(keys)), nil
+}
+
 // RegisterChannel register a channel to the cross chain module with the cross chain app
 func (k Keeper) RegisterChannel(name string, id sdk.ChannelID, app sdk.CrossChainApplication) error {
 	_, ok := k.cfg.nameToChannelID[name]
@@ -140,9 +364,70 @@ func (k Keeper) RegisterChannel(name string, id sdk.ChannelID, app sdk.CrossChai
 	return nil
 }
 
+// GetRegisteredChannelIDs returns the ids of all the registered channels in ascending order
+func (k Keeper) GetRegisteredChannelIDs() []sdk.ChannelID {
+	channelIDs := make([]sdk.ChannelID, 0, len(k.cfg.channelIDToName))
+	for id := range k.cfg.channelIDToName {
+		channelIDs = append(channelIDs, id)
+	}
+	sort.Slice(channelIDs, func(i, j int) bool { return channelIDs[i] < channelIDs[j] })
+	return channelIDs
+}
+
+// GetChannelName returns the name of a registered channel
+func (k Keeper) GetChannelName(channelID sdk.ChannelID) (string, bool) {
+	name, ok := k.cfg.channelIDToName[channelID]
+	return name, ok
+}
+
+// RegisterDestChainChannel binds a cross chain app to a registered channel for the given dest chain only,
+// packages of the channel from or to other dest chains are still routed to the app registered by RegisterChannel
+func (k Keeper) RegisterDestChainChannel(destChainID sdk.ChainID, id sdk.ChannelID, app sdk.CrossChainApplication) error {
+	if !k.IsDestChainSupported(destChainID) {
+		return fmt.Errorf("dest chain %d is not supported", destChainID)
+	}
+	_, ok := k.cfg.channelIDToName[id]
+	if !ok {
+		return fmt.Errorf("channel %d is not registered", id)
+	}
+	if app == nil {
+		return fmt.Errorf("nil cross chain app")
+	}
+
+	channelIDToApp, ok := k.cfg.destChainChannelIDToApp[destChainID]
+	if !ok {
+		channelIDToApp = make(map[sdk.ChannelID]sdk.CrossChainApplication)
+		k.cfg.destChainChannelIDToApp[destChainID] = channelIDToApp
+	}
+	if _, ok := channelIDToApp[id]; ok {
+		return fmt.Errorf("duplicated channel id %d for dest chain %d", id, destChainID)
+	}
+	channelIDToApp[id] = app
+	return nil
+}
+
+// RegisterDestChain registers a dest chain to the cross chain module
+func (k Keeper) RegisterDestChain(destChainID sdk.ChainID) error {
+	if k.IsDestChainSupported(destChainID) {
+		return fmt.Errorf("duplicated dest chain id")
+	}
+	k.cfg.destChainIDs = append(k.cfg.destChainIDs, destChainID)
+	return nil
+}
+
+// GetDestChainIDs returns all the registered dest chain ids
+func (k Keeper) GetDestChainIDs() []sdk.ChainID {
+	return k.cfg.destChainIDs
+}
+
 // IsDestChainSupported returns the support status of a dest chain
 func (k Keeper) IsDestChainSupported(chainID sdk.ChainID) bool {
-	return chainID == k.cfg.destChainId
+	for _, destChainID := range k.cfg.destChainIDs {
+		if destChainID == chainID {
+			return true
+		}
+	}
+	return false
 }
 
 // SetChannelSendPermission sets the channel send permission
@@ -171,41 +456,67 @@ func (k Keeper) GetSrcChainID() sdk.ChainID {
 	return k.cfg.srcChainID
 }
 
-// SetDestChainID sets the destination chain id
-func (k Keeper) SetDestChainID(destChainId sdk.ChainID) {
-	k.cfg.destChainId = destChainId
+// GetCrossChainPackage returns the ibc package to the dest chain by sequence
+func (k Keeper) GetCrossChainPackage(ctx sdk.Context, destChainID sdk.ChainID, channelId sdk.ChannelID, sequence uint64) ([]byte, error) {
+	kvStore := ctx.KVStore(k.storeKey)
+	key := types.BuildCrossChainPackageKey(k.GetSrcChainID(), destChainID, channelId, sequence)
+	return kvStore.Get(key), nil
 }
 
-// GetDestChainID gets the destination chain id
-func (k Keeper) GetDestChainID() sdk.ChainID {
-	return k.cfg.destChainId
+// GetSendSequence returns the sending sequence of the channel to the dest chain
+func (k Keeper) GetSendSequence(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID) uint64 {
+	return k.getSequence(ctx, destChainID, channelID, types.PrefixForSendSequenceKey)
 }
 
-// GetCrossChainPackage returns the ibc package by sequence
-func (k Keeper) GetCrossChainPackage(ctx sdk.Context, channelId sdk.ChannelID, sequence uint64) ([]byte, error) {
-	kvStore := ctx.KVStore(k.storeKey)
-	key := types.BuildCrossChainPackageKey(k.GetSrcChainID(), k.GetDestChainID(), channelId, sequence)
-	return kvStore.Get(key), nil
+// IncrSendSequence increases the sending sequence of the channel to the dest chain
+func (k Keeper) IncrSendSequence(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID) {
+	k.incrSequence(ctx, destChainID, channelID, types.PrefixForSendSequenceKey)
 }
 
-// GetSendSequence returns the sending sequence of the channel
-func (k Keeper) GetSendSequence(ctx sdk.Context, channelID sdk.ChannelID) uint64 {
-	return k.getSequence(ctx, k.GetDestChainID(), channelID, types.PrefixForSendSequenceKey)
+// GetReceiveSequence returns the receiving sequence of the channel from the dest chain
+func (k Keeper) GetReceiveSequence(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID) uint64 {
+	return k.getSequence(ctx, destChainID, channelID, types.PrefixForReceiveSequenceKey)
 }
 
-// IncrSendSequence increases the sending sequence of the channel
-func (k Keeper) IncrSendSequence(ctx sdk.Context, channelID sdk.ChannelID) {
-	k.incrSequence(ctx, k.GetDestChainID(), channelID, types.PrefixForSendSequenceKey)
+// IncrReceiveSequence increases the receiving sequence of the channel from the dest chain
+func (k Keeper) IncrReceiveSequence(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID) {
+	k.incrSequence(ctx, destChainID, channelID, types.PrefixForReceiveSequenceKey)
 }
 
-// GetReceiveSequence returns the receiving sequence of the channel
-func (k Keeper) GetReceiveSequence(ctx sdk.Context, channelID sdk.ChannelID) uint64 {
-	return k.getSequence(ctx, k.GetDestChainID(), channelID, types.PrefixForReceiveSequenceKey)
+// GetAckSequence returns the sequence before which all the packages of the channel are received by the dest chain
+func (k Keeper) GetAckSequence(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID) uint64 {
+	return k.getSequence(ctx, destChainID, channelID, types.PrefixForAckSequenceKey)
 }
 
-// IncrReceiveSequence increases the receiving sequence of the channel
-func (k Keeper) IncrReceiveSequence(ctx sdk.Context, channelID sdk.ChannelID) {
-	k.incrSequence(ctx, k.GetDestChainID(), channelID, types.PrefixForReceiveSequenceKey)
+// GetPrunedSequence returns the sequence before which the packages of the channel to the dest chain are pruned
+func (k Keeper) GetPrunedSequence(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID) uint64 {
+	return k.getSequence(ctx, destChainID, channelID, types.PrefixForPrunedSequenceKey)
+}
+
+// AcknowledgePackage records that the syn package of the given sequence to the dest chain is acknowledged by an ack
+// or fail ack package. The dest chain handles the packages of a channel in order, so all the packages before the
+// acknowledged one are received by the dest chain as well and they are never timed out. It returns
+// ErrPackageTimedOut if the package is already timed out, the ack or fail ack package should not be executed then.
+func (k Keeper) AcknowledgePackage(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID, sequence uint64) error {
+	if sequence >= k.GetSendSequence(ctx, destChainID, channelID) {
+		return sdkerrors.Wrapf(types.ErrInvalidAckSequence, "package %d in channel %d to dest chain %d is not sent yet",
+			sequence, channelID, destChainID)
+	}
+
+	// timed out packages are deleted when their timeout callbacks are executed
+	kvStore := ctx.KVStore(k.storeKey)
+	timedOut := !kvStore.Has(types.BuildCrossChainPackageKey(k.GetSrcChainID(), destChainID, channelID, sequence))
+
+	if ackSequence := k.GetAckSequence(ctx, destChainID, channelID); sequence >= ackSequence {
+		k.clearPackageTimeouts(ctx, destChainID, channelID, ackSequence, sequence+1)
+		k.setSequence(ctx, destChainID, channelID, types.PrefixForAckSequenceKey, sequence+1)
+	}
+
+	if timedOut {
+		return sdkerrors.Wrapf(types.ErrPackageTimedOut, "package %d in channel %d to dest chain %d",
+			sequence, channelID, destChainID)
+	}
+	return nil
 }
 
 // getSequence returns the sequence with a prefix
@@ -218,6 +529,36 @@ func (k Keeper) getSequence(ctx sdk.Context, destChainID sdk.ChainID, channelID
 	return binary.BigEndian.Uint64(bz)
 }
 
+// setSequence sets the sequence with a prefix
+func (k Keeper) setSequence(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID, prefix []byte, sequence uint64) {
+	kvStore := ctx.KVStore(k.storeKey)
+
+	sequenceBytes := make([]byte, types.SequenceLength)
+	binary.BigEndian.PutUint64(sequenceBytes, sequence)
+	kvStore.Set(types.BuildChannelSequenceKey(destChainID, channelID, prefix), sequenceBytes)
+}
+
+// getAllSequences returns the sequences of all channels with a prefix
+func (k Keeper) getAllSequences(ctx sdk.Context, prefix []byte) []types.ChannelSequence {
+	kvStore := ctx.KVStore(k.storeKey)
+
+	sequences := make([]types.ChannelSequence, 0)
+	iterator := sdk.KVStorePrefixIterator(kvStore, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		destChainID, channelID, err := types.ParseChannelKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+		sequences = append(sequences, types.ChannelSequence{
+			DestChainId: uint32(destChainID),
+			ChannelId:   uint32(channelID),
+			Sequence:    binary.BigEndian.Uint64(iterator.Value()),
+		})
+	}
+	return sequences
+}
+
 // incrSequence increases the sequence with a prefix
 func (k Keeper) incrSequence(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID, prefix []byte) {
 	var sequence uint64
@@ -240,7 +581,10 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return params
 }
 
-// GetCrossChainApp returns the cross chain app by channel id
-func (k Keeper) GetCrossChainApp(channelID sdk.ChannelID) sdk.CrossChainApplication {
+// GetCrossChainApp returns the cross chain app by dest chain id and channel id
+func (k Keeper) GetCrossChainApp(destChainID sdk.ChainID, channelID sdk.ChannelID) sdk.CrossChainApplication {
+	if app, ok := k.cfg.destChainChannelIDToApp[destChainID][channelID]; ok {
+		return app
+	}
 	return k.cfg.channelIDToApp[channelID]
 }
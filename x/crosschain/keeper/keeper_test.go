@@ -1,7 +1,9 @@
 package keeper_test
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/stretchr/testify/suite"
@@ -29,6 +31,9 @@ func (s *TestSuite) SetupTest() {
 
 	app.CrossChainKeeper.SetParams(ctx, types.DefaultParams())
 
+	err := app.CrossChainKeeper.RegisterDestChain(sdk.ChainID(56))
+	s.Require().NoError(err)
+
 	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
 	types.RegisterQueryServer(queryHelper, app.CrossChainKeeper)
 	queryClient := types.NewQueryClient(queryHelper)
@@ -43,21 +48,21 @@ func TestTestSuite(t *testing.T) {
 }
 
 func (s *TestSuite) TestIncrSendSequence() {
-	beforeSequence := s.app.CrossChainKeeper.GetSendSequence(s.ctx, sdk.ChannelID(1))
+	beforeSequence := s.app.CrossChainKeeper.GetSendSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(1))
 
-	s.app.CrossChainKeeper.IncrSendSequence(s.ctx, sdk.ChannelID(1))
+	s.app.CrossChainKeeper.IncrSendSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(1))
 
-	afterSequence := s.app.CrossChainKeeper.GetSendSequence(s.ctx, sdk.ChannelID(1))
+	afterSequence := s.app.CrossChainKeeper.GetSendSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(1))
 
 	s.Require().EqualValues(afterSequence, beforeSequence+1)
 }
 
 func (s *TestSuite) TestIncrReceiveSequence() {
-	beforeSequence := s.app.CrossChainKeeper.GetReceiveSequence(s.ctx, sdk.ChannelID(1))
+	beforeSequence := s.app.CrossChainKeeper.GetReceiveSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(1))
 
-	s.app.CrossChainKeeper.IncrReceiveSequence(s.ctx, sdk.ChannelID(1))
+	s.app.CrossChainKeeper.IncrReceiveSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(1))
 
-	afterSequence := s.app.CrossChainKeeper.GetReceiveSequence(s.ctx, sdk.ChannelID(1))
+	afterSequence := s.app.CrossChainKeeper.GetReceiveSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(1))
 
 	s.Require().EqualValues(afterSequence, beforeSequence+1)
 }
@@ -70,7 +75,7 @@ func (s *TestSuite) TestRegisterChannel() {
 
 	s.Require().NoError(err)
 
-	app := s.app.CrossChainKeeper.GetCrossChainApp(testChannelId)
+	app := s.app.CrossChainKeeper.GetCrossChainApp(sdk.ChainID(56), testChannelId)
 	s.Require().NotNil(app)
 
 	// check duplicate name
@@ -92,3 +97,358 @@ func (s *TestSuite) TestSetChannelSendPermission() {
 	permission := s.app.CrossChainKeeper.GetChannelSendPermission(s.ctx, sdk.ChainID(1), sdk.ChannelID(1))
 	s.Require().EqualValues(sdk.ChannelAllow, permission)
 }
+
+func (s *TestSuite) TestExportGenesis() {
+	s.app.CrossChainKeeper.SetChannelSendPermission(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sdk.ChannelAllow)
+	s.app.CrossChainKeeper.IncrReceiveSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(1))
+
+	_, err := s.app.CrossChainKeeper.CreateRawIBCPackageWithFee(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sdk.SynCrossChainPackageType,
+		[]byte("test payload"), big.NewInt(1), big.NewInt(1))
+	s.Require().NoError(err)
+
+	genesisState := s.app.CrossChainKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(types.ValidateGenesis(*genesisState))
+	s.Require().Len(genesisState.Packages, 1)
+	s.Require().Len(genesisState.SendSequences, 1)
+	s.Require().EqualValues(1, genesisState.SendSequences[0].Sequence)
+	s.Require().Len(genesisState.ReceiveSequences, 1)
+	s.Require().EqualValues(1, genesisState.ReceiveSequences[0].Sequence)
+	s.Require().Len(genesisState.ChannelPermissions, 1)
+
+	// import the exported state into a fresh chain
+	app := simapp.Setup(s.T(), false, true)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	s.Require().NoError(app.CrossChainKeeper.RegisterDestChain(sdk.ChainID(56)))
+	app.CrossChainKeeper.InitGenesis(ctx, genesisState, app.BankKeeper, app.StakingKeeper)
+
+	s.Require().Equal(genesisState, app.CrossChainKeeper.ExportGenesis(ctx))
+	s.Require().EqualValues(1, app.CrossChainKeeper.GetSendSequence(ctx, sdk.ChainID(56), sdk.ChannelID(1)))
+	s.Require().EqualValues(1, app.CrossChainKeeper.GetReceiveSequence(ctx, sdk.ChainID(56), sdk.ChannelID(1)))
+	s.Require().EqualValues(sdk.ChannelAllow, app.CrossChainKeeper.GetChannelSendPermission(ctx, sdk.ChainID(56), sdk.ChannelID(1)))
+
+	pack, err := app.CrossChainKeeper.GetCrossChainPackage(ctx, sdk.ChainID(56), sdk.ChannelID(1), 0)
+	s.Require().NoError(err)
+	s.Require().Equal(genesisState.Packages[0].Package, pack)
+}
+
+func (s *TestSuite) TestInitLegacyGenesis() {
+	header := sdk.PackageHeader{
+		PackageType:   sdk.SynCrossChainPackageType,
+		Timestamp:     1,
+		RelayerFee:    big.NewInt(1),
+		AckRelayerFee: big.NewInt(1),
+	}
+	payload := []byte("test payload")
+
+	// the genesis exported before the package headers were versioned has no header version
+	genesisState := types.DefaultGenesisState()
+	genesisState.PackageHeaderVersion = 0
+	genesisState.Packages = []types.CrossChainPackage{{
+		SrcChainId:  uint32(s.app.CrossChainKeeper.GetSrcChainID()),
+		DestChainId: 56,
+		ChannelId:   1,
+		Sequence:    0,
+		Package:     append(sdk.EncodePackageHeaderWithVersion(header, sdk.PackageHeaderV1), payload...),
+	}}
+	genesisState.SendSequences = []types.ChannelSequence{{DestChainId: 56, ChannelId: 1, Sequence: 1}}
+	s.Require().NoError(types.ValidateGenesis(*genesisState))
+
+	app := simapp.Setup(s.T(), false, true)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	s.Require().NoError(app.CrossChainKeeper.RegisterDestChain(sdk.ChainID(56)))
+	app.CrossChainKeeper.InitGenesis(ctx, genesisState, app.BankKeeper, app.StakingKeeper)
+
+	// the packages are stored in the latest layout
+	pack, err := app.CrossChainKeeper.GetCrossChainPackage(ctx, sdk.ChainID(56), sdk.ChannelID(1), 0)
+	s.Require().NoError(err)
+	s.Require().Equal(append(sdk.EncodePackageHeader(header), payload...), pack)
+
+	exported := app.CrossChainKeeper.ExportGenesis(ctx)
+	s.Require().EqualValues(sdk.LatestPackageHeaderVersion, exported.PackageHeaderVersion)
+	s.Require().NoError(types.ValidateGenesis(*exported))
+}
+
+func (s *TestSuite) TestRegisterDestChain() {
+	s.Require().True(s.app.CrossChainKeeper.IsDestChainSupported(sdk.ChainID(56)))
+	s.Require().False(s.app.CrossChainKeeper.IsDestChainSupported(sdk.ChainID(204)))
+
+	err := s.app.CrossChainKeeper.RegisterDestChain(sdk.ChainID(204))
+	s.Require().NoError(err)
+	s.Require().True(s.app.CrossChainKeeper.IsDestChainSupported(sdk.ChainID(204)))
+	s.Require().Equal([]sdk.ChainID{56, 204}, s.app.CrossChainKeeper.GetDestChainIDs())
+
+	// check duplicate dest chain
+	err = s.app.CrossChainKeeper.RegisterDestChain(sdk.ChainID(204))
+	s.Require().ErrorContains(err, "duplicated dest chain id")
+
+	// sequences are tracked per dest chain
+	s.app.CrossChainKeeper.IncrSendSequence(s.ctx, sdk.ChainID(204), sdk.ChannelID(1))
+	s.Require().EqualValues(1, s.app.CrossChainKeeper.GetSendSequence(s.ctx, sdk.ChainID(204), sdk.ChannelID(1)))
+	s.Require().EqualValues(0, s.app.CrossChainKeeper.GetSendSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(1)))
+
+	// packages to unsupported dest chains are rejected
+	_, err = s.app.CrossChainKeeper.CreateRawIBCPackageWithFee(s.ctx, sdk.ChainID(97), sdk.ChannelID(1), sdk.SynCrossChainPackageType,
+		[]byte("test payload"), big.NewInt(1), big.NewInt(1))
+	s.Require().ErrorContains(err, "dest chain 97 is not supported")
+}
+
+func (s *TestSuite) TestRegisterDestChainChannel() {
+	testChannelId := sdk.ChannelID(100)
+	defaultApp := &testutil.MockCrossChainApplication{}
+	destChainApp := &testutil.MockCrossChainApplication{}
+
+	err := s.app.CrossChainKeeper.RegisterDestChainChannel(sdk.ChainID(56), testChannelId, destChainApp)
+	s.Require().ErrorContains(err, "channel 100 is not registered")
+
+	err = s.app.CrossChainKeeper.RegisterChannel("test channel", testChannelId, defaultApp)
+	s.Require().NoError(err)
+
+	err = s.app.CrossChainKeeper.RegisterDestChainChannel(sdk.ChainID(204), testChannelId, destChainApp)
+	s.Require().ErrorContains(err, "dest chain 204 is not supported")
+
+	s.Require().NoError(s.app.CrossChainKeeper.RegisterDestChain(sdk.ChainID(204)))
+	err = s.app.CrossChainKeeper.RegisterDestChainChannel(sdk.ChainID(204), testChannelId, destChainApp)
+	s.Require().NoError(err)
+
+	err = s.app.CrossChainKeeper.RegisterDestChainChannel(sdk.ChainID(204), testChannelId, destChainApp)
+	s.Require().ErrorContains(err, "duplicated channel id")
+
+	s.Require().Same(defaultApp, s.app.CrossChainKeeper.GetCrossChainApp(sdk.ChainID(56), testChannelId))
+	s.Require().Same(destChainApp, s.app.CrossChainKeeper.GetCrossChainApp(sdk.ChainID(204), testChannelId))
+}
+
+func (s *TestSuite) TestPackageRetention() {
+	params := types.DefaultParams()
+	params.PackageRetentionSequences = 2
+	s.app.CrossChainKeeper.SetParams(s.ctx, params)
+	s.app.CrossChainKeeper.SetChannelSendPermission(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sdk.ChannelAllow)
+
+	for i := 0; i < 4; i++ {
+		_, err := s.app.CrossChainKeeper.CreateRawIBCPackageWithFee(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sdk.SynCrossChainPackageType,
+			[]byte("test payload"), big.NewInt(1), big.NewInt(1))
+		s.Require().NoError(err)
+	}
+
+	// no package is received by the dest chain yet
+	for sequence := uint64(0); sequence < 4; sequence++ {
+		pack, err := s.app.CrossChainKeeper.GetCrossChainPackage(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sequence)
+		s.Require().NoError(err)
+		s.Require().NotNil(pack)
+	}
+
+	// packages before the acknowledged one are received by the dest chain, but only the ones out of the retention
+	// window are pruned
+	s.Require().NoError(s.app.CrossChainKeeper.AcknowledgePackage(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), 2))
+	_, err := s.app.CrossChainKeeper.CreateRawIBCPackageWithFee(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sdk.SynCrossChainPackageType,
+		[]byte("test payload"), big.NewInt(1), big.NewInt(1))
+	s.Require().NoError(err)
+
+	for sequence := uint64(0); sequence < 5; sequence++ {
+		pack, err := s.app.CrossChainKeeper.GetCrossChainPackage(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sequence)
+		s.Require().NoError(err)
+		if sequence < 3 {
+			s.Require().Nil(pack)
+		} else {
+			s.Require().NotNil(pack)
+		}
+	}
+	s.Require().EqualValues(5, s.app.CrossChainKeeper.GetSendSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(1)))
+}
+
+func (s *TestSuite) TestPruneCrossChainPackages() {
+	s.app.CrossChainKeeper.SetChannelSendPermission(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sdk.ChannelAllow)
+
+	for i := 0; i < 3; i++ {
+		_, err := s.app.CrossChainKeeper.CreateRawIBCPackageWithFee(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sdk.SynCrossChainPackageType,
+			[]byte("test payload"), big.NewInt(1), big.NewInt(1))
+		s.Require().NoError(err)
+	}
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	// packages not received by the dest chain are kept
+	count, err := s.app.CrossChainKeeper.PruneCrossChainPackages(ctx, sdk.ChainID(56), sdk.ChannelID(1), 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, count)
+	s.Require().Len(ctx.EventManager().Events(), 0)
+
+	s.Require().EqualValues(0, s.app.CrossChainKeeper.GetPrunedSequence(ctx, sdk.ChainID(56), sdk.ChannelID(1)))
+
+	s.Require().NoError(s.app.CrossChainKeeper.AcknowledgePackage(ctx, sdk.ChainID(56), sdk.ChannelID(1), 0))
+	count, err = s.app.CrossChainKeeper.PruneCrossChainPackages(ctx, sdk.ChainID(56), sdk.ChannelID(1), 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, count)
+	s.Require().Len(ctx.EventManager().Events(), 1)
+	s.Require().EqualValues(1, s.app.CrossChainKeeper.GetPrunedSequence(ctx, sdk.ChainID(56), sdk.ChannelID(1)))
+
+	s.Require().NoError(s.app.CrossChainKeeper.AcknowledgePackage(ctx, sdk.ChainID(56), sdk.ChannelID(1), 2))
+	count, err = s.app.CrossChainKeeper.PruneCrossChainPackages(ctx, sdk.ChainID(56), sdk.ChannelID(1), 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, count)
+
+	s.Require().EqualValues(2, s.app.CrossChainKeeper.GetPrunedSequence(ctx, sdk.ChainID(56), sdk.ChannelID(1)))
+
+	// nothing left to prune
+	count, err = s.app.CrossChainKeeper.PruneCrossChainPackages(ctx, sdk.ChainID(56), sdk.ChannelID(1), 2)
+	s.Require().NoError(err)
+	s.Require().EqualValues(0, count)
+
+	// the pruning starts from the pruned sequence, so a package stored before it is never visited again
+	key := types.BuildCrossChainPackageKey(s.app.CrossChainKeeper.GetSrcChainID(), sdk.ChainID(56), sdk.ChannelID(1), 0)
+	s.ctx.KVStore(s.app.GetKey(types.StoreKey)).Set(key, []byte("test package"))
+	pack, err := s.app.CrossChainKeeper.GetCrossChainPackage(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), 2)
+	s.Require().NoError(err)
+	s.Require().NotNil(pack)
+
+	count, err = s.app.CrossChainKeeper.PruneCrossChainPackages(ctx, sdk.ChainID(56), sdk.ChannelID(1), 3)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, count)
+	s.Require().EqualValues(3, s.app.CrossChainKeeper.GetPrunedSequence(ctx, sdk.ChainID(56), sdk.ChannelID(1)))
+
+	pack, err = s.app.CrossChainKeeper.GetCrossChainPackage(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), 0)
+	s.Require().NoError(err)
+	s.Require().NotNil(pack)
+}
+
+func (s *TestSuite) TestAcknowledgePackage() {
+	s.app.CrossChainKeeper.SetChannelSendPermission(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sdk.ChannelAllow)
+
+	for i := 0; i < 3; i++ {
+		_, err := s.app.CrossChainKeeper.CreateRawIBCPackageWithFee(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sdk.SynCrossChainPackageType,
+			[]byte("test payload"), big.NewInt(1), big.NewInt(1))
+		s.Require().NoError(err)
+	}
+	s.Require().EqualValues(0, s.app.CrossChainKeeper.GetAckSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(1)))
+
+	s.Require().NoError(s.app.CrossChainKeeper.AcknowledgePackage(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), 1))
+	s.Require().EqualValues(2, s.app.CrossChainKeeper.GetAckSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(1)))
+
+	// the ack sequence never goes back
+	s.Require().NoError(s.app.CrossChainKeeper.AcknowledgePackage(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), 0))
+	s.Require().EqualValues(2, s.app.CrossChainKeeper.GetAckSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(1)))
+
+	err := s.app.CrossChainKeeper.AcknowledgePackage(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), 3)
+	s.Require().ErrorIs(err, types.ErrInvalidAckSequence)
+}
+
+func (s *TestSuite) TestCreateRawAckPackage() {
+	_, err := s.app.CrossChainKeeper.CreateRawIBCPackageWithFee(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sdk.AckCrossChainPackageType,
+		[]byte("test payload"), big.NewInt(1), sdk.NilAckRelayerFee)
+	s.Require().Error(err)
+
+	_, err = s.app.CrossChainKeeper.CreateRawAckPackage(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sdk.SynCrossChainPackageType,
+		10, []byte("test payload"), big.NewInt(1))
+	s.Require().Error(err)
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	sequence, err := s.app.CrossChainKeeper.CreateRawAckPackage(ctx, sdk.ChainID(56), sdk.ChannelID(1), sdk.FailAckCrossChainPackageType,
+		10, []byte("test payload"), big.NewInt(1))
+	s.Require().NoError(err)
+
+	s.Require().Len(ctx.EventManager().ABCIEvents(), 1)
+	event, err := sdk.ParseTypedEvent(ctx.EventManager().ABCIEvents()[0])
+	s.Require().NoError(err)
+	s.Require().EqualValues(10, event.(*types.EventCrossChain).SynSequence)
+
+	pack, err := s.app.CrossChainKeeper.GetCrossChainPackage(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sequence)
+	s.Require().NoError(err)
+	header, err := sdk.DecodePackageHeader(pack)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.FailAckCrossChainPackageType, header.PackageType)
+	s.Require().EqualValues(10, header.SynSequence)
+	s.Require().EqualValues(1, header.RelayerFee.Int64())
+	s.Require().Equal([]byte("test payload"), pack[sdk.AckPackageHeaderLength:])
+}
+
+type timeoutApp struct {
+	testutil.MockCrossChainApplication
+
+	headers  []*sdk.CrossChainAppContext
+	payloads [][]byte
+}
+
+func (app *timeoutApp) ExecuteTimeoutPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	app.headers = append(app.headers, appCtx)
+	app.payloads = append(app.payloads, payload)
+	return sdk.ExecuteResult{}
+}
+
+func (s *TestSuite) TestPackageTimeout() {
+	app := &timeoutApp{}
+	err := s.app.CrossChainKeeper.RegisterChannel("timeout channel", sdk.ChannelID(120), app)
+	s.Require().NoError(err)
+	s.app.CrossChainKeeper.SetChannelSendPermission(s.ctx, sdk.ChainID(56), sdk.ChannelID(120), sdk.ChannelAllow)
+
+	ctx := s.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	// invalid timeouts
+	_, err = s.app.CrossChainKeeper.CreateRawIBCPackageWithTimeout(ctx, sdk.ChainID(56), sdk.ChannelID(120),
+		[]byte("test payload"), big.NewInt(1), big.NewInt(1), 0)
+	s.Require().Error(err)
+	_, err = s.app.CrossChainKeeper.CreateRawIBCPackageWithTimeout(ctx, sdk.ChainID(56), sdk.ChannelID(120),
+		[]byte("test payload"), big.NewInt(1), big.NewInt(1), 1000)
+	s.Require().Error(err)
+
+	receivedSeq, err := s.app.CrossChainKeeper.CreateRawIBCPackageWithTimeout(ctx, sdk.ChainID(56), sdk.ChannelID(120),
+		[]byte("received payload"), big.NewInt(1), big.NewInt(1), 1005)
+	s.Require().NoError(err)
+	ackedSeq, err := s.app.CrossChainKeeper.CreateRawIBCPackageWithTimeout(ctx, sdk.ChainID(56), sdk.ChannelID(120),
+		[]byte("acked payload"), big.NewInt(1), big.NewInt(1), 1005)
+	s.Require().NoError(err)
+	timeoutSeq, err := s.app.CrossChainKeeper.CreateRawIBCPackageWithTimeout(ctx, sdk.ChainID(56), sdk.ChannelID(120),
+		[]byte("timeout payload"), big.NewInt(1), big.NewInt(2), 1010)
+	s.Require().NoError(err)
+	lateSeq, err := s.app.CrossChainKeeper.CreateRawIBCPackageWithTimeout(ctx, sdk.ChainID(56), sdk.ChannelID(120),
+		[]byte("late payload"), big.NewInt(1), big.NewInt(1), 1020)
+	s.Require().NoError(err)
+
+	// the timeout timestamp is encoded into the package header
+	pack, err := s.app.CrossChainKeeper.GetCrossChainPackage(ctx, sdk.ChainID(56), sdk.ChannelID(120), timeoutSeq)
+	s.Require().NoError(err)
+	header, err := sdk.DecodePackageHeader(pack)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1010, header.TimeoutTimestamp)
+
+	// the acked package and the packages before it are received by the dest chain, they are not timed out
+	s.Require().NoError(s.app.CrossChainKeeper.AcknowledgePackage(ctx, sdk.ChainID(56), sdk.ChannelID(120), ackedSeq))
+	_, found := s.app.CrossChainKeeper.GetPackageTimeout(ctx, sdk.ChainID(56), sdk.ChannelID(120), receivedSeq)
+	s.Require().False(found)
+	_, found = s.app.CrossChainKeeper.GetPackageTimeout(ctx, sdk.ChainID(56), sdk.ChannelID(120), ackedSeq)
+	s.Require().False(found)
+	_, found = s.app.CrossChainKeeper.GetPackageTimeout(ctx, sdk.ChainID(56), sdk.ChannelID(120), timeoutSeq)
+	s.Require().True(found)
+
+	// packages not received by the dest chain are not pruned
+	count, err := s.app.CrossChainKeeper.PruneCrossChainPackages(ctx, sdk.ChainID(56), sdk.ChannelID(120), lateSeq+1)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, count)
+
+	s.app.CrossChainKeeper.ProcessTimeoutPackages(ctx.WithBlockTime(time.Unix(1009, 0)))
+	s.Require().Len(app.payloads, 0)
+
+	eventCtx := ctx.WithBlockTime(time.Unix(1010, 0)).WithEventManager(sdk.NewEventManager())
+	s.app.CrossChainKeeper.ProcessTimeoutPackages(eventCtx)
+	s.Require().Len(app.payloads, 1)
+	s.Require().Equal([]byte("timeout payload"), app.payloads[0])
+	s.Require().Equal(timeoutSeq, app.headers[0].Sequence)
+	s.Require().EqualValues(1010, app.headers[0].Header.TimeoutTimestamp)
+	s.Require().Equal(big.NewInt(2), app.headers[0].Header.AckRelayerFee)
+	s.Require().Len(eventCtx.EventManager().Events(), 1)
+
+	// the timed out package is deleted so that it can not be relayed anymore
+	pack, err = s.app.CrossChainKeeper.GetCrossChainPackage(ctx, sdk.ChainID(56), sdk.ChannelID(120), timeoutSeq)
+	s.Require().NoError(err)
+	s.Require().Nil(pack)
+
+	// the fail ack package of the timed out package is not executed, while the package is still received
+	err = s.app.CrossChainKeeper.AcknowledgePackage(ctx, sdk.ChainID(56), sdk.ChannelID(120), timeoutSeq)
+	s.Require().ErrorIs(err, types.ErrPackageTimedOut)
+	s.Require().EqualValues(timeoutSeq+1, s.app.CrossChainKeeper.GetAckSequence(ctx, sdk.ChainID(56), sdk.ChannelID(120)))
+
+	s.app.CrossChainKeeper.ProcessTimeoutPackages(ctx.WithBlockTime(time.Unix(2000, 0)))
+	s.Require().Len(app.payloads, 2)
+	s.Require().Equal(lateSeq, app.headers[1].Sequence)
+
+	// the package is timed out only once
+	s.app.CrossChainKeeper.ProcessTimeoutPackages(ctx.WithBlockTime(time.Unix(3000, 0)))
+	s.Require().Len(app.payloads, 2)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the crosschain MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		k,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) UpdateChannelPermissions(goCtx context.Context, req *types.MsgUpdateChannelPermissions) (*types.MsgUpdateChannelPermissionsResponse, error) {
+	if k.authority != req.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, permission := range req.ChannelPermissions {
+		setting := permission.ToSetting()
+		if err := setting.Check(); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		if !k.IsDestChainSupported(setting.DestChainId) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "dest chain %d is not supported", setting.DestChainId)
+		}
+		if _, ok := k.GetChannelName(setting.ChannelId); !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "channel %d is not registered", setting.ChannelId)
+		}
+
+		k.SetChannelSendPermission(ctx, setting.DestChainId, setting.ChannelId, setting.Permission)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventChannelPermissionUpdated{
+			DestChainId: uint32(setting.DestChainId),
+			ChannelId:   uint32(setting.ChannelId),
+			Permission:  uint32(setting.Permission),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgUpdateChannelPermissionsResponse{}, nil
+}
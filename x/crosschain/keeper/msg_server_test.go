@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/keeper"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/testutil"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func (s *TestSuite) TestUpdateChannelPermissions() {
+	err := s.app.CrossChainKeeper.RegisterChannel("test channel", sdk.ChannelID(111), &testutil.MockCrossChainApplication{})
+	s.Require().NoError(err)
+
+	msgServer := keeper.NewMsgServerImpl(s.app.CrossChainKeeper)
+	authority := s.app.CrossChainKeeper.GetAuthority()
+
+	// invalid authority
+	_, err = msgServer.UpdateChannelPermissions(s.ctx, types.NewMsgUpdateChannelPermissions(
+		sdk.AccAddress("invalid authority").String(),
+		[]types.ChannelPermission{{DestChainId: 56, ChannelId: 111, Permission: uint32(sdk.ChannelAllow)}},
+	))
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	// gov channel
+	_, err = msgServer.UpdateChannelPermissions(s.ctx, types.NewMsgUpdateChannelPermissions(
+		authority,
+		[]types.ChannelPermission{{DestChainId: 56, ChannelId: uint32(types.GovChannelId), Permission: uint32(sdk.ChannelForbidden)}},
+	))
+	s.Require().ErrorContains(err, "gov channel id is forbidden to set")
+
+	// unsupported dest chain
+	_, err = msgServer.UpdateChannelPermissions(s.ctx, types.NewMsgUpdateChannelPermissions(
+		authority,
+		[]types.ChannelPermission{{DestChainId: 97, ChannelId: 111, Permission: uint32(sdk.ChannelAllow)}},
+	))
+	s.Require().ErrorContains(err, "dest chain 97 is not supported")
+
+	// unregistered channel
+	_, err = msgServer.UpdateChannelPermissions(s.ctx, types.NewMsgUpdateChannelPermissions(
+		authority,
+		[]types.ChannelPermission{{DestChainId: 56, ChannelId: 112, Permission: uint32(sdk.ChannelAllow)}},
+	))
+	s.Require().ErrorContains(err, "channel 112 is not registered")
+
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.UpdateChannelPermissions(ctx, types.NewMsgUpdateChannelPermissions(
+		authority,
+		[]types.ChannelPermission{{DestChainId: 56, ChannelId: 111, Permission: uint32(sdk.ChannelAllow)}},
+	))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.ChannelAllow, s.app.CrossChainKeeper.GetChannelSendPermission(ctx, sdk.ChainID(56), sdk.ChannelID(111)))
+	s.Require().Len(ctx.EventManager().Events(), 1)
+	s.Require().Equal("cosmos.crosschain.v1.EventChannelPermissionUpdated", ctx.EventManager().Events()[0].Type)
+
+	_, err = msgServer.UpdateChannelPermissions(ctx, types.NewMsgUpdateChannelPermissions(
+		authority,
+		[]types.ChannelPermission{{DestChainId: 56, ChannelId: 111, Permission: uint32(sdk.ChannelForbidden)}},
+	))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.ChannelForbidden, s.app.CrossChainKeeper.GetChannelSendPermission(ctx, sdk.ChainID(56), sdk.ChannelID(111)))
+}
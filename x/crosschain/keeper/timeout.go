@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+	"runtime/debug"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
+)
+
+// CreateRawIBCPackageWithTimeout creates a syn cross chain package to the dest chain with given cross chain fee, the
+// package is timed out at the given block time in unix seconds if it is not acknowledged before. The timeout timestamp
+// is encoded into the package header, so the dest chain rejects the package once its block time reaches it.
+func (k Keeper) CreateRawIBCPackageWithTimeout(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID,
+	packageLoad []byte, relayerFee *big.Int, ackRelayerFee *big.Int, timeoutTimestamp uint64,
+) (uint64, error) {
+	if timeoutTimestamp <= uint64(ctx.BlockTime().Unix()) {
+		return 0, fmt.Errorf("timeout timestamp %d should be larger than the current block time %d", timeoutTimestamp, ctx.BlockTime().Unix())
+	}
+
+	sequence, err := k.createRawSynPackage(ctx, destChainID, channelID, packageLoad, relayerFee, ackRelayerFee, timeoutTimestamp)
+	if err != nil {
+		return 0, err
+	}
+
+	k.setPackageTimeout(ctx, types.PackageTimeout{
+		DestChainId:      uint32(destChainID),
+		ChannelId:        uint32(channelID),
+		Sequence:         sequence,
+		TimeoutTimestamp: timeoutTimestamp,
+	})
+
+	return sequence, nil
+}
+
+// GetPackageTimeout returns the timeout of a pending syn package to the dest chain
+func (k Keeper) GetPackageTimeout(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID, sequence uint64) (types.PackageTimeout, bool) {
+	kvStore := ctx.KVStore(k.storeKey)
+	bz := kvStore.Get(types.BuildPackageTimeoutKey(destChainID, channelID, sequence))
+	if bz == nil {
+		return types.PackageTimeout{}, false
+	}
+
+	var timeout types.PackageTimeout
+	k.cdc.MustUnmarshal(bz, &timeout)
+	return timeout, true
+}
+
+// ClearPackageTimeout removes the timeout of a syn package to the dest chain, so that ExecuteTimeoutPackage will not
+// be called for the package. The timeouts are cleared by AcknowledgePackage once the packages are received by the
+// dest chain, cross chain apps don't need to call it.
+func (k Keeper) ClearPackageTimeout(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID, sequence uint64) {
+	timeout, found := k.GetPackageTimeout(ctx, destChainID, channelID, sequence)
+	if !found {
+		return
+	}
+
+	kvStore := ctx.KVStore(k.storeKey)
+	kvStore.Delete(types.BuildPackageTimeoutKey(destChainID, channelID, sequence))
+	kvStore.Delete(types.BuildPackageTimeoutQueueKey(timeout.TimeoutTimestamp, destChainID, channelID, sequence))
+}
+
+// ProcessTimeoutPackages executes the timeout callbacks of the cross chain apps for the syn packages which are timed
+// out at the current block, the timed out packages are deleted so that they can not be relayed anymore
+func (k Keeper) ProcessTimeoutPackages(ctx sdk.Context) {
+	for _, timeout := range k.getExpiredPackageTimeouts(ctx) {
+		destChainID, channelID := sdk.ChainID(timeout.DestChainId), sdk.ChannelID(timeout.ChannelId)
+		k.ClearPackageTimeout(ctx, destChainID, channelID, timeout.Sequence)
+
+		if err := k.executeTimeoutPackage(ctx, timeout); err != nil {
+			k.Logger(ctx).Error("execute timeout package failed", "dest_chain_id", destChainID,
+				"channel_id", channelID, "sequence", timeout.Sequence, "err", err.Error())
+		}
+
+		kvStore := ctx.KVStore(k.storeKey)
+		kvStore.Delete(types.BuildCrossChainPackageKey(k.GetSrcChainID(), destChainID, channelID, timeout.Sequence))
+	}
+}
+
+func (k Keeper) setPackageTimeout(ctx sdk.Context, timeout types.PackageTimeout) {
+	destChainID, channelID := sdk.ChainID(timeout.DestChainId), sdk.ChannelID(timeout.ChannelId)
+
+	kvStore := ctx.KVStore(k.storeKey)
+	kvStore.Set(types.BuildPackageTimeoutKey(destChainID, channelID, timeout.Sequence), k.cdc.MustMarshal(&timeout))
+	kvStore.Set(types.BuildPackageTimeoutQueueKey(timeout.TimeoutTimestamp, destChainID, channelID, timeout.Sequence), []byte{})
+}
+
+// clearPackageTimeouts removes the timeouts of the syn packages to the dest chain with sequence within
+// [startSequence, endSequence)
+func (k Keeper) clearPackageTimeouts(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID, startSequence, endSequence uint64) {
+	kvStore := ctx.KVStore(k.storeKey)
+	iterator := kvStore.Iterator(types.BuildPackageTimeoutKey(destChainID, channelID, startSequence),
+		types.BuildPackageTimeoutKey(destChainID, channelID, endSequence))
+
+	sequences := make([]uint64, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var timeout types.PackageTimeout
+		k.cdc.MustUnmarshal(iterator.Value(), &timeout)
+		sequences = append(sequences, timeout.Sequence)
+	}
+	iterator.Close()
+
+	for _, sequence := range sequences {
+		k.ClearPackageTimeout(ctx, destChainID, channelID, sequence)
+	}
+}
+
+func (k Keeper) getAllPackageTimeouts(ctx sdk.Context) []types.PackageTimeout {
+	kvStore := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(kvStore, types.PrefixForPackageTimeoutKey)
+	defer iterator.Close()
+
+	timeouts := make([]types.PackageTimeout, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var timeout types.PackageTimeout
+		k.cdc.MustUnmarshal(iterator.Value(), &timeout)
+		timeouts = append(timeouts, timeout)
+	}
+	return timeouts
+}
+
+// getExpiredPackageTimeouts returns the timeouts of the packages which are timed out at the current block
+func (k Keeper) getExpiredPackageTimeouts(ctx sdk.Context) []types.PackageTimeout {
+	kvStore := ctx.KVStore(k.storeKey)
+	iterator := kvStore.Iterator(types.PrefixForPackageTimeoutQueueKey,
+		sdk.PrefixEndBytes(types.BuildPackageTimeoutQueuePrefix(uint64(ctx.BlockTime().Unix()))))
+	defer iterator.Close()
+
+	timeouts := make([]types.PackageTimeout, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		destChainID, channelID, sequence, err := types.ParsePackageTimeoutQueueKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		timeout, found := k.GetPackageTimeout(ctx, destChainID, channelID, sequence)
+		if !found {
+			panic(fmt.Sprintf("timeout of package %d in channel %d to dest chain %d not found", sequence, channelID, destChainID))
+		}
+		timeouts = append(timeouts, timeout)
+	}
+
+	return timeouts
+}
+
+func (k Keeper) executeTimeoutPackage(ctx sdk.Context, timeout types.PackageTimeout) error {
+	destChainID, channelID := sdk.ChainID(timeout.DestChainId), sdk.ChannelID(timeout.ChannelId)
+
+	app := k.GetCrossChainApp(destChainID, channelID)
+	if app == nil {
+		return fmt.Errorf("channel %d is not registered", channelID)
+	}
+
+	pack, err := k.GetCrossChainPackage(ctx, destChainID, channelID, timeout.Sequence)
+	if err != nil {
+		return err
+	}
+	header, err := sdk.DecodePackageHeader(pack)
+	if err != nil {
+		return err
+	}
+	if header.PackageType != sdk.SynCrossChainPackageType {
+		return fmt.Errorf("package type %d is not syn", header.PackageType)
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	result := executeTimeout(cacheCtx, app, timeout.Sequence, &header, pack[sdk.SynPackageHeaderLength:])
+	if result.IsOk() {
+		write()
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventCrossChainPackageTimeout{
+		SrcChainId:       uint32(k.GetSrcChainID()),
+		DestChainId:      timeout.DestChainId,
+		ChannelId:        timeout.ChannelId,
+		Sequence:         timeout.Sequence,
+		TimeoutTimestamp: timeout.TimeoutTimestamp,
+		ErrorMsg:         result.ErrMsg(),
+	})
+}
+
+func executeTimeout(
+	ctx sdk.Context,
+	app sdk.CrossChainApplication,
+	sequence uint64,
+	header *sdk.PackageHeader,
+	payload []byte,
+) (result sdk.ExecuteResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			log := fmt.Sprintf("recovered: %v\nstack:\n%v", r, string(debug.Stack()))
+			logger := ctx.Logger().With("module", "x/"+types.ModuleName)
+			logger.Error("execute timeout package panic", "err_log", log)
+			result = sdk.ExecuteResult{
+				Err: fmt.Errorf("execute timeout package failed: %v", r),
+			}
+		}
+	}()
+
+	return app.ExecuteTimeoutPackage(ctx, &sdk.CrossChainAppContext{
+		Sequence: sequence,
+		Header:   header,
+	}, payload)
+}
@@ -0,0 +1,45 @@
+package v2
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 1 to 2.
+// The migration includes:
+//
+// - Setting the PackageRetentionSequences param in the paramstore
+// - Upgrading the headers of the stored cross chain packages to the latest layout, which adds the timeout timestamp
+// to syn packages and the syn sequence to ack and fail ack packages
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, paramstore paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeyParamPackageRetentionSequences, types.DefaultPackageRetentionSequences)
+
+	return migratePackages(ctx, storeKey)
+}
+
+func migratePackages(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PrefixForIbcPackageKey)
+	keys, packages := make([][]byte, 0), make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		pack, err := types.UpgradePackageHeader(iterator.Value(), sdk.PackageHeaderV1)
+		if err != nil {
+			iterator.Close()
+			return err
+		}
+		keys = append(keys, iterator.Key())
+		packages = append(packages, pack)
+	}
+	iterator.Close()
+
+	for i, key := range keys {
+		store.Set(key, packages[i])
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package v2_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v2 "github.com/cosmos/cosmos-sdk/x/crosschain/migrations/v2"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	crossChainKey := sdk.NewKVStoreKey(types.StoreKey)
+	tCrossChainKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(crossChainKey, tCrossChainKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, crossChainKey, tCrossChainKey, types.ModuleName)
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.KeyParamPackageRetentionSequences))
+
+	// Store packages in the legacy layout
+	payload := []byte("payload")
+	synHeader := sdk.PackageHeader{
+		PackageType:   sdk.SynCrossChainPackageType,
+		Timestamp:     1,
+		RelayerFee:    big.NewInt(2),
+		AckRelayerFee: big.NewInt(3),
+	}
+	ackHeader := sdk.PackageHeader{
+		PackageType:   sdk.AckCrossChainPackageType,
+		Timestamp:     1,
+		RelayerFee:    big.NewInt(2),
+		AckRelayerFee: big.NewInt(0),
+	}
+	synKey := types.BuildCrossChainPackageKey(1, 56, 1, 0)
+	ackKey := types.BuildCrossChainPackageKey(1, 56, 1, 1)
+	store := ctx.KVStore(crossChainKey)
+	store.Set(synKey, append(sdk.EncodePackageHeaderWithVersion(synHeader, sdk.PackageHeaderV1), payload...))
+	store.Set(ackKey, append(sdk.EncodePackageHeaderWithVersion(ackHeader, sdk.PackageHeaderV1), payload...))
+
+	// Run migrations.
+	err := v2.MigrateStore(ctx, crossChainKey, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeyParamPackageRetentionSequences))
+	var retention uint64
+	paramstore.Get(ctx, types.KeyParamPackageRetentionSequences, &retention)
+	require.Equal(t, types.DefaultPackageRetentionSequences, retention)
+
+	// Make sure the packages are in the latest layout.
+	for key, header := range map[string]sdk.PackageHeader{string(synKey): synHeader, string(ackKey): ackHeader} {
+		require.Equal(t, append(sdk.EncodePackageHeader(header), payload...), store.Get([]byte(key)))
+	}
+}
@@ -74,3 +74,17 @@ func (mr *MockCrossChainApplicationMockRecorder) ExecuteFailAckPackage(ctx, head
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteFailAckPackage", reflect.TypeOf((*MockCrossChainApplication)(nil).ExecuteFailAckPackage), ctx, payload)
 }
+
+// ExecuteTimeoutPackage mocks base method
+func (m *MockCrossChainApplication) ExecuteTimeoutPackage(ctx types.Context, header *types.CrossChainAppContext, payload []byte) types.ExecuteResult {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteTimeoutPackage", ctx, payload)
+	ret0, _ := ret[0].(types.ExecuteResult)
+	return ret0
+}
+
+// ExecuteTimeoutPackage indicates an expected call of ExecuteTimeoutPackage
+func (mr *MockCrossChainApplicationMockRecorder) ExecuteTimeoutPackage(ctx, header, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteTimeoutPackage", reflect.TypeOf((*MockCrossChainApplication)(nil).ExecuteTimeoutPackage), ctx, payload)
+}
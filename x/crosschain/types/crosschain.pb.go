@@ -9,6 +9,7 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	crypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -29,6 +30,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// initial balance to mint for crosschain module when the chain starts
 	InitModuleBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=init_module_balance,json=initModuleBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"init_module_balance"`
+	// number of the latest cross chain packages to keep for each channel of a dest chain, older packages are pruned
+	// when new packages are created. 0 means the packages are never pruned.
+	PackageRetentionSequences uint64 `protobuf:"varint,2,opt,name=package_retention_sequences,json=packageRetentionSequences,proto3" json:"package_retention_sequences,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -64,8 +68,376 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetPackageRetentionSequences() uint64 {
+	if m != nil {
+		return m.PackageRetentionSequences
+	}
+	return 0
+}
+
+// CrossChainPackage defines a cross chain package stored in the crosschain module.
+type CrossChainPackage struct {
+	// source chain id of the cross chain package
+	SrcChainId uint32 `protobuf:"varint,1,opt,name=src_chain_id,json=srcChainId,proto3" json:"src_chain_id,omitempty"`
+	// destination chain id of the cross chain package
+	DestChainId uint32 `protobuf:"varint,2,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	// channel id of the cross chain package
+	ChannelId uint32 `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence of the cross chain package
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// content of the cross chain package, including the package header
+	Package []byte `protobuf:"bytes,5,opt,name=package,proto3" json:"package,omitempty"`
+}
+
+func (m *CrossChainPackage) Reset()         { *m = CrossChainPackage{} }
+func (m *CrossChainPackage) String() string { return proto.CompactTextString(m) }
+func (*CrossChainPackage) ProtoMessage()    {}
+func (*CrossChainPackage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7b94a7254cf916a, []int{1}
+}
+func (m *CrossChainPackage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossChainPackage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossChainPackage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrossChainPackage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossChainPackage.Merge(m, src)
+}
+func (m *CrossChainPackage) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossChainPackage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossChainPackage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossChainPackage proto.InternalMessageInfo
+
+func (m *CrossChainPackage) GetSrcChainId() uint32 {
+	if m != nil {
+		return m.SrcChainId
+	}
+	return 0
+}
+
+func (m *CrossChainPackage) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *CrossChainPackage) GetChannelId() uint32 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *CrossChainPackage) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *CrossChainPackage) GetPackage() []byte {
+	if m != nil {
+		return m.Package
+	}
+	return nil
+}
+
+// ChannelSequence defines the sequence of a channel to a destination chain.
+type ChannelSequence struct {
+	// destination chain id of the channel
+	DestChainId uint32 `protobuf:"varint,1,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	// channel id
+	ChannelId uint32 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence of the channel
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *ChannelSequence) Reset()         { *m = ChannelSequence{} }
+func (m *ChannelSequence) String() string { return proto.CompactTextString(m) }
+func (*ChannelSequence) ProtoMessage()    {}
+func (*ChannelSequence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7b94a7254cf916a, []int{2}
+}
+func (m *ChannelSequence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelSequence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelSequence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelSequence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelSequence.Merge(m, src)
+}
+func (m *ChannelSequence) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelSequence) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelSequence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelSequence proto.InternalMessageInfo
+
+func (m *ChannelSequence) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *ChannelSequence) GetChannelId() uint32 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *ChannelSequence) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// ChannelPermission defines the send permission of a channel to a destination chain.
+type ChannelPermission struct {
+	// destination chain id of the channel
+	DestChainId uint32 `protobuf:"varint,1,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	// channel id
+	ChannelId uint32 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// send permission of the channel, 1 means allowed and 0 means forbidden
+	Permission uint32 `protobuf:"varint,3,opt,name=permission,proto3" json:"permission,omitempty"`
+}
+
+func (m *ChannelPermission) Reset()         { *m = ChannelPermission{} }
+func (m *ChannelPermission) String() string { return proto.CompactTextString(m) }
+func (*ChannelPermission) ProtoMessage()    {}
+func (*ChannelPermission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7b94a7254cf916a, []int{3}
+}
+func (m *ChannelPermission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelPermission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelPermission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelPermission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelPermission.Merge(m, src)
+}
+func (m *ChannelPermission) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelPermission) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelPermission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelPermission proto.InternalMessageInfo
+
+func (m *ChannelPermission) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *ChannelPermission) GetChannelId() uint32 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *ChannelPermission) GetPermission() uint32 {
+	if m != nil {
+		return m.Permission
+	}
+	return 0
+}
+
+// PackageTimeout defines the timeout of a syn cross chain package sent to a destination chain.
+type PackageTimeout struct {
+	// destination chain id of the cross chain package
+	DestChainId uint32 `protobuf:"varint,1,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	// channel id of the cross chain package
+	ChannelId uint32 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence of the cross chain package
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// block time in unix seconds from which the package is timed out, it is also encoded into the package header
+	TimeoutTimestamp uint64 `protobuf:"varint,4,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+}
+
+func (m *PackageTimeout) Reset()         { *m = PackageTimeout{} }
+func (m *PackageTimeout) String() string { return proto.CompactTextString(m) }
+func (*PackageTimeout) ProtoMessage()    {}
+func (*PackageTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7b94a7254cf916a, []int{4}
+}
+func (m *PackageTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PackageTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PackageTimeout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PackageTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PackageTimeout.Merge(m, src)
+}
+func (m *PackageTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *PackageTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_PackageTimeout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PackageTimeout proto.InternalMessageInfo
+
+func (m *PackageTimeout) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *PackageTimeout) GetChannelId() uint32 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *PackageTimeout) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *PackageTimeout) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+// CrossChainStoreProof defines a key-value pair in the crosschain store along with its merkle proof.
+type CrossChainStoreProof struct {
+	// key in the crosschain store
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value of the key, it is empty if the key does not exist in the store
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// proof_ops is the ICS23 existence or absence proof of the key from the crosschain store up to the app hash
+	ProofOps *crypto.ProofOps `protobuf:"bytes,3,opt,name=proof_ops,json=proofOps,proto3" json:"proof_ops,omitempty"`
+	// height of the queried state, the proof should be verified against the app hash in the header of height + 1
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *CrossChainStoreProof) Reset()         { *m = CrossChainStoreProof{} }
+func (m *CrossChainStoreProof) String() string { return proto.CompactTextString(m) }
+func (*CrossChainStoreProof) ProtoMessage()    {}
+func (*CrossChainStoreProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d7b94a7254cf916a, []int{5}
+}
+func (m *CrossChainStoreProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossChainStoreProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossChainStoreProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrossChainStoreProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossChainStoreProof.Merge(m, src)
+}
+func (m *CrossChainStoreProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossChainStoreProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossChainStoreProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossChainStoreProof proto.InternalMessageInfo
+
+func (m *CrossChainStoreProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *CrossChainStoreProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *CrossChainStoreProof) GetProofOps() *crypto.ProofOps {
+	if m != nil {
+		return m.ProofOps
+	}
+	return nil
+}
+
+func (m *CrossChainStoreProof) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.crosschain.v1.Params")
+	proto.RegisterType((*CrossChainPackage)(nil), "cosmos.crosschain.v1.CrossChainPackage")
+	proto.RegisterType((*ChannelSequence)(nil), "cosmos.crosschain.v1.ChannelSequence")
+	proto.RegisterType((*ChannelPermission)(nil), "cosmos.crosschain.v1.ChannelPermission")
+	proto.RegisterType((*PackageTimeout)(nil), "cosmos.crosschain.v1.PackageTimeout")
+	proto.RegisterType((*CrossChainStoreProof)(nil), "cosmos.crosschain.v1.CrossChainStoreProof")
 }
 
 func init() {
@@ -73,22 +445,42 @@ func init() {
 }
 
 var fileDescriptor_d7b94a7254cf916a = []byte{
-	// 227 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xca, 0x2f, 0x2e, 0x4e, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x33,
-	0x44, 0xe2, 0xe9, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x40, 0x94, 0xe9, 0x21, 0x49, 0x94,
-	0x19, 0x4a, 0x49, 0x42, 0x44, 0xe3, 0xc1, 0x6a, 0xf4, 0xa1, 0x4a, 0xc0, 0x1c, 0x29, 0x91, 0xf4,
-	0xfc, 0xf4, 0x7c, 0x88, 0x38, 0x88, 0x05, 0x11, 0x55, 0x2a, 0xe3, 0x62, 0x0b, 0x48, 0x2c, 0x4a,
-	0xcc, 0x2d, 0x16, 0xca, 0xe1, 0x12, 0xce, 0xcc, 0xcb, 0x2c, 0x89, 0xcf, 0xcd, 0x4f, 0x29, 0xcd,
-	0x49, 0x8d, 0x4f, 0x4a, 0xcc, 0x49, 0xcc, 0x4b, 0x4e, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74,
-	0xb2, 0x39, 0x71, 0x4f, 0x9e, 0xe1, 0xd6, 0x3d, 0x79, 0xb5, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x98, 0x3b, 0xc1, 0x94, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x49, 0x65,
-	0x41, 0x6a, 0xb1, 0x9e, 0x67, 0x5e, 0xc9, 0xa5, 0x2d, 0xba, 0x5c, 0x50, 0xcb, 0x3d, 0xf3, 0x4a,
-	0x82, 0x04, 0x41, 0x06, 0xfb, 0x82, 0xcd, 0x75, 0x82, 0x18, 0xeb, 0xe4, 0x79, 0xe2, 0x91, 0x1c,
-	0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xfa, 0x78, 0xad, 0xa8, 0x40, 0x0e, 0x17, 0xb0, 0x7d,
-	0x49, 0x6c, 0x60, 0x9f, 0x18, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x23, 0x9a, 0x6c, 0x39,
-	0x01, 0x00, 0x00,
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc6, 0xeb, 0x76, 0xeb, 0x7f, 0x7d, 0xd7, 0xfd, 0x59, 0x4d, 0x85, 0xba, 0x4e, 0xcb, 0xaa,
+	0x48, 0xa0, 0x4a, 0x68, 0x89, 0x06, 0x17, 0x0e, 0x88, 0x43, 0x7b, 0xea, 0x01, 0x51, 0x65, 0x3b,
+	0x71, 0x89, 0x52, 0xc7, 0xb4, 0x51, 0x1b, 0xdb, 0xc4, 0x6e, 0x45, 0xbf, 0xc5, 0x3e, 0x00, 0x9f,
+	0x81, 0x13, 0xe2, 0x33, 0xec, 0x38, 0x71, 0x42, 0x1c, 0x26, 0xd4, 0x7e, 0x11, 0x14, 0xdb, 0x59,
+	0x8b, 0x90, 0xc6, 0x05, 0x4e, 0xf1, 0xfb, 0xf8, 0xd1, 0xeb, 0x9f, 0xdf, 0xe4, 0x09, 0x3c, 0x26,
+	0x5c, 0xa6, 0x5c, 0xfa, 0x24, 0xe3, 0x52, 0x92, 0x49, 0x94, 0x30, 0x7f, 0x71, 0xbe, 0x55, 0x79,
+	0x22, 0xe3, 0x8a, 0xe3, 0xa6, 0xb1, 0x79, 0x5b, 0x1b, 0x8b, 0xf3, 0xf6, 0x91, 0x51, 0x43, 0xed,
+	0xf1, 0xad, 0x45, 0x17, 0xed, 0xe6, 0x98, 0x8f, 0xb9, 0xd1, 0xf3, 0x95, 0x55, 0x4f, 0x14, 0x65,
+	0x31, 0xcd, 0xd2, 0x84, 0x29, 0x9f, 0x64, 0x4b, 0xa1, 0xb8, 0x2f, 0x32, 0xce, 0xdf, 0x99, 0x6d,
+	0xf7, 0x0b, 0x82, 0xea, 0x30, 0xca, 0xa2, 0x54, 0xe2, 0x19, 0x3c, 0x4c, 0x58, 0xa2, 0xc2, 0x94,
+	0xc7, 0xf3, 0x19, 0x0d, 0x47, 0xd1, 0x2c, 0x62, 0x84, 0xb6, 0x50, 0x07, 0x75, 0x6b, 0xbd, 0x97,
+	0xd7, 0xb7, 0xa7, 0xa5, 0xef, 0xb7, 0xa7, 0x4f, 0xc6, 0x89, 0x9a, 0xcc, 0x47, 0x1e, 0xe1, 0xa9,
+	0x5f, 0xdc, 0x43, 0x3f, 0xce, 0x64, 0x3c, 0xf5, 0xd5, 0x52, 0x50, 0xe9, 0x0d, 0x98, 0xfa, 0xfa,
+	0xf9, 0x0c, 0x2c, 0xdc, 0x80, 0xa9, 0xa0, 0x91, 0x37, 0x7e, 0xad, 0xfb, 0xf6, 0x4c, 0x5b, 0xfc,
+	0x0a, 0x8e, 0x45, 0x44, 0xa6, 0xd1, 0x98, 0x86, 0x19, 0x55, 0x94, 0xa9, 0x84, 0xb3, 0x50, 0xd2,
+	0xf7, 0x73, 0xca, 0x08, 0x95, 0xad, 0x72, 0x07, 0x75, 0x77, 0x82, 0x23, 0x6b, 0x09, 0x0a, 0xc7,
+	0x45, 0x61, 0x70, 0x3f, 0x21, 0x68, 0xf4, 0xf3, 0xd1, 0xf4, 0xf3, 0xd1, 0x0c, 0x8d, 0x0f, 0x77,
+	0xa0, 0x2e, 0x33, 0x12, 0xea, 0x71, 0x85, 0x49, 0xac, 0xe1, 0x0f, 0x02, 0x90, 0x19, 0xd1, 0xb6,
+	0x41, 0x8c, 0x5d, 0x38, 0x88, 0xa9, 0x54, 0x1b, 0x4b, 0x59, 0x5b, 0xf6, 0x73, 0xb1, 0xf0, 0x9c,
+	0x00, 0x90, 0x49, 0xc4, 0x18, 0x9d, 0xe5, 0x86, 0x8a, 0x36, 0xd4, 0xac, 0x32, 0x88, 0x71, 0x1b,
+	0xf6, 0x0a, 0xd0, 0xd6, 0x8e, 0xe6, 0xbc, 0xab, 0x71, 0x0b, 0xfe, 0xb3, 0xcc, 0xad, 0xdd, 0x0e,
+	0xea, 0xd6, 0x83, 0xa2, 0x74, 0x05, 0x3c, 0xe8, 0x9b, 0x16, 0xc5, 0x25, 0x7e, 0x67, 0x41, 0x7f,
+	0x62, 0x29, 0xdf, 0xc7, 0x52, 0xf9, 0x95, 0xc5, 0x5d, 0x40, 0xc3, 0x9e, 0x38, 0xcc, 0x3f, 0x00,
+	0x29, 0x13, 0xce, 0xfe, 0xc6, 0x99, 0x0e, 0x80, 0xb8, 0x6b, 0x68, 0xc7, 0xb3, 0xa5, 0xb8, 0x1f,
+	0x11, 0xfc, 0x6f, 0x5f, 0xc8, 0x65, 0x92, 0x52, 0x3e, 0x57, 0xff, 0xf8, 0xa6, 0xf8, 0x29, 0x34,
+	0x94, 0x39, 0x29, 0xcc, 0x9f, 0x52, 0x45, 0xa9, 0xb0, 0xaf, 0xe6, 0xd0, 0x6e, 0x5c, 0x16, 0xba,
+	0x7b, 0x85, 0xa0, 0xb9, 0xf9, 0x72, 0x2e, 0x14, 0xcf, 0xe8, 0x30, 0x4f, 0x04, 0x3e, 0x84, 0xca,
+	0x94, 0x2e, 0x35, 0x5a, 0x3d, 0xc8, 0x97, 0xb8, 0x09, 0xbb, 0x8b, 0x68, 0x36, 0xa7, 0x9a, 0xa6,
+	0x1e, 0x98, 0x02, 0xbf, 0x80, 0x9a, 0x8e, 0x50, 0xc8, 0x85, 0xd4, 0x28, 0xfb, 0xcf, 0x8e, 0xbd,
+	0x4d, 0xcc, 0x3c, 0x13, 0x33, 0x4f, 0x37, 0x7d, 0x23, 0x64, 0xb0, 0x27, 0xec, 0x0a, 0x3f, 0x82,
+	0xea, 0x84, 0x26, 0xe3, 0x89, 0xd2, 0x70, 0x95, 0xc0, 0x56, 0xbd, 0xc1, 0xf5, 0xca, 0x41, 0x37,
+	0x2b, 0x07, 0xfd, 0x58, 0x39, 0xe8, 0x6a, 0xed, 0x94, 0x6e, 0xd6, 0x4e, 0xe9, 0xdb, 0xda, 0x29,
+	0xbd, 0xf5, 0xef, 0xcd, 0xdb, 0x87, 0xed, 0x9f, 0x88, 0x0e, 0xdf, 0xa8, 0xaa, 0x73, 0xfd, 0xfc,
+	0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa0, 0x94, 0x50, 0x4e, 0x66, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -111,48 +503,988 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PackageRetentionSequences != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.PackageRetentionSequences))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.InitModuleBalance.Size()
 		i -= size
 		if _, err := m.InitModuleBalance.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintCrosschain(dAtA, i, uint64(size))
+		i = encodeVarintCrosschain(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CrossChainPackage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossChainPackage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossChainPackage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Package) > 0 {
+		i -= len(m.Package)
+		copy(dAtA[i:], m.Package)
+		i = encodeVarintCrosschain(dAtA, i, uint64(len(m.Package)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DestChainId != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SrcChainId != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.SrcChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelSequence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelSequence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DestChainId != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelPermission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelPermission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelPermission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DestChainId != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PackageTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PackageTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PackageTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DestChainId != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CrossChainStoreProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossChainStoreProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossChainStoreProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintCrosschain(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProofOps != nil {
+		{
+			size, err := m.ProofOps.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrosschain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintCrosschain(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCrosschain(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCrosschain(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCrosschain(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitModuleBalance.Size()
+	n += 1 + l + sovCrosschain(uint64(l))
+	if m.PackageRetentionSequences != 0 {
+		n += 1 + sovCrosschain(uint64(m.PackageRetentionSequences))
+	}
+	return n
+}
+
+func (m *CrossChainPackage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SrcChainId != 0 {
+		n += 1 + sovCrosschain(uint64(m.SrcChainId))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovCrosschain(uint64(m.DestChainId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovCrosschain(uint64(m.ChannelId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovCrosschain(uint64(m.Sequence))
+	}
+	l = len(m.Package)
+	if l > 0 {
+		n += 1 + l + sovCrosschain(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestChainId != 0 {
+		n += 1 + sovCrosschain(uint64(m.DestChainId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovCrosschain(uint64(m.ChannelId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovCrosschain(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *ChannelPermission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestChainId != 0 {
+		n += 1 + sovCrosschain(uint64(m.DestChainId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovCrosschain(uint64(m.ChannelId))
+	}
+	if m.Permission != 0 {
+		n += 1 + sovCrosschain(uint64(m.Permission))
+	}
+	return n
+}
+
+func (m *PackageTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestChainId != 0 {
+		n += 1 + sovCrosschain(uint64(m.DestChainId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovCrosschain(uint64(m.ChannelId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovCrosschain(uint64(m.Sequence))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovCrosschain(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *CrossChainStoreProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCrosschain(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovCrosschain(uint64(l))
+	}
+	if m.ProofOps != nil {
+		l = m.ProofOps.Size()
+		n += 1 + l + sovCrosschain(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCrosschain(uint64(m.Height))
+	}
+	return n
+}
+
+func sovCrosschain(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCrosschain(x uint64) (n int) {
+	return sovCrosschain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitModuleBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitModuleBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackageRetentionSequences", wireType)
+			}
+			m.PackageRetentionSequences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PackageRetentionSequences |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrossChainPackage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossChainPackage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossChainPackage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcChainId", wireType)
+			}
+			m.SrcChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Package = append(m.Package[:0], dAtA[iNdEx:postIndex]...)
+			if m.Package == nil {
+				m.Package = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelPermission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelPermission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelPermission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintCrosschain(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCrosschain(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PackageTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PackageTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PackageTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.InitModuleBalance.Size()
-	n += 1 + l + sovCrosschain(uint64(l))
-	return n
-}
 
-func sovCrosschain(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCrosschain(x uint64) (n int) {
-	return sovCrosschain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *CrossChainStoreProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -175,17 +1507,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: CrossChainStoreProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CrossChainStoreProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitModuleBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCrosschain
@@ -195,26 +1527,115 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCrosschain
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCrosschain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitModuleBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProofOps == nil {
+				m.ProofOps = &crypto.ProofOps{}
+			}
+			if err := m.ProofOps.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrosschain(dAtA[iNdEx:])
@@ -0,0 +1,11 @@
+package types
+
+import sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+// x/crosschain module sentinel errors
+var (
+	ErrDecodePackage      = sdkerrors.Register(ModuleName, 2, "decode cross chain package error")
+	ErrEncodePackage      = sdkerrors.Register(ModuleName, 3, "encode cross chain package error")
+	ErrInvalidAckSequence = sdkerrors.Register(ModuleName, 4, "invalid acknowledged package sequence")
+	ErrPackageTimedOut    = sdkerrors.Register(ModuleName, 5, "cross chain package is timed out")
+)
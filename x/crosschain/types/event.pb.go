@@ -42,6 +42,10 @@ type EventCrossChain struct {
 	RelayerFee string `protobuf:"bytes,8,opt,name=relayer_fee,json=relayerFee,proto3" json:"relayer_fee,omitempty"`
 	// Relayer fee for the ACK or FAIL_ACK package of this cross chain package
 	AckRelayerFee string `protobuf:"bytes,9,opt,name=ack_relayer_fee,json=ackRelayerFee,proto3" json:"ack_relayer_fee,omitempty"`
+	// Sequence of the SYN package which the ACK or FAIL_ACK package responds to, it is 0 for SYN packages
+	SynSequence uint64 `protobuf:"varint,10,opt,name=syn_sequence,json=synSequence,proto3" json:"syn_sequence,omitempty"`
+	// Block time in unix seconds from which the SYN package is timed out, 0 means no timeout
+	TimeoutTimestamp uint64 `protobuf:"varint,11,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
 }
 
 func (m *EventCrossChain) Reset()         { *m = EventCrossChain{} }
@@ -140,35 +144,299 @@ func (m *EventCrossChain) GetAckRelayerFee() string {
 	return ""
 }
 
+func (m *EventCrossChain) GetSynSequence() uint64 {
+	if m != nil {
+		return m.SynSequence
+	}
+	return 0
+}
+
+func (m *EventCrossChain) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+// EventCrossChainPackagesPruned is emitted when cross chain packages are pruned from the store
+type EventCrossChainPackagesPruned struct {
+	// Source chain id of the pruned cross chain packages
+	SrcChainId uint32 `protobuf:"varint,1,opt,name=src_chain_id,json=srcChainId,proto3" json:"src_chain_id,omitempty"`
+	// Destination chain id of the pruned cross chain packages
+	DestChainId uint32 `protobuf:"varint,2,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	// Channel id of the pruned cross chain packages
+	ChannelId uint32 `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Packages with sequence less than end_sequence are pruned
+	EndSequence uint64 `protobuf:"varint,4,opt,name=end_sequence,json=endSequence,proto3" json:"end_sequence,omitempty"`
+	// Number of the pruned cross chain packages
+	Count uint64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *EventCrossChainPackagesPruned) Reset()         { *m = EventCrossChainPackagesPruned{} }
+func (m *EventCrossChainPackagesPruned) String() string { return proto.CompactTextString(m) }
+func (*EventCrossChainPackagesPruned) ProtoMessage()    {}
+func (*EventCrossChainPackagesPruned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a5a3ba75f5dd2c3, []int{1}
+}
+func (m *EventCrossChainPackagesPruned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCrossChainPackagesPruned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCrossChainPackagesPruned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCrossChainPackagesPruned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCrossChainPackagesPruned.Merge(m, src)
+}
+func (m *EventCrossChainPackagesPruned) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCrossChainPackagesPruned) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCrossChainPackagesPruned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCrossChainPackagesPruned proto.InternalMessageInfo
+
+func (m *EventCrossChainPackagesPruned) GetSrcChainId() uint32 {
+	if m != nil {
+		return m.SrcChainId
+	}
+	return 0
+}
+
+func (m *EventCrossChainPackagesPruned) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *EventCrossChainPackagesPruned) GetChannelId() uint32 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *EventCrossChainPackagesPruned) GetEndSequence() uint64 {
+	if m != nil {
+		return m.EndSequence
+	}
+	return 0
+}
+
+func (m *EventCrossChainPackagesPruned) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// EventChannelPermissionUpdated is emitted when the send permission of a channel is updated
+type EventChannelPermissionUpdated struct {
+	// Destination chain id of the channel
+	DestChainId uint32 `protobuf:"varint,1,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	// Channel id
+	ChannelId uint32 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Send permission of the channel, 1 means allowed and 0 means forbidden
+	Permission uint32 `protobuf:"varint,3,opt,name=permission,proto3" json:"permission,omitempty"`
+}
+
+func (m *EventChannelPermissionUpdated) Reset()         { *m = EventChannelPermissionUpdated{} }
+func (m *EventChannelPermissionUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventChannelPermissionUpdated) ProtoMessage()    {}
+func (*EventChannelPermissionUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a5a3ba75f5dd2c3, []int{2}
+}
+func (m *EventChannelPermissionUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChannelPermissionUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChannelPermissionUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChannelPermissionUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChannelPermissionUpdated.Merge(m, src)
+}
+func (m *EventChannelPermissionUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChannelPermissionUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChannelPermissionUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChannelPermissionUpdated proto.InternalMessageInfo
+
+func (m *EventChannelPermissionUpdated) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *EventChannelPermissionUpdated) GetChannelId() uint32 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *EventChannelPermissionUpdated) GetPermission() uint32 {
+	if m != nil {
+		return m.Permission
+	}
+	return 0
+}
+
+// EventCrossChainPackageTimeout is emitted when a syn cross chain package is timed out without being acknowledged
+type EventCrossChainPackageTimeout struct {
+	// Source chain id of the cross chain package
+	SrcChainId uint32 `protobuf:"varint,1,opt,name=src_chain_id,json=srcChainId,proto3" json:"src_chain_id,omitempty"`
+	// Destination chain id of the cross chain package
+	DestChainId uint32 `protobuf:"varint,2,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	// Channel id of the cross chain package
+	ChannelId uint32 `protobuf:"varint,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Sequence of the cross chain package
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Timeout timestamp of the cross chain package
+	TimeoutTimestamp uint64 `protobuf:"varint,5,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// Error message of the timeout callback of the cross chain app, empty if the callback succeeded
+	ErrorMsg string `protobuf:"bytes,6,opt,name=error_msg,json=errorMsg,proto3" json:"error_msg,omitempty"`
+}
+
+func (m *EventCrossChainPackageTimeout) Reset()         { *m = EventCrossChainPackageTimeout{} }
+func (m *EventCrossChainPackageTimeout) String() string { return proto.CompactTextString(m) }
+func (*EventCrossChainPackageTimeout) ProtoMessage()    {}
+func (*EventCrossChainPackageTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a5a3ba75f5dd2c3, []int{3}
+}
+func (m *EventCrossChainPackageTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCrossChainPackageTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCrossChainPackageTimeout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCrossChainPackageTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCrossChainPackageTimeout.Merge(m, src)
+}
+func (m *EventCrossChainPackageTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCrossChainPackageTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCrossChainPackageTimeout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCrossChainPackageTimeout proto.InternalMessageInfo
+
+func (m *EventCrossChainPackageTimeout) GetSrcChainId() uint32 {
+	if m != nil {
+		return m.SrcChainId
+	}
+	return 0
+}
+
+func (m *EventCrossChainPackageTimeout) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *EventCrossChainPackageTimeout) GetChannelId() uint32 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *EventCrossChainPackageTimeout) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *EventCrossChainPackageTimeout) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *EventCrossChainPackageTimeout) GetErrorMsg() string {
+	if m != nil {
+		return m.ErrorMsg
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCrossChain)(nil), "cosmos.crosschain.v1.EventCrossChain")
+	proto.RegisterType((*EventCrossChainPackagesPruned)(nil), "cosmos.crosschain.v1.EventCrossChainPackagesPruned")
+	proto.RegisterType((*EventChannelPermissionUpdated)(nil), "cosmos.crosschain.v1.EventChannelPermissionUpdated")
+	proto.RegisterType((*EventCrossChainPackageTimeout)(nil), "cosmos.crosschain.v1.EventCrossChainPackageTimeout")
 }
 
 func init() { proto.RegisterFile("cosmos/crosschain/v1/event.proto", fileDescriptor_2a5a3ba75f5dd2c3) }
 
 var fileDescriptor_2a5a3ba75f5dd2c3 = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0xd1, 0x4f, 0x4f, 0xfa, 0x30,
-	0x18, 0xc0, 0x71, 0xca, 0x8f, 0x1f, 0xb2, 0x07, 0x08, 0x49, 0xe3, 0x61, 0x31, 0x3a, 0x27, 0x07,
-	0xc3, 0xc5, 0x2d, 0xc4, 0x77, 0x20, 0xd1, 0x84, 0xc4, 0x13, 0xf1, 0xe4, 0x65, 0x29, 0xed, 0x23,
-	0x2c, 0x63, 0xeb, 0x6c, 0x0b, 0x91, 0x77, 0xe1, 0x3b, 0xf2, 0xea, 0x91, 0xa3, 0x47, 0x03, 0x6f,
-	0xc4, 0xb4, 0xfc, 0x3d, 0x2d, 0xfb, 0xf6, 0xd3, 0x27, 0x69, 0x1e, 0x08, 0xb9, 0xd4, 0xb9, 0xd4,
-	0x31, 0x57, 0x52, 0x6b, 0x3e, 0x65, 0x69, 0x11, 0x2f, 0xfa, 0x31, 0x2e, 0xb0, 0x30, 0x51, 0xa9,
-	0xa4, 0x91, 0xf4, 0x7c, 0x2b, 0xa2, 0xa3, 0x88, 0x16, 0xfd, 0xee, 0x57, 0x15, 0x3a, 0x8f, 0x56,
-	0x0d, 0x6c, 0x1e, 0xd8, 0x4c, 0x43, 0x68, 0x69, 0xc5, 0x13, 0x67, 0x92, 0x54, 0xf8, 0x24, 0x24,
-	0xbd, 0xf6, 0x08, 0xb4, 0xe2, 0xee, 0x7c, 0x28, 0x68, 0x17, 0xda, 0x02, 0xb5, 0x39, 0x92, 0xaa,
-	0x23, 0x4d, 0x1b, 0xf7, 0xe6, 0x0a, 0x80, 0x4f, 0x59, 0x51, 0xe0, 0xcc, 0x82, 0x7f, 0x0e, 0x78,
-	0xbb, 0x32, 0x14, 0xf4, 0x02, 0x1a, 0x1a, 0xdf, 0xe7, 0x58, 0x70, 0xf4, 0x6b, 0x21, 0xe9, 0xd5,
-	0x46, 0x87, 0x7f, 0x7a, 0x03, 0xad, 0x92, 0xf1, 0x8c, 0x4d, 0x30, 0x31, 0xcb, 0x12, 0xfd, 0xff,
-	0xdb, 0xe9, 0xbb, 0xf6, 0xb2, 0x2c, 0x91, 0x5e, 0x82, 0x67, 0xd2, 0x1c, 0xb5, 0x61, 0x79, 0xe9,
-	0xd7, 0xdd, 0xfd, 0x63, 0x38, 0x1d, 0x30, 0x93, 0x4c, 0xf8, 0x67, 0x21, 0xe9, 0x79, 0x87, 0x01,
-	0xcf, 0x92, 0x09, 0x7a, 0x0d, 0x4d, 0x85, 0x33, 0xb6, 0x44, 0x95, 0xbc, 0x21, 0xfa, 0x0d, 0x27,
-	0x60, 0x97, 0x9e, 0x10, 0xe9, 0x2d, 0x74, 0x18, 0xcf, 0x92, 0x53, 0xe4, 0x39, 0xd4, 0x66, 0x3c,
-	0x1b, 0x1d, 0xdc, 0xc3, 0xf0, 0x7b, 0x1d, 0x90, 0xd5, 0x3a, 0x20, 0xbf, 0xeb, 0x80, 0x7c, 0x6e,
-	0x82, 0xca, 0x6a, 0x13, 0x54, 0x7e, 0x36, 0x41, 0xe5, 0x35, 0x9e, 0xa4, 0x66, 0x3a, 0x1f, 0x47,
-	0x5c, 0xe6, 0xf1, 0x7e, 0x3d, 0xee, 0x73, 0xa7, 0x45, 0x16, 0x7f, 0x9c, 0xee, 0xca, 0x3e, 0x53,
-	0x8f, 0xeb, 0x6e, 0x53, 0xf7, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x93, 0x08, 0x3b, 0x98, 0xcd,
-	0x01, 0x00, 0x00,
+	// 483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x94, 0x41, 0x8f, 0xd2, 0x40,
+	0x14, 0xc7, 0xe9, 0x2e, 0xac, 0xf4, 0x01, 0x59, 0x6d, 0xf6, 0xd0, 0xa8, 0x5b, 0x81, 0x83, 0x21,
+	0x31, 0xd2, 0x6c, 0xfc, 0x06, 0x6e, 0x34, 0x21, 0xd1, 0x84, 0x54, 0xbc, 0x78, 0x69, 0x66, 0x67,
+	0x9e, 0xd0, 0x40, 0x67, 0xea, 0xcc, 0x94, 0xd8, 0xab, 0x5f, 0x40, 0xbf, 0x90, 0x77, 0x8f, 0x7b,
+	0xf4, 0x68, 0xe0, 0xe6, 0xa7, 0x30, 0x9d, 0x96, 0x96, 0x10, 0x8c, 0x37, 0xf7, 0x44, 0xe6, 0x3f,
+	0x3f, 0xfe, 0xf9, 0xbf, 0x79, 0xaf, 0x0f, 0xfa, 0x54, 0xa8, 0x58, 0x28, 0x9f, 0x4a, 0xa1, 0x14,
+	0x5d, 0x90, 0x88, 0xfb, 0xeb, 0x2b, 0x1f, 0xd7, 0xc8, 0xf5, 0x38, 0x91, 0x42, 0x0b, 0xe7, 0xa2,
+	0x20, 0xc6, 0x35, 0x31, 0x5e, 0x5f, 0x0d, 0xbf, 0x9e, 0xc2, 0xf9, 0xab, 0x9c, 0xba, 0xce, 0xe5,
+	0xeb, 0x5c, 0x76, 0xfa, 0xd0, 0x55, 0x92, 0x86, 0x86, 0x09, 0x23, 0xe6, 0x5a, 0x7d, 0x6b, 0xd4,
+	0x0b, 0x40, 0x49, 0x6a, 0xee, 0x27, 0xcc, 0x19, 0x42, 0x8f, 0xa1, 0xd2, 0x35, 0x72, 0x62, 0x90,
+	0x4e, 0x2e, 0xee, 0x98, 0x4b, 0x00, 0xba, 0x20, 0x9c, 0xe3, 0x2a, 0x07, 0x4e, 0x0d, 0x60, 0x97,
+	0xca, 0x84, 0x39, 0x0f, 0xa1, 0xad, 0xf0, 0x53, 0x8a, 0x9c, 0xa2, 0xdb, 0xec, 0x5b, 0xa3, 0x66,
+	0x50, 0x9d, 0x9d, 0x01, 0x74, 0x13, 0x42, 0x97, 0x64, 0x8e, 0xa1, 0xce, 0x12, 0x74, 0x5b, 0x85,
+	0x7b, 0xa9, 0xcd, 0xb2, 0x04, 0x9d, 0xc7, 0x60, 0xeb, 0x28, 0x46, 0xa5, 0x49, 0x9c, 0xb8, 0x67,
+	0xe6, 0xff, 0xb5, 0xb0, 0x6f, 0xb0, 0x12, 0x84, 0xb9, 0xf7, 0xfa, 0xd6, 0xc8, 0xae, 0x0c, 0xde,
+	0x08, 0xc2, 0x9c, 0x27, 0xd0, 0x91, 0xb8, 0x22, 0x19, 0xca, 0xf0, 0x23, 0xa2, 0xdb, 0x36, 0x04,
+	0x94, 0xd2, 0x6b, 0x44, 0xe7, 0x29, 0x9c, 0x13, 0xba, 0x0c, 0xf7, 0x21, 0xdb, 0x40, 0x3d, 0x42,
+	0x97, 0x41, 0xcd, 0x0d, 0xa0, 0xab, 0x32, 0x1e, 0x56, 0xc5, 0x80, 0x09, 0xd3, 0x51, 0x19, 0x7f,
+	0xb7, 0xab, 0xe7, 0x19, 0x3c, 0xc8, 0xb3, 0x89, 0x54, 0x87, 0x75, 0xe8, 0x8e, 0xe1, 0xee, 0x97,
+	0x17, 0xb3, 0x9d, 0x3e, 0xfc, 0x6e, 0xc1, 0xe5, 0x41, 0x47, 0xa6, 0x45, 0x6e, 0x35, 0x95, 0x29,
+	0x47, 0xf6, 0x7f, 0xfa, 0x33, 0x80, 0x2e, 0x72, 0x16, 0x1e, 0xf4, 0xa8, 0x83, 0x9c, 0x55, 0x65,
+	0x5d, 0x40, 0x8b, 0x8a, 0x94, 0x6b, 0xd3, 0x9f, 0x66, 0x50, 0x1c, 0x86, 0x5f, 0xaa, 0xfc, 0x85,
+	0xd7, 0x14, 0x65, 0x1c, 0x29, 0x15, 0x09, 0xfe, 0x3e, 0x61, 0x44, 0xe3, 0x91, 0x74, 0xd6, 0xbf,
+	0xd2, 0x9d, 0x1c, 0xa6, 0xf3, 0x00, 0x92, 0xca, 0xb7, 0x0c, 0xbf, 0xa7, 0x0c, 0x7f, 0xff, 0xf5,
+	0x11, 0x67, 0xc5, 0x7b, 0xdf, 0xfd, 0x90, 0x1f, 0x1d, 0x8a, 0xd6, 0xf1, 0xa1, 0x70, 0x1e, 0x81,
+	0x8d, 0x52, 0x0a, 0x19, 0xc6, 0x6a, 0x6e, 0xc6, 0xdd, 0x0e, 0xda, 0x46, 0x78, 0xab, 0xe6, 0x2f,
+	0x27, 0x3f, 0x36, 0x9e, 0x75, 0xbb, 0xf1, 0xac, 0x5f, 0x1b, 0xcf, 0xfa, 0xb6, 0xf5, 0x1a, 0xb7,
+	0x5b, 0xaf, 0xf1, 0x73, 0xeb, 0x35, 0x3e, 0xf8, 0xf3, 0x48, 0x2f, 0xd2, 0x9b, 0x31, 0x15, 0xb1,
+	0xbf, 0x5b, 0x10, 0xe6, 0xe7, 0xb9, 0x62, 0x4b, 0xff, 0xf3, 0xfe, 0xb6, 0xc8, 0x3f, 0x34, 0x75,
+	0x73, 0x66, 0x76, 0xc5, 0x8b, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb6, 0x5c, 0x96, 0xd9, 0x4f,
+	0x04, 0x00, 0x00,
 }
 
 func (m *EventCrossChain) Marshal() (dAtA []byte, err error) {
@@ -191,6 +459,16 @@ func (m *EventCrossChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SynSequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SynSequence))
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.AckRelayerFee) > 0 {
 		i -= len(m.AckRelayerFee)
 		copy(dAtA[i:], m.AckRelayerFee)
@@ -245,63 +523,578 @@ func (m *EventCrossChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventCrossChainPackagesPruned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventCrossChain) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventCrossChainPackagesPruned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCrossChainPackagesPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.SrcChainId != 0 {
-		n += 1 + sovEvent(uint64(m.SrcChainId))
+	if m.Count != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.DestChainId != 0 {
-		n += 1 + sovEvent(uint64(m.DestChainId))
+	if m.EndSequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.EndSequence))
+		i--
+		dAtA[i] = 0x20
 	}
 	if m.ChannelId != 0 {
-		n += 1 + sovEvent(uint64(m.ChannelId))
+		i = encodeVarintEvent(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovEvent(uint64(m.Sequence))
+	if m.DestChainId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.PackageType != 0 {
-		n += 1 + sovEvent(uint64(m.PackageType))
+	if m.SrcChainId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SrcChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Timestamp != 0 {
-		n += 1 + sovEvent(uint64(m.Timestamp))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChannelPermissionUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.PackageLoad)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *EventChannelPermissionUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChannelPermissionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.RelayerFee)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.ChannelId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.AckRelayerFee)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.DestChainId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCrossChainPackageTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCrossChainPackageTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCrossChainPackageTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ErrorMsg) > 0 {
+		i -= len(m.ErrorMsg)
+		copy(dAtA[i:], m.ErrorMsg)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ErrorMsg)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DestChainId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SrcChainId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SrcChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCrossChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SrcChainId != 0 {
+		n += 1 + sovEvent(uint64(m.SrcChainId))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovEvent(uint64(m.DestChainId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovEvent(uint64(m.ChannelId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	if m.PackageType != 0 {
+		n += 1 + sovEvent(uint64(m.PackageType))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovEvent(uint64(m.Timestamp))
+	}
+	l = len(m.PackageLoad)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.RelayerFee)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.AckRelayerFee)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SynSequence != 0 {
+		n += 1 + sovEvent(uint64(m.SynSequence))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovEvent(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *EventCrossChainPackagesPruned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SrcChainId != 0 {
+		n += 1 + sovEvent(uint64(m.SrcChainId))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovEvent(uint64(m.DestChainId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovEvent(uint64(m.ChannelId))
+	}
+	if m.EndSequence != 0 {
+		n += 1 + sovEvent(uint64(m.EndSequence))
+	}
+	if m.Count != 0 {
+		n += 1 + sovEvent(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *EventChannelPermissionUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestChainId != 0 {
+		n += 1 + sovEvent(uint64(m.DestChainId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovEvent(uint64(m.ChannelId))
+	}
+	if m.Permission != 0 {
+		n += 1 + sovEvent(uint64(m.Permission))
+	}
+	return n
+}
+
+func (m *EventCrossChainPackageTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SrcChainId != 0 {
+		n += 1 + sovEvent(uint64(m.SrcChainId))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovEvent(uint64(m.DestChainId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovEvent(uint64(m.ChannelId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovEvent(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.ErrorMsg)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCrossChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCrossChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCrossChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcChainId", wireType)
+			}
+			m.SrcChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackageType", wireType)
+			}
+			m.PackageType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PackageType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackageLoad", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PackageLoad = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckRelayerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckRelayerFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynSequence", wireType)
+			}
+			m.SynSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SynSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventCrossChain) Unmarshal(dAtA []byte) error {
+func (m *EventCrossChainPackagesPruned) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -324,10 +1117,10 @@ func (m *EventCrossChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCrossChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCrossChainPackagesPruned: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCrossChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCrossChainPackagesPruned: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -389,9 +1182,9 @@ func (m *EventCrossChain) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSequence", wireType)
 			}
-			m.Sequence = 0
+			m.EndSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -401,16 +1194,16 @@ func (m *EventCrossChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.EndSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PackageType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.PackageType = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -420,16 +1213,66 @@ func (m *EventCrossChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PackageType |= uint32(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventChannelPermissionUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventChannelPermissionUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventChannelPermissionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
 			}
-			m.Timestamp = 0
+			m.DestChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -439,16 +1282,16 @@ func (m *EventCrossChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timestamp |= uint64(b&0x7F) << shift
+				m.DestChainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PackageLoad", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var stringLen uint64
+			m.ChannelId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -458,29 +1301,85 @@ func (m *EventCrossChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChannelId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PackageLoad = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCrossChainPackageTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCrossChainPackageTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCrossChainPackageTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcChainId", wireType)
+			}
+			m.SrcChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -490,27 +1389,90 @@ func (m *EventCrossChain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SrcChainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			m.RelayerFee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckRelayerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMsg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -538,7 +1500,7 @@ func (m *EventCrossChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AckRelayerFee = string(dAtA[iNdEx:postIndex])
+			m.ErrorMsg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
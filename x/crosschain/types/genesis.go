@@ -2,22 +2,51 @@ package types
 
 import (
 	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
 	params Params,
+	packages []CrossChainPackage,
+	sendSequences []ChannelSequence,
+	receiveSequences []ChannelSequence,
+	channelPermissions []ChannelPermission,
+	packageTimeouts []PackageTimeout,
+	ackSequences []ChannelSequence,
+	packageHeaderVersion sdk.PackageHeaderVersion,
+	prunedSequences []ChannelSequence,
 ) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:               params,
+		Packages:             packages,
+		SendSequences:        sendSequences,
+		ReceiveSequences:     receiveSequences,
+		ChannelPermissions:   channelPermissions,
+		PackageTimeouts:      packageTimeouts,
+		AckSequences:         ackSequences,
+		PackageHeaderVersion: uint32(packageHeaderVersion),
+		PrunedSequences:      prunedSequences,
 	}
 }
 
 // DefaultGenesisState - default GenesisState
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:               DefaultParams(),
+		PackageHeaderVersion: uint32(sdk.LatestPackageHeaderVersion),
+	}
+}
+
+// HeaderVersion returns the layout version of the headers of the packages in the genesis state, the genesis exported
+// before the package headers were versioned has no version and is in the legacy layout
+func (gs GenesisState) HeaderVersion() sdk.PackageHeaderVersion {
+	if gs.PackageHeaderVersion == 0 {
+		return sdk.PackageHeaderV1
 	}
+	return sdk.PackageHeaderVersion(gs.PackageHeaderVersion)
 }
 
 // ValidateGenesis validates the cross chain genesis parameters
@@ -26,5 +55,174 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("init module balance should be positive, is %s", data.Params.InitModuleBalance.String())
 	}
 
+	if data.PackageHeaderVersion > math.MaxUint8 || !sdk.IsValidPackageHeaderVersion(data.HeaderVersion()) {
+		return fmt.Errorf("package header version %d is invalid", data.PackageHeaderVersion)
+	}
+
+	sendSequences, err := validateChannelSequences(data.SendSequences)
+	if err != nil {
+		return fmt.Errorf("invalid send sequences: %w", err)
+	}
+
+	if _, err := validateChannelSequences(data.ReceiveSequences); err != nil {
+		return fmt.Errorf("invalid receive sequences: %w", err)
+	}
+
+	ackSequences, err := validateChannelSequences(data.AckSequences)
+	if err != nil {
+		return fmt.Errorf("invalid ack sequences: %w", err)
+	}
+	for key, ackSequence := range ackSequences {
+		if ackSequence > sendSequences[key] {
+			destChainID, channelID, _ := ParseChannelKey([]byte(key))
+			return fmt.Errorf("ack sequence %d of channel %d to dest chain %d should not be larger than the send sequence %d",
+				ackSequence, channelID, destChainID, sendSequences[key])
+		}
+	}
+
+	prunedSequences, err := validateChannelSequences(data.PrunedSequences)
+	if err != nil {
+		return fmt.Errorf("invalid pruned sequences: %w", err)
+	}
+	for key, prunedSequence := range prunedSequences {
+		if prunedSequence > ackSequences[key] {
+			destChainID, channelID, _ := ParseChannelKey([]byte(key))
+			return fmt.Errorf("pruned sequence %d of channel %d to dest chain %d should not be larger than the ack sequence %d",
+				prunedSequence, channelID, destChainID, ackSequences[key])
+		}
+	}
+
+	packageKeys := make(map[string]bool, len(data.Packages))
+	// timeout timestamps in the headers of the syn packages indexed by their timeout key, which does not contain
+	// the src chain id
+	synPackageTimeouts := make(map[string]uint64)
+	for _, pack := range data.Packages {
+		if err := validateChainID(pack.SrcChainId); err != nil {
+			return err
+		}
+		if err := validateChainID(pack.DestChainId); err != nil {
+			return err
+		}
+		if err := validateChannelID(pack.ChannelId); err != nil {
+			return err
+		}
+
+		key := string(BuildCrossChainPackageKey(sdk.ChainID(pack.SrcChainId), sdk.ChainID(pack.DestChainId),
+			sdk.ChannelID(pack.ChannelId), pack.Sequence))
+		if packageKeys[key] {
+			return fmt.Errorf("duplicated package, dest chain id %d, channel id %d, sequence %d",
+				pack.DestChainId, pack.ChannelId, pack.Sequence)
+		}
+		packageKeys[key] = true
+
+		header, err := sdk.DecodePackageHeaderWithVersion(pack.Package, data.HeaderVersion())
+		if err != nil {
+			return fmt.Errorf("invalid package header, channel id %d, sequence %d: %w", pack.ChannelId, pack.Sequence, err)
+		}
+		if header.PackageType == sdk.SynCrossChainPackageType {
+			synPackageTimeouts[string(BuildPackageTimeoutKey(sdk.ChainID(pack.DestChainId), sdk.ChannelID(pack.ChannelId), pack.Sequence))] = header.TimeoutTimestamp
+		}
+
+		sendSequence := sendSequences[string(BuildChannelSequenceKey(sdk.ChainID(pack.DestChainId),
+			sdk.ChannelID(pack.ChannelId), PrefixForSendSequenceKey))]
+		if pack.Sequence >= sendSequence {
+			return fmt.Errorf("sequence %d of package in channel %d should be less than the send sequence %d",
+				pack.Sequence, pack.ChannelId, sendSequence)
+		}
+	}
+
+	permissionKeys := make(map[string]bool, len(data.ChannelPermissions))
+	for _, permission := range data.ChannelPermissions {
+		if err := validateChainID(permission.DestChainId); err != nil {
+			return err
+		}
+		if err := validateChannelID(permission.ChannelId); err != nil {
+			return err
+		}
+
+		key := string(BuildChannelPermissionKey(sdk.ChainID(permission.DestChainId), sdk.ChannelID(permission.ChannelId)))
+		if permissionKeys[key] {
+			return fmt.Errorf("duplicated channel permission, dest chain id %d, channel id %d",
+				permission.DestChainId, permission.ChannelId)
+		}
+		permissionKeys[key] = true
+
+		if err := validatePermission(permission.Permission); err != nil {
+			return fmt.Errorf("invalid permission of channel %d: %w", permission.ChannelId, err)
+		}
+	}
+
+	timeoutKeys := make(map[string]bool, len(data.PackageTimeouts))
+	for _, timeout := range data.PackageTimeouts {
+		if err := validateChainID(timeout.DestChainId); err != nil {
+			return err
+		}
+		if err := validateChannelID(timeout.ChannelId); err != nil {
+			return err
+		}
+
+		key := string(BuildPackageTimeoutKey(sdk.ChainID(timeout.DestChainId), sdk.ChannelID(timeout.ChannelId), timeout.Sequence))
+		if timeoutKeys[key] {
+			return fmt.Errorf("duplicated package timeout, dest chain id %d, channel id %d, sequence %d",
+				timeout.DestChainId, timeout.ChannelId, timeout.Sequence)
+		}
+		timeoutKeys[key] = true
+
+		if timeout.TimeoutTimestamp == 0 {
+			return fmt.Errorf("timeout of package %d in channel %d should not be empty", timeout.Sequence, timeout.ChannelId)
+		}
+		headerTimeout, ok := synPackageTimeouts[key]
+		if !ok {
+			return fmt.Errorf("syn package %d in channel %d of the timeout is not found", timeout.Sequence, timeout.ChannelId)
+		}
+		if headerTimeout != timeout.TimeoutTimestamp {
+			return fmt.Errorf("timeout timestamp %d of package %d in channel %d is different from the one %d in the package header",
+				timeout.TimeoutTimestamp, timeout.Sequence, timeout.ChannelId, headerTimeout)
+		}
+	}
+
+	return nil
+}
+
+// validateChannelSequences checks the channel sequences and returns them indexed by their store key
+func validateChannelSequences(sequences []ChannelSequence) (map[string]uint64, error) {
+	sequenceMap := make(map[string]uint64, len(sequences))
+	for _, sequence := range sequences {
+		if err := validateChainID(sequence.DestChainId); err != nil {
+			return nil, err
+		}
+		if err := validateChannelID(sequence.ChannelId); err != nil {
+			return nil, err
+		}
+
+		// the prefix does not matter here, it is only used to index the sequences
+		key := string(BuildChannelSequenceKey(sdk.ChainID(sequence.DestChainId), sdk.ChannelID(sequence.ChannelId), PrefixForSendSequenceKey))
+		if _, ok := sequenceMap[key]; ok {
+			return nil, fmt.Errorf("duplicated sequence, dest chain id %d, channel id %d", sequence.DestChainId, sequence.ChannelId)
+		}
+		sequenceMap[key] = sequence.Sequence
+	}
+	return sequenceMap, nil
+}
+
+func validateChainID(chainID uint32) error {
+	if chainID > math.MaxUint16 {
+		return fmt.Errorf("chain id %d is invalid", chainID)
+	}
+	return nil
+}
+
+func validateChannelID(channelID uint32) error {
+	if channelID > math.MaxUint8 {
+		return fmt.Errorf("channel id %d is invalid", channelID)
+	}
+	return nil
+}
+
+func validatePermission(permission uint32) error {
+	p := sdk.ChannelPermission(permission)
+	if permission > math.MaxUint8 || (p != sdk.ChannelAllow && p != sdk.ChannelForbidden) {
+		return fmt.Errorf("permission %d is invalid", permission)
+	}
 	return nil
 }
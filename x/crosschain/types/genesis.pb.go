@@ -23,10 +23,27 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// GenesisState defines the oracle module's genesis state.
+// GenesisState defines the crosschain module's genesis state.
 type GenesisState struct {
-	// params defines all the parameters of related to oracle module.
+	// params defines all the parameters of related to crosschain module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// packages defines all the cross chain packages stored in the module.
+	Packages []CrossChainPackage `protobuf:"bytes,2,rep,name=packages,proto3" json:"packages"`
+	// send_sequences defines the send sequences of all the channels.
+	SendSequences []ChannelSequence `protobuf:"bytes,3,rep,name=send_sequences,json=sendSequences,proto3" json:"send_sequences"`
+	// receive_sequences defines the receive sequences of all the channels.
+	ReceiveSequences []ChannelSequence `protobuf:"bytes,4,rep,name=receive_sequences,json=receiveSequences,proto3" json:"receive_sequences"`
+	// channel_permissions defines the send permissions of all the channels.
+	ChannelPermissions []ChannelPermission `protobuf:"bytes,5,rep,name=channel_permissions,json=channelPermissions,proto3" json:"channel_permissions"`
+	// package_timeouts defines the timeouts of all the pending syn packages.
+	PackageTimeouts []PackageTimeout `protobuf:"bytes,6,rep,name=package_timeouts,json=packageTimeouts,proto3" json:"package_timeouts"`
+	// ack_sequences defines the sequences before which all the packages of the channels are received by the dest chains.
+	AckSequences []ChannelSequence `protobuf:"bytes,7,rep,name=ack_sequences,json=ackSequences,proto3" json:"ack_sequences"`
+	// package_header_version defines the layout version of the headers of the packages, 0 means the legacy layout of
+	// the genesis exported before the package headers were versioned.
+	PackageHeaderVersion uint32 `protobuf:"varint,8,opt,name=package_header_version,json=packageHeaderVersion,proto3" json:"package_header_version,omitempty"`
+	// pruned_sequences defines the sequences before which the packages of the channels are pruned.
+	PrunedSequences []ChannelSequence `protobuf:"bytes,9,rep,name=pruned_sequences,json=prunedSequences,proto3" json:"pruned_sequences"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +86,62 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPackages() []CrossChainPackage {
+	if m != nil {
+		return m.Packages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSendSequences() []ChannelSequence {
+	if m != nil {
+		return m.SendSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReceiveSequences() []ChannelSequence {
+	if m != nil {
+		return m.ReceiveSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelPermissions() []ChannelPermission {
+	if m != nil {
+		return m.ChannelPermissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPackageTimeouts() []PackageTimeout {
+	if m != nil {
+		return m.PackageTimeouts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAckSequences() []ChannelSequence {
+	if m != nil {
+		return m.AckSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPackageHeaderVersion() uint32 {
+	if m != nil {
+		return m.PackageHeaderVersion
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPrunedSequences() []ChannelSequence {
+	if m != nil {
+		return m.PrunedSequences
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.crosschain.v1.GenesisState")
 }
@@ -78,20 +151,34 @@ func init() {
 }
 
 var fileDescriptor_810ffca0c738aa54 = []byte{
-	// 201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xca, 0x2f, 0x2e, 0x4e, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x33,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x81, 0xa8, 0xd1, 0x43, 0xa8, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x54, 0xb1, 0x9a, 0x87, 0xa4, 0x13, 0xac, 0x4c, 0xc9, 0x8b,
-	0x8b, 0xc7, 0x1d, 0x62, 0x47, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x15, 0x17, 0x5b, 0x41, 0x62,
-	0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x8c, 0x1e, 0x36, 0x3b, 0xf5,
-	0x02, 0xc0, 0x6a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xea, 0x70, 0xf2, 0x3c, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xfd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x98, 0xbb, 0xc0, 0x94, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x05, 0xb2,
-	0x23, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xae, 0x33, 0x06, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xdd, 0xc4, 0x01, 0xd0, 0x16, 0x01, 0x00, 0x00,
+	// 430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x80, 0x1b, 0xb6, 0x95, 0xe1, 0xad, 0xac, 0x98, 0x0a, 0x45, 0x13, 0x0a, 0xd5, 0xc4, 0x44,
+	0x2f, 0x24, 0xda, 0xe0, 0xc4, 0x71, 0x3b, 0xc0, 0x6e, 0x51, 0x07, 0x13, 0xe2, 0x40, 0xe4, 0xb9,
+	0x4f, 0x49, 0x14, 0x62, 0x07, 0xbf, 0x24, 0x82, 0x7f, 0xc1, 0xcf, 0xda, 0x71, 0x47, 0xc4, 0x01,
+	0xa1, 0xf6, 0x8f, 0xa0, 0xd8, 0x6e, 0x1b, 0x50, 0x3b, 0xa9, 0xa7, 0xc4, 0x7e, 0xdf, 0xfb, 0xfc,
+	0xfc, 0xac, 0x47, 0x8e, 0xb8, 0xc4, 0x5c, 0x62, 0xc0, 0x95, 0x44, 0xe4, 0x09, 0x4b, 0x45, 0x50,
+	0x9f, 0x04, 0x31, 0x08, 0xc0, 0x14, 0xfd, 0x42, 0xc9, 0x52, 0xd2, 0x81, 0x61, 0xfc, 0x25, 0xe3,
+	0xd7, 0x27, 0x87, 0x83, 0x58, 0xc6, 0x52, 0x03, 0x41, 0xf3, 0x67, 0xd8, 0xc3, 0xe3, 0x95, 0xbe,
+	0x56, 0xa6, 0xc6, 0x8e, 0x7e, 0xed, 0x90, 0xfd, 0xb7, 0xe6, 0x90, 0xcb, 0x92, 0x95, 0x40, 0xdf,
+	0x90, 0x6e, 0xc1, 0x14, 0xcb, 0xd1, 0x75, 0x86, 0xce, 0x68, 0xef, 0xf4, 0xa9, 0xbf, 0xea, 0x50,
+	0x3f, 0xd4, 0xcc, 0xd9, 0xf6, 0xcd, 0xef, 0x67, 0x9d, 0xb1, 0xcd, 0xa0, 0x17, 0x64, 0xb7, 0x60,
+	0x3c, 0x63, 0x31, 0xa0, 0x7b, 0x6f, 0xb8, 0x35, 0xda, 0x3b, 0x7d, 0xb1, 0x3a, 0xfb, 0xbc, 0x59,
+	0x9d, 0x37, 0xab, 0xd0, 0xf0, 0x56, 0xb4, 0x48, 0xa7, 0x63, 0xf2, 0x10, 0x41, 0x4c, 0x22, 0x84,
+	0xaf, 0x15, 0x08, 0x0e, 0xe8, 0x6e, 0x69, 0xe1, 0xf1, 0x1a, 0x61, 0xc2, 0x84, 0x80, 0x2f, 0x97,
+	0x96, 0xb6, 0xba, 0x5e, 0xa3, 0x98, 0xef, 0x21, 0xfd, 0x48, 0x1e, 0x29, 0xe0, 0x90, 0xd6, 0xd0,
+	0xd2, 0x6e, 0x6f, 0xae, 0xed, 0x5b, 0xcb, 0xd2, 0xfc, 0x99, 0x3c, 0xe6, 0x06, 0x8d, 0x0a, 0x50,
+	0x79, 0x8a, 0x98, 0x4a, 0x81, 0xee, 0xce, 0x9d, 0x3d, 0x30, 0x09, 0xe1, 0x82, 0xb7, 0x76, 0xca,
+	0xff, 0x0f, 0x20, 0xfd, 0x40, 0xfa, 0xb6, 0x33, 0x51, 0x99, 0xe6, 0x20, 0xab, 0x12, 0xdd, 0xae,
+	0x96, 0x3f, 0x5f, 0xf7, 0x3c, 0x9a, 0x7e, 0x6f, 0x60, 0x6b, 0x3e, 0x28, 0xfe, 0xd9, 0x45, 0x1a,
+	0x92, 0x1e, 0xe3, 0x59, 0xab, 0x19, 0xf7, 0x37, 0x6f, 0xc6, 0x3e, 0xe3, 0xd9, 0xb2, 0x11, 0xaf,
+	0xc9, 0x93, 0x79, 0xa1, 0x09, 0xb0, 0x09, 0xa8, 0xa8, 0x06, 0xd5, 0xdc, 0xc1, 0xdd, 0x1d, 0x3a,
+	0xa3, 0xde, 0x78, 0x60, 0xa3, 0xef, 0x74, 0xf0, 0xca, 0xc4, 0xe8, 0x15, 0xe9, 0x17, 0xaa, 0x12,
+	0xd0, 0x7e, 0xee, 0x07, 0x9b, 0x97, 0x72, 0x60, 0x24, 0x8b, 0x6a, 0xce, 0x2e, 0x6e, 0xa6, 0x9e,
+	0x73, 0x3b, 0xf5, 0x9c, 0x3f, 0x53, 0xcf, 0xf9, 0x31, 0xf3, 0x3a, 0xb7, 0x33, 0xaf, 0xf3, 0x73,
+	0xe6, 0x75, 0x3e, 0x05, 0x71, 0x5a, 0x26, 0xd5, 0xb5, 0xcf, 0x65, 0x1e, 0xcc, 0x07, 0x45, 0x7f,
+	0x5e, 0xe2, 0x24, 0x0b, 0xbe, 0xb5, 0xa7, 0xa6, 0xfc, 0x5e, 0x00, 0x5e, 0x77, 0xf5, 0xb8, 0xbc,
+	0xfa, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xac, 0xb0, 0x84, 0xa7, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +201,109 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PrunedSequences) > 0 {
+		for iNdEx := len(m.PrunedSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrunedSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.PackageHeaderVersion != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PackageHeaderVersion))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AckSequences) > 0 {
+		for iNdEx := len(m.AckSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AckSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PackageTimeouts) > 0 {
+		for iNdEx := len(m.PackageTimeouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PackageTimeouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ChannelPermissions) > 0 {
+		for iNdEx := len(m.ChannelPermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelPermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ReceiveSequences) > 0 {
+		for iNdEx := len(m.ReceiveSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiveSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SendSequences) > 0 {
+		for iNdEx := len(m.SendSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Packages) > 0 {
+		for iNdEx := len(m.Packages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -146,6 +336,51 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Packages) > 0 {
+		for _, e := range m.Packages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SendSequences) > 0 {
+		for _, e := range m.SendSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReceiveSequences) > 0 {
+		for _, e := range m.ReceiveSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelPermissions) > 0 {
+		for _, e := range m.ChannelPermissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PackageTimeouts) > 0 {
+		for _, e := range m.PackageTimeouts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AckSequences) > 0 {
+		for _, e := range m.AckSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PackageHeaderVersion != 0 {
+		n += 1 + sovGenesis(uint64(m.PackageHeaderVersion))
+	}
+	if len(m.PrunedSequences) > 0 {
+		for _, e := range m.PrunedSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -217,6 +452,263 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packages = append(m.Packages, CrossChainPackage{})
+			if err := m.Packages[len(m.Packages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendSequences = append(m.SendSequences, ChannelSequence{})
+			if err := m.SendSequences[len(m.SendSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiveSequences = append(m.ReceiveSequences, ChannelSequence{})
+			if err := m.ReceiveSequences[len(m.ReceiveSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelPermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelPermissions = append(m.ChannelPermissions, ChannelPermission{})
+			if err := m.ChannelPermissions[len(m.ChannelPermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackageTimeouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PackageTimeouts = append(m.PackageTimeouts, PackageTimeout{})
+			if err := m.PackageTimeouts[len(m.PackageTimeouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckSequences = append(m.AckSequences, ChannelSequence{})
+			if err := m.AckSequences[len(m.AckSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackageHeaderVersion", wireType)
+			}
+			m.PackageHeaderVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PackageHeaderVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrunedSequences = append(m.PrunedSequences, ChannelSequence{})
+			if err := m.PrunedSequences[len(m.PrunedSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,180 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	synPackage := sdk.EncodePackageHeader(sdk.PackageHeader{
+		PackageType:      sdk.SynCrossChainPackageType,
+		Timestamp:        1,
+		RelayerFee:       big.NewInt(1),
+		AckRelayerFee:    big.NewInt(1),
+		TimeoutTimestamp: 100,
+	})
+	legacySynPackage := sdk.EncodePackageHeaderWithVersion(sdk.PackageHeader{
+		PackageType:   sdk.SynCrossChainPackageType,
+		Timestamp:     1,
+		RelayerFee:    big.NewInt(1),
+		AckRelayerFee: big.NewInt(1),
+	}, sdk.PackageHeaderV1)
+
+	testCases := []struct {
+		name         string
+		genesisState GenesisState
+		expErr       bool
+	}{
+		{
+			"valid genesisState",
+			GenesisState{
+				Params:               DefaultParams(),
+				Packages:             []CrossChainPackage{{SrcChainId: 1, DestChainId: 56, ChannelId: 1, Sequence: 0, Package: synPackage}},
+				SendSequences:        []ChannelSequence{{DestChainId: 56, ChannelId: 1, Sequence: 1}},
+				ReceiveSequences:     []ChannelSequence{{DestChainId: 56, ChannelId: 1, Sequence: 3}},
+				ChannelPermissions:   []ChannelPermission{{DestChainId: 56, ChannelId: 1, Permission: uint32(sdk.ChannelAllow)}},
+				PackageTimeouts:      []PackageTimeout{{DestChainId: 56, ChannelId: 1, Sequence: 0, TimeoutTimestamp: 100}},
+				AckSequences:         []ChannelSequence{{DestChainId: 56, ChannelId: 1, Sequence: 1}},
+				PackageHeaderVersion: uint32(sdk.PackageHeaderV2),
+			},
+			false,
+		},
+		{"default genesisState", *DefaultGenesisState(), false},
+		{
+			"legacy genesisState",
+			GenesisState{
+				Params:        DefaultParams(),
+				Packages:      []CrossChainPackage{{SrcChainId: 1, DestChainId: 56, ChannelId: 1, Sequence: 0, Package: legacySynPackage}},
+				SendSequences: []ChannelSequence{{DestChainId: 56, ChannelId: 1, Sequence: 1}},
+			},
+			false,
+		},
+		{
+			"package timeout of legacy genesisState",
+			GenesisState{
+				Params:          DefaultParams(),
+				Packages:        []CrossChainPackage{{SrcChainId: 1, DestChainId: 56, ChannelId: 1, Sequence: 0, Package: legacySynPackage}},
+				SendSequences:   []ChannelSequence{{DestChainId: 56, ChannelId: 1, Sequence: 1}},
+				PackageTimeouts: []PackageTimeout{{DestChainId: 56, ChannelId: 1, Sequence: 0, TimeoutTimestamp: 100}},
+			},
+			true,
+		},
+		{
+			"invalid package header version",
+			GenesisState{
+				Params:               DefaultParams(),
+				PackageHeaderVersion: 3,
+			},
+			true,
+		},
+		{"empty genesisState", GenesisState{}, true},
+		{
+			"package sequence not less than send sequence",
+			GenesisState{
+				Params:        DefaultParams(),
+				Packages:      []CrossChainPackage{{SrcChainId: 1, DestChainId: 56, ChannelId: 1, Sequence: 1, Package: synPackage}},
+				SendSequences: []ChannelSequence{{DestChainId: 56, ChannelId: 1, Sequence: 1}},
+			},
+			true,
+		},
+		{
+			"invalid package header",
+			GenesisState{
+				Params:        DefaultParams(),
+				Packages:      []CrossChainPackage{{SrcChainId: 1, DestChainId: 56, ChannelId: 1, Sequence: 0, Package: []byte{0x09}}},
+				SendSequences: []ChannelSequence{{DestChainId: 56, ChannelId: 1, Sequence: 1}},
+			},
+			true,
+		},
+		{
+			"duplicated send sequence",
+			GenesisState{
+				Params:        DefaultParams(),
+				SendSequences: []ChannelSequence{{DestChainId: 56, ChannelId: 1, Sequence: 1}, {DestChainId: 56, ChannelId: 1, Sequence: 2}},
+			},
+			true,
+		},
+		{
+			"ack sequence larger than send sequence",
+			GenesisState{
+				Params:        DefaultParams(),
+				SendSequences: []ChannelSequence{{DestChainId: 56, ChannelId: 1, Sequence: 1}},
+				AckSequences:  []ChannelSequence{{DestChainId: 56, ChannelId: 1, Sequence: 2}},
+			},
+			true,
+		},
+		{
+			"pruned sequence larger than ack sequence",
+			GenesisState{
+				Params:          DefaultParams(),
+				SendSequences:   []ChannelSequence{{DestChainId: 56, ChannelId: 1, Sequence: 2}},
+				AckSequences:    []ChannelSequence{{DestChainId: 56, ChannelId: 1, Sequence: 1}},
+				PrunedSequences: []ChannelSequence{{DestChainId: 56, ChannelId: 1, Sequence: 2}},
+			},
+			true,
+		},
+		{
+			"invalid channel id",
+			GenesisState{
+				Params:           DefaultParams(),
+				ReceiveSequences: []ChannelSequence{{DestChainId: 56, ChannelId: 256, Sequence: 1}},
+			},
+			true,
+		},
+		{
+			"empty package timeout",
+			GenesisState{
+				Params:               DefaultParams(),
+				Packages:             []CrossChainPackage{{SrcChainId: 1, DestChainId: 56, ChannelId: 1, Sequence: 0, Package: synPackage}},
+				SendSequences:        []ChannelSequence{{DestChainId: 56, ChannelId: 1, Sequence: 1}},
+				PackageTimeouts:      []PackageTimeout{{DestChainId: 56, ChannelId: 1, Sequence: 0}},
+				PackageHeaderVersion: uint32(sdk.PackageHeaderV2),
+			},
+			true,
+		},
+		{
+			"timeout different from the package header",
+			GenesisState{
+				Params:               DefaultParams(),
+				Packages:             []CrossChainPackage{{SrcChainId: 1, DestChainId: 56, ChannelId: 1, Sequence: 0, Package: synPackage}},
+				SendSequences:        []ChannelSequence{{DestChainId: 56, ChannelId: 1, Sequence: 1}},
+				PackageTimeouts:      []PackageTimeout{{DestChainId: 56, ChannelId: 1, Sequence: 0, TimeoutTimestamp: 101}},
+				PackageHeaderVersion: uint32(sdk.PackageHeaderV2),
+			},
+			true,
+		},
+		{
+			"package of timeout not found",
+			GenesisState{
+				Params:          DefaultParams(),
+				PackageTimeouts: []PackageTimeout{{DestChainId: 56, ChannelId: 1, Sequence: 0, TimeoutTimestamp: 100}},
+			},
+			true,
+		},
+		{
+			"invalid permission",
+			GenesisState{
+				Params:             DefaultParams(),
+				ChannelPermissions: []ChannelPermission{{DestChainId: 56, ChannelId: 1, Permission: 2}},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateGenesis(tc.genesisState)
+
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -31,8 +31,17 @@ var (
 
 	PrefixForSendSequenceKey    = []byte{0xf0}
 	PrefixForReceiveSequenceKey = []byte{0xf1}
+	// PrefixForAckSequenceKey stores the sequence before which all the packages of a channel are received by the
+	// dest chain, it is derived from the syn sequences of the ack and fail ack packages sent back by the dest chain
+	PrefixForAckSequenceKey = []byte{0xf2}
+	// PrefixForPrunedSequenceKey stores the sequence before which the packages of a channel are pruned, so that the
+	// pruning does not scan the channel from the first sequence on every send
+	PrefixForPrunedSequenceKey = []byte{0xf3}
 
 	PrefixForChannelPermissionKey = []byte{0xc0}
+
+	PrefixForPackageTimeoutKey      = []byte{0xd0}
+	PrefixForPackageTimeoutQueueKey = []byte{0xd1}
 )
 
 func BuildCrossChainPackageKey(srcChainID, destChainID sdk.ChainID, channelID sdk.ChannelID, sequence uint64) []byte {
@@ -47,14 +56,33 @@ func BuildCrossChainPackageKey(srcChainID, destChainID sdk.ChainID, channelID sd
 	return key
 }
 
+// BuildCrossChainPackagePrefix returns the key prefix of all the cross chain packages in a channel
+func BuildCrossChainPackagePrefix(srcChainID, destChainID sdk.ChainID, channelID sdk.ChannelID) []byte {
+	return BuildCrossChainPackageKey(srcChainID, destChainID, channelID, 0)[:totalPackageKeyLength-sequenceLength]
+}
+
+// ParseCrossChainPackageKey returns the chain ids, channel id and sequence encoded in a cross chain package key
+func ParseCrossChainPackageKey(key []byte) (srcChainID, destChainID sdk.ChainID, channelID sdk.ChannelID, sequence uint64, err error) {
+	if len(key) != totalPackageKeyLength {
+		return 0, 0, 0, 0, fmt.Errorf("invalid cross chain package key length %d", len(key))
+	}
+
+	srcChainID = sdk.ChainID(binary.BigEndian.Uint16(key[prefixLength : prefixLength+srcChainIdLength]))
+	destChainID = sdk.ChainID(binary.BigEndian.Uint16(key[prefixLength+srcChainIdLength : prefixLength+srcChainIdLength+destChainIDLength]))
+	channelID = sdk.ChannelID(key[prefixLength+srcChainIdLength+destChainIDLength])
+	sequence = binary.BigEndian.Uint64(key[prefixLength+srcChainIdLength+destChainIDLength+channelIDLength:])
+
+	return srcChainID, destChainID, channelID, sequence, nil
+}
+
 type ChannelPermissionSetting struct {
-	DestChainId string                `json:"dest_chain_id"`
+	DestChainId sdk.ChainID           `json:"dest_chain_id"`
 	ChannelId   sdk.ChannelID         `json:"channel_id"`
 	Permission  sdk.ChannelPermission `json:"permission"`
 }
 
 func (c *ChannelPermissionSetting) Check() error {
-	if len(c.DestChainId) == 0 || len(c.DestChainId) > MaxSideChainIdLength {
+	if c.DestChainId == 0 {
 		return fmt.Errorf("invalid dest chain id")
 	}
 	if c.ChannelId == GovChannelId {
@@ -83,3 +111,55 @@ func BuildChannelPermissionKey(destChainID sdk.ChainID, channelID sdk.ChannelID)
 	copy(key[prefixLength+destChainIDLength:], []byte{byte(channelID)})
 	return key
 }
+
+// ParseChannelKey returns the dest chain id and channel id encoded in a channel sequence or channel permission key
+func ParseChannelKey(key []byte) (sdk.ChainID, sdk.ChannelID, error) {
+	if len(key) != prefixLength+destChainIDLength+channelIDLength {
+		return 0, 0, fmt.Errorf("invalid channel key length %d", len(key))
+	}
+
+	destChainID := sdk.ChainID(binary.BigEndian.Uint16(key[prefixLength : prefixLength+destChainIDLength]))
+	channelID := sdk.ChannelID(key[prefixLength+destChainIDLength])
+
+	return destChainID, channelID, nil
+}
+
+// BuildPackageTimeoutKey returns the key of the timeout of a syn package sent to the dest chain
+func BuildPackageTimeoutKey(destChainID sdk.ChainID, channelID sdk.ChannelID, sequence uint64) []byte {
+	key := make([]byte, prefixLength+destChainIDLength+channelIDLength+sequenceLength)
+
+	copy(key[:prefixLength], PrefixForPackageTimeoutKey)
+	binary.BigEndian.PutUint16(key[prefixLength:prefixLength+destChainIDLength], uint16(destChainID))
+	copy(key[prefixLength+destChainIDLength:], []byte{byte(channelID)})
+	binary.BigEndian.PutUint64(key[prefixLength+destChainIDLength+channelIDLength:], sequence)
+	return key
+}
+
+// BuildPackageTimeoutQueuePrefix returns the key prefix of the packages timed out at the given timestamp in the
+// timeout queue
+func BuildPackageTimeoutQueuePrefix(timeoutTimestamp uint64) []byte {
+	key := make([]byte, prefixLength+SequenceLength)
+
+	copy(key[:prefixLength], PrefixForPackageTimeoutQueueKey)
+	binary.BigEndian.PutUint64(key[prefixLength:], timeoutTimestamp)
+	return key
+}
+
+// BuildPackageTimeoutQueueKey returns the key of a syn package in the timeout queue
+func BuildPackageTimeoutQueueKey(timeoutTimestamp uint64, destChainID sdk.ChainID, channelID sdk.ChannelID, sequence uint64) []byte {
+	return append(BuildPackageTimeoutQueuePrefix(timeoutTimestamp), BuildPackageTimeoutKey(destChainID, channelID, sequence)[prefixLength:]...)
+}
+
+// ParsePackageTimeoutQueueKey returns the dest chain id, channel id and sequence encoded in a timeout queue key
+func ParsePackageTimeoutQueueKey(key []byte) (destChainID sdk.ChainID, channelID sdk.ChannelID, sequence uint64, err error) {
+	if len(key) != prefixLength+SequenceLength+destChainIDLength+channelIDLength+sequenceLength {
+		return 0, 0, 0, fmt.Errorf("invalid package timeout queue key length %d", len(key))
+	}
+
+	key = key[prefixLength+SequenceLength:]
+	destChainID = sdk.ChainID(binary.BigEndian.Uint16(key[:destChainIDLength]))
+	channelID = sdk.ChannelID(key[destChainIDLength])
+	sequence = binary.BigEndian.Uint64(key[destChainIDLength+channelIDLength:])
+
+	return destChainID, channelID, sequence, nil
+}
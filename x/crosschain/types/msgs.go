@@ -0,0 +1,79 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUpdateChannelPermissions{}
+
+func NewMsgUpdateChannelPermissions(authority string, channelPermissions []ChannelPermission) *MsgUpdateChannelPermissions {
+	return &MsgUpdateChannelPermissions{
+		Authority:          authority,
+		ChannelPermissions: channelPermissions,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgUpdateChannelPermissions) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUpdateChannelPermissions) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateChannelPermissions) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateChannelPermissions message.
+func (m *MsgUpdateChannelPermissions) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromHexUnsafe(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateChannelPermissions) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromHexUnsafe(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if len(m.ChannelPermissions) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "channel permissions should not be empty")
+	}
+
+	permissionKeys := make(map[string]bool, len(m.ChannelPermissions))
+	for _, permission := range m.ChannelPermissions {
+		if err := validateChainID(permission.DestChainId); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if err := validateChannelID(permission.ChannelId); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if err := validatePermission(permission.Permission); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		setting := permission.ToSetting()
+		if err := setting.Check(); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		key := string(BuildChannelPermissionKey(setting.DestChainId, setting.ChannelId))
+		if permissionKeys[key] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated channel permission, dest chain id %d, channel id %d",
+				permission.DestChainId, permission.ChannelId)
+		}
+		permissionKeys[key] = true
+	}
+
+	return nil
+}
+
+// ToSetting converts the channel permission to a ChannelPermissionSetting, the ids are expected to be validated.
+func (m ChannelPermission) ToSetting() ChannelPermissionSetting {
+	return ChannelPermissionSetting{
+		DestChainId: sdk.ChainID(m.DestChainId),
+		ChannelId:   sdk.ChannelID(m.ChannelId),
+		Permission:  sdk.ChannelPermission(m.Permission),
+	}
+}
@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMsgUpdateChannelPermissionsValidateBasic(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	tests := []struct {
+		msg          *types.MsgUpdateChannelPermissions
+		expectedPass bool
+		errorMsg     string
+	}{
+		{
+			types.NewMsgUpdateChannelPermissions("random string", []types.ChannelPermission{
+				{DestChainId: 56, ChannelId: 1, Permission: uint32(sdk.ChannelAllow)},
+			}),
+			false,
+			"invalid authority address",
+		},
+		{
+			types.NewMsgUpdateChannelPermissions(authority, nil),
+			false,
+			"channel permissions should not be empty",
+		},
+		{
+			types.NewMsgUpdateChannelPermissions(authority, []types.ChannelPermission{
+				{DestChainId: math.MaxUint16 + 1, ChannelId: 1, Permission: uint32(sdk.ChannelAllow)},
+			}),
+			false,
+			"chain id 65536 is invalid",
+		},
+		{
+			types.NewMsgUpdateChannelPermissions(authority, []types.ChannelPermission{
+				{DestChainId: 56, ChannelId: math.MaxUint8 + 1, Permission: uint32(sdk.ChannelAllow)},
+			}),
+			false,
+			"channel id 256 is invalid",
+		},
+		{
+			types.NewMsgUpdateChannelPermissions(authority, []types.ChannelPermission{
+				{DestChainId: 56, ChannelId: 1, Permission: 2},
+			}),
+			false,
+			"permission 2 is invalid",
+		},
+		{
+			types.NewMsgUpdateChannelPermissions(authority, []types.ChannelPermission{
+				{DestChainId: 0, ChannelId: 1, Permission: uint32(sdk.ChannelAllow)},
+			}),
+			false,
+			"invalid dest chain id",
+		},
+		{
+			types.NewMsgUpdateChannelPermissions(authority, []types.ChannelPermission{
+				{DestChainId: 56, ChannelId: uint32(types.GovChannelId), Permission: uint32(sdk.ChannelForbidden)},
+			}),
+			false,
+			"gov channel id is forbidden to set",
+		},
+		{
+			types.NewMsgUpdateChannelPermissions(authority, []types.ChannelPermission{
+				{DestChainId: 56, ChannelId: 1, Permission: uint32(sdk.ChannelAllow)},
+				{DestChainId: 56, ChannelId: 1, Permission: uint32(sdk.ChannelForbidden)},
+			}),
+			false,
+			"duplicated channel permission",
+		},
+		{
+			types.NewMsgUpdateChannelPermissions(authority, []types.ChannelPermission{
+				{DestChainId: 56, ChannelId: 1, Permission: uint32(sdk.ChannelAllow)},
+				{DestChainId: 97, ChannelId: 1, Permission: uint32(sdk.ChannelForbidden)},
+			}),
+			true,
+			"",
+		},
+	}
+
+	for i, test := range tests {
+		if test.expectedPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.ErrorContains(t, test.msg.ValidateBasic(), test.errorMsg, "test: %v", i)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradePackageHeader re-encodes the header of a cross chain package from the given layout version to the latest
+// one, the payload is kept as it is. The syn packages get no timeout, while the syn sequences of the ack and fail ack
+// packages are unknown for the legacy layout and set to 0.
+func UpgradePackageHeader(pack []byte, version sdk.PackageHeaderVersion) ([]byte, error) {
+	if version == sdk.LatestPackageHeaderVersion {
+		return pack, nil
+	}
+
+	header, err := sdk.DecodePackageHeaderWithVersion(pack, version)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := pack[sdk.GetPackageHeaderLengthWithVersion(header.PackageType, version):]
+	return append(sdk.EncodePackageHeader(header), payload...), nil
+}
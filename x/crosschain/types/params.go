@@ -9,6 +9,9 @@ import (
 
 var DefaultInitModuleBalance sdkmath.Int
 
+// DefaultPackageRetentionSequences keeps all the cross chain packages by default
+const DefaultPackageRetentionSequences uint64 = 0
+
 func init() {
 	initModuleBalance, ok := sdkmath.NewIntFromString("2000000000000000000000000") // 2M
 	if !ok {
@@ -17,11 +20,15 @@ func init() {
 	DefaultInitModuleBalance = initModuleBalance
 }
 
-var KeyParamInitModuleBalance = []byte("InitModuleBalance")
+var (
+	KeyParamInitModuleBalance         = []byte("InitModuleBalance")
+	KeyParamPackageRetentionSequences = []byte("PackageRetentionSequences")
+)
 
 func DefaultParams() Params {
 	return Params{
-		InitModuleBalance: DefaultInitModuleBalance,
+		InitModuleBalance:         DefaultInitModuleBalance,
+		PackageRetentionSequences: DefaultPackageRetentionSequences,
 	}
 }
 
@@ -33,6 +40,7 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyParamInitModuleBalance, &p.InitModuleBalance, validateInitModuleBalance),
+		paramtypes.NewParamSetPair(KeyParamPackageRetentionSequences, &p.PackageRetentionSequences, validatePackageRetentionSequences),
 	}
 }
 
@@ -52,3 +60,12 @@ func validateInitModuleBalance(i interface{}) error {
 
 	return nil
 }
+
+func validatePackageRetentionSequences(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
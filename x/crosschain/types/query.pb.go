@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -118,6 +119,8 @@ type QueryCrossChainPackageRequest struct {
 	ChannelId uint32 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 	// sequence of the cross chain package
 	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// destination chain id of the cross chain package
+	DestChainId uint32 `protobuf:"varint,3,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
 }
 
 func (m *QueryCrossChainPackageRequest) Reset()         { *m = QueryCrossChainPackageRequest{} }
@@ -167,6 +170,13 @@ func (m *QueryCrossChainPackageRequest) GetSequence() uint64 {
 	return 0
 }
 
+func (m *QueryCrossChainPackageRequest) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
 // QueryCrossChainPackageResponse is the response type for the Query/CrossChainPackage RPC method.
 type QueryCrossChainPackageResponse struct {
 	// content of the cross chain package
@@ -213,24 +223,32 @@ func (m *QueryCrossChainPackageResponse) GetPackage() []byte {
 	return nil
 }
 
-// QuerySendSequenceRequest is the request type for the Query/SendSequence RPC method.
-type QuerySendSequenceRequest struct {
-	// channel id of the cross chain package
-	ChannelId uint32 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+// QueryCrossChainPackagesRequest is the request type for the Query/CrossChainPackages RPC method.
+type QueryCrossChainPackagesRequest struct {
+	// destination chain id of the cross chain packages
+	DestChainId uint32 `protobuf:"varint,1,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	// channel id of the cross chain packages
+	ChannelId uint32 `protobuf:"varint,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// start sequence of the cross chain packages, inclusive
+	StartSequence uint64 `protobuf:"varint,3,opt,name=start_sequence,json=startSequence,proto3" json:"start_sequence,omitempty"`
+	// end sequence of the cross chain packages, exclusive, 0 means no upper bound
+	EndSequence uint64 `protobuf:"varint,4,opt,name=end_sequence,json=endSequence,proto3" json:"end_sequence,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QuerySendSequenceRequest) Reset()         { *m = QuerySendSequenceRequest{} }
-func (m *QuerySendSequenceRequest) String() string { return proto.CompactTextString(m) }
-func (*QuerySendSequenceRequest) ProtoMessage()    {}
-func (*QuerySendSequenceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryCrossChainPackagesRequest) Reset()         { *m = QueryCrossChainPackagesRequest{} }
+func (m *QueryCrossChainPackagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossChainPackagesRequest) ProtoMessage()    {}
+func (*QueryCrossChainPackagesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3c0bc65cbea0cca3, []int{4}
 }
-func (m *QuerySendSequenceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCrossChainPackagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySendSequenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCrossChainPackagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySendSequenceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCrossChainPackagesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -240,43 +258,73 @@ func (m *QuerySendSequenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QuerySendSequenceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySendSequenceRequest.Merge(m, src)
+func (m *QueryCrossChainPackagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossChainPackagesRequest.Merge(m, src)
 }
-func (m *QuerySendSequenceRequest) XXX_Size() int {
+func (m *QueryCrossChainPackagesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySendSequenceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySendSequenceRequest.DiscardUnknown(m)
+func (m *QueryCrossChainPackagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossChainPackagesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySendSequenceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCrossChainPackagesRequest proto.InternalMessageInfo
 
-func (m *QuerySendSequenceRequest) GetChannelId() uint32 {
+func (m *QueryCrossChainPackagesRequest) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
+	}
+	return 0
+}
+
+func (m *QueryCrossChainPackagesRequest) GetChannelId() uint32 {
 	if m != nil {
 		return m.ChannelId
 	}
 	return 0
 }
 
-// QuerySendSequenceResponse is the response type for the Query/SendSequence RPC method.
-type QuerySendSequenceResponse struct {
-	// sequence of the cross chain package
-	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+func (m *QueryCrossChainPackagesRequest) GetStartSequence() uint64 {
+	if m != nil {
+		return m.StartSequence
+	}
+	return 0
 }
 
-func (m *QuerySendSequenceResponse) Reset()         { *m = QuerySendSequenceResponse{} }
-func (m *QuerySendSequenceResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySendSequenceResponse) ProtoMessage()    {}
-func (*QuerySendSequenceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryCrossChainPackagesRequest) GetEndSequence() uint64 {
+	if m != nil {
+		return m.EndSequence
+	}
+	return 0
+}
+
+func (m *QueryCrossChainPackagesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCrossChainPackagesResponse is the response type for the Query/CrossChainPackages RPC method.
+type QueryCrossChainPackagesResponse struct {
+	// packages defines the cross chain packages with the decoded package headers
+	Packages []CrossChainPackageInfo `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCrossChainPackagesResponse) Reset()         { *m = QueryCrossChainPackagesResponse{} }
+func (m *QueryCrossChainPackagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCrossChainPackagesResponse) ProtoMessage()    {}
+func (*QueryCrossChainPackagesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3c0bc65cbea0cca3, []int{5}
 }
-func (m *QuerySendSequenceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCrossChainPackagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySendSequenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCrossChainPackagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySendSequenceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCrossChainPackagesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -286,43 +334,64 @@ func (m *QuerySendSequenceResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QuerySendSequenceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySendSequenceResponse.Merge(m, src)
+func (m *QueryCrossChainPackagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCrossChainPackagesResponse.Merge(m, src)
 }
-func (m *QuerySendSequenceResponse) XXX_Size() int {
+func (m *QueryCrossChainPackagesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySendSequenceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySendSequenceResponse.DiscardUnknown(m)
+func (m *QueryCrossChainPackagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCrossChainPackagesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySendSequenceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCrossChainPackagesResponse proto.InternalMessageInfo
 
-func (m *QuerySendSequenceResponse) GetSequence() uint64 {
+func (m *QueryCrossChainPackagesResponse) GetPackages() []CrossChainPackageInfo {
 	if m != nil {
-		return m.Sequence
+		return m.Packages
 	}
-	return 0
+	return nil
 }
 
-// QuerySendSequenceRequest is the request type for the Query/ReceiveSequence RPC method.
-type QueryReceiveSequenceRequest struct {
-	// channel id of the cross chain package
-	ChannelId uint32 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+func (m *QueryCrossChainPackagesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryReceiveSequenceRequest) Reset()         { *m = QueryReceiveSequenceRequest{} }
-func (m *QueryReceiveSequenceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryReceiveSequenceRequest) ProtoMessage()    {}
-func (*QueryReceiveSequenceRequest) Descriptor() ([]byte, []int) {
+// CrossChainPackageInfo defines a cross chain package with its decoded package header.
+type CrossChainPackageInfo struct {
+	// sequence of the cross chain package
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// package type of the cross chain package, like SYN, ACK and FAIL_ACK
+	PackageType uint32 `protobuf:"varint,2,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+	// timestamp of the cross chain package
+	Timestamp uint64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// relayer fee for the cross chain package
+	RelayerFee string `protobuf:"bytes,4,opt,name=relayer_fee,json=relayerFee,proto3" json:"relayer_fee,omitempty"`
+	// relayer fee for the ACK or FAIL_ACK package of this cross chain package
+	AckRelayerFee string `protobuf:"bytes,5,opt,name=ack_relayer_fee,json=ackRelayerFee,proto3" json:"ack_relayer_fee,omitempty"`
+	// raw content of the cross chain package, including the package header
+	Package []byte `protobuf:"bytes,6,opt,name=package,proto3" json:"package,omitempty"`
+	// sequence of the SYN package which the ACK or FAIL_ACK package responds to
+	SynSequence uint64 `protobuf:"varint,7,opt,name=syn_sequence,json=synSequence,proto3" json:"syn_sequence,omitempty"`
+	// block time in unix seconds from which the SYN package is timed out, 0 means no timeout
+	TimeoutTimestamp uint64 `protobuf:"varint,8,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+}
+
+func (m *CrossChainPackageInfo) Reset()         { *m = CrossChainPackageInfo{} }
+func (m *CrossChainPackageInfo) String() string { return proto.CompactTextString(m) }
+func (*CrossChainPackageInfo) ProtoMessage()    {}
+func (*CrossChainPackageInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3c0bc65cbea0cca3, []int{6}
 }
-func (m *QueryReceiveSequenceRequest) XXX_Unmarshal(b []byte) error {
+func (m *CrossChainPackageInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryReceiveSequenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CrossChainPackageInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryReceiveSequenceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CrossChainPackageInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -332,43 +401,90 @@ func (m *QueryReceiveSequenceRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryReceiveSequenceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryReceiveSequenceRequest.Merge(m, src)
+func (m *CrossChainPackageInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossChainPackageInfo.Merge(m, src)
 }
-func (m *QueryReceiveSequenceRequest) XXX_Size() int {
+func (m *CrossChainPackageInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryReceiveSequenceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryReceiveSequenceRequest.DiscardUnknown(m)
+func (m *CrossChainPackageInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossChainPackageInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryReceiveSequenceRequest proto.InternalMessageInfo
+var xxx_messageInfo_CrossChainPackageInfo proto.InternalMessageInfo
 
-func (m *QueryReceiveSequenceRequest) GetChannelId() uint32 {
+func (m *CrossChainPackageInfo) GetSequence() uint64 {
 	if m != nil {
-		return m.ChannelId
+		return m.Sequence
 	}
 	return 0
 }
 
-// QuerySendSequenceResponse is the response type for the Query/ReceiveSequence RPC method.
-type QueryReceiveSequenceResponse struct {
-	// sequence of the cross chain package
-	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+func (m *CrossChainPackageInfo) GetPackageType() uint32 {
+	if m != nil {
+		return m.PackageType
+	}
+	return 0
 }
 
-func (m *QueryReceiveSequenceResponse) Reset()         { *m = QueryReceiveSequenceResponse{} }
-func (m *QueryReceiveSequenceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryReceiveSequenceResponse) ProtoMessage()    {}
-func (*QueryReceiveSequenceResponse) Descriptor() ([]byte, []int) {
+func (m *CrossChainPackageInfo) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *CrossChainPackageInfo) GetRelayerFee() string {
+	if m != nil {
+		return m.RelayerFee
+	}
+	return ""
+}
+
+func (m *CrossChainPackageInfo) GetAckRelayerFee() string {
+	if m != nil {
+		return m.AckRelayerFee
+	}
+	return ""
+}
+
+func (m *CrossChainPackageInfo) GetPackage() []byte {
+	if m != nil {
+		return m.Package
+	}
+	return nil
+}
+
+func (m *CrossChainPackageInfo) GetSynSequence() uint64 {
+	if m != nil {
+		return m.SynSequence
+	}
+	return 0
+}
+
+func (m *CrossChainPackageInfo) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+// QueryChannelsRequest is the request type for the Query/Channels RPC method.
+type QueryChannelsRequest struct {
+}
+
+func (m *QueryChannelsRequest) Reset()         { *m = QueryChannelsRequest{} }
+func (m *QueryChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelsRequest) ProtoMessage()    {}
+func (*QueryChannelsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3c0bc65cbea0cca3, []int{7}
 }
-func (m *QueryReceiveSequenceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryReceiveSequenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryReceiveSequenceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryChannelsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -378,616 +494,2828 @@ func (m *QueryReceiveSequenceResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryReceiveSequenceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryReceiveSequenceResponse.Merge(m, src)
+func (m *QueryChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelsRequest.Merge(m, src)
 }
-func (m *QueryReceiveSequenceResponse) XXX_Size() int {
+func (m *QueryChannelsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryReceiveSequenceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryReceiveSequenceResponse.DiscardUnknown(m)
+func (m *QueryChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryReceiveSequenceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryChannelsRequest proto.InternalMessageInfo
 
-func (m *QueryReceiveSequenceResponse) GetSequence() uint64 {
-	if m != nil {
-		return m.Sequence
-	}
-	return 0
+// QueryChannelsResponse is the response type for the Query/Channels RPC method.
+type QueryChannelsResponse struct {
+	// channels defines all the registered channels
+	Channels []ChannelInfo `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels"`
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.crosschain.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.crosschain.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryCrossChainPackageRequest)(nil), "cosmos.crosschain.v1.QueryCrossChainPackageRequest")
-	proto.RegisterType((*QueryCrossChainPackageResponse)(nil), "cosmos.crosschain.v1.QueryCrossChainPackageResponse")
-	proto.RegisterType((*QuerySendSequenceRequest)(nil), "cosmos.crosschain.v1.QuerySendSequenceRequest")
-	proto.RegisterType((*QuerySendSequenceResponse)(nil), "cosmos.crosschain.v1.QuerySendSequenceResponse")
-	proto.RegisterType((*QueryReceiveSequenceRequest)(nil), "cosmos.crosschain.v1.QueryReceiveSequenceRequest")
-	proto.RegisterType((*QueryReceiveSequenceResponse)(nil), "cosmos.crosschain.v1.QueryReceiveSequenceResponse")
+func (m *QueryChannelsResponse) Reset()         { *m = QueryChannelsResponse{} }
+func (m *QueryChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelsResponse) ProtoMessage()    {}
+func (*QueryChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c0bc65cbea0cca3, []int{8}
 }
-
-func init() { proto.RegisterFile("cosmos/crosschain/v1/query.proto", fileDescriptor_3c0bc65cbea0cca3) }
-
-var fileDescriptor_3c0bc65cbea0cca3 = []byte{
-	// 514 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xb1, 0x6f, 0xd3, 0x40,
-	0x14, 0xc6, 0x73, 0x55, 0x1b, 0xe0, 0x51, 0x84, 0x78, 0x64, 0x08, 0x26, 0x35, 0x91, 0xa1, 0x28,
-	0x55, 0x85, 0x4f, 0x49, 0x91, 0x10, 0x11, 0x53, 0x3b, 0x75, 0x6b, 0xdd, 0xad, 0x4b, 0xe4, 0xda,
-	0x27, 0xc7, 0x6a, 0x73, 0xe7, 0xfa, 0x9c, 0x88, 0xae, 0x30, 0xb2, 0x20, 0xb1, 0xf2, 0x07, 0xb0,
-	0xf2, 0x5f, 0x74, 0xac, 0xc4, 0xc2, 0x84, 0x50, 0xc2, 0x1f, 0x82, 0x7c, 0xbe, 0x86, 0xd0, 0x5c,
-	0xd2, 0x64, 0x4a, 0xee, 0xfc, 0x7d, 0xef, 0xfb, 0x49, 0xef, 0xb3, 0xa1, 0x1e, 0x08, 0xd9, 0x13,
-	0x92, 0x06, 0xa9, 0x90, 0x32, 0xe8, 0xfa, 0x31, 0xa7, 0x83, 0x26, 0x3d, 0xef, 0xb3, 0xf4, 0xc2,
-	0x4d, 0x52, 0x91, 0x09, 0xac, 0x14, 0x0a, 0xf7, 0x9f, 0xc2, 0x1d, 0x34, 0xad, 0x4a, 0x24, 0x22,
-	0xa1, 0x04, 0x34, 0xff, 0x57, 0x68, 0xad, 0x5a, 0x24, 0x44, 0x74, 0xc6, 0xa8, 0x9f, 0xc4, 0xd4,
-	0xe7, 0x5c, 0x64, 0x7e, 0x16, 0x0b, 0x2e, 0xf5, 0xd3, 0x4d, 0x63, 0xd6, 0xc4, 0x5c, 0x25, 0x73,
-	0x2a, 0x80, 0x87, 0x79, 0xfe, 0x81, 0x9f, 0xfa, 0x3d, 0xe9, 0xb1, 0xf3, 0x3e, 0x93, 0x99, 0x73,
-	0x08, 0x8f, 0xff, 0xbb, 0x95, 0x89, 0xe0, 0x92, 0x61, 0x1b, 0xca, 0x89, 0xba, 0xa9, 0x92, 0x3a,
-	0x69, 0xdc, 0x6f, 0xd5, 0x5c, 0x13, 0xae, 0x5b, 0xb8, 0x76, 0x57, 0x2f, 0x7f, 0x3d, 0x2b, 0x79,
-	0xda, 0xe1, 0x1c, 0xc3, 0x86, 0x1a, 0xb9, 0x97, 0x4b, 0xf7, 0x72, 0xe9, 0x81, 0x1f, 0x9c, 0xfa,
-	0x11, 0xd3, 0x99, 0xb8, 0x01, 0x10, 0x74, 0x7d, 0xce, 0xd9, 0x59, 0x27, 0x0e, 0x55, 0xc0, 0x03,
-	0xef, 0x9e, 0xbe, 0xd9, 0x0f, 0xd1, 0x82, 0xbb, 0x32, 0x57, 0xf2, 0x80, 0x55, 0x57, 0xea, 0xa4,
-	0xb1, 0xea, 0x8d, 0xcf, 0x4e, 0x1b, 0xec, 0x59, 0xb3, 0x35, 0x79, 0x15, 0xee, 0x24, 0xc5, 0x95,
-	0x9a, 0xbc, 0xee, 0x5d, 0x1f, 0x9d, 0xb7, 0x50, 0x55, 0xde, 0x23, 0xc6, 0xc3, 0x23, 0x3d, 0x70,
-	0x31, 0x24, 0xe7, 0x0d, 0x3c, 0x31, 0x58, 0x75, 0xe2, 0x24, 0x2f, 0xb9, 0xc1, 0xfb, 0x0e, 0x9e,
-	0x2a, 0xa3, 0xc7, 0x02, 0x16, 0x0f, 0xd8, 0x92, 0xb1, 0x6d, 0xa8, 0x99, 0xdd, 0xb7, 0x27, 0xb7,
-	0x3e, 0xad, 0xc1, 0x9a, 0x32, 0xe3, 0x47, 0x02, 0xe5, 0x62, 0x51, 0xd8, 0x30, 0xaf, 0x71, 0xba,
-	0x17, 0xd6, 0xd6, 0x02, 0xca, 0x82, 0xc2, 0x79, 0xf1, 0xe1, 0xc7, 0x9f, 0x2f, 0x2b, 0x36, 0xd6,
-	0xa8, 0xb1, 0x88, 0x45, 0x2b, 0xf0, 0x3b, 0x81, 0x47, 0x53, 0x5b, 0xc3, 0x9d, 0x39, 0x31, 0xb3,
-	0xfa, 0x63, 0xbd, 0x5e, 0xce, 0xa4, 0x31, 0x9b, 0x0a, 0x73, 0x1b, 0xb7, 0xe8, 0xec, 0xf7, 0xa5,
-	0xa3, 0x8e, 0x1d, 0xdd, 0x18, 0xfc, 0x4a, 0x60, 0x7d, 0x72, 0xe5, 0xe8, 0xce, 0x49, 0x36, 0xd4,
-	0xca, 0xa2, 0x0b, 0xeb, 0x35, 0xe4, 0xb6, 0x82, 0xdc, 0xc4, 0xe7, 0x66, 0x48, 0xc9, 0x78, 0xd8,
-	0xb9, 0x5e, 0x31, 0x7e, 0x23, 0xf0, 0xf0, 0x46, 0x35, 0xb0, 0x39, 0x27, 0xd1, 0x5c, 0x42, 0xab,
-	0xb5, 0x8c, 0x45, 0x73, 0xba, 0x8a, 0xb3, 0x81, 0x2f, 0xcd, 0x9c, 0x69, 0x61, 0x1b, 0xa3, 0xee,
-	0xee, 0x5f, 0x0e, 0x6d, 0x72, 0x35, 0xb4, 0xc9, 0xef, 0xa1, 0x4d, 0x3e, 0x8f, 0xec, 0xd2, 0xd5,
-	0xc8, 0x2e, 0xfd, 0x1c, 0xd9, 0xa5, 0x63, 0x1a, 0xc5, 0x59, 0xb7, 0x7f, 0xe2, 0x06, 0xa2, 0x37,
-	0x9e, 0xa5, 0x7e, 0x5e, 0xc9, 0xf0, 0x94, 0xbe, 0x9f, 0x1c, 0x9c, 0x5d, 0x24, 0x4c, 0x9e, 0x94,
-	0xd5, 0xe7, 0x6c, 0xe7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x45, 0x6c, 0xf9, 0x89, 0x63, 0x05,
-	0x00, 0x00,
+func (m *QueryChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelsResponse.Merge(m, src)
+}
+func (m *QueryChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelsResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryChannelsResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the total set of cross chain parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// CrossChainPackage returns the specified cross chain package
-	CrossChainPackage(ctx context.Context, in *QueryCrossChainPackageRequest, opts ...grpc.CallOption) (*QueryCrossChainPackageResponse, error)
-	// SendSequence returns the send sequence of the channel
-	SendSequence(ctx context.Context, in *QuerySendSequenceRequest, opts ...grpc.CallOption) (*QuerySendSequenceResponse, error)
-	// ReceiveSequence returns the receive sequence of the channel
-	ReceiveSequence(ctx context.Context, in *QueryReceiveSequenceRequest, opts ...grpc.CallOption) (*QueryReceiveSequenceResponse, error)
+func (m *QueryChannelsResponse) GetChannels() []ChannelInfo {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryChannelRequest is the request type for the Query/Channel RPC method.
+type QueryChannelRequest struct {
+	// channel id of the registered channel
+	ChannelId uint32 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryChannelRequest) Reset()         { *m = QueryChannelRequest{} }
+func (m *QueryChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelRequest) ProtoMessage()    {}
+func (*QueryChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c0bc65cbea0cca3, []int{9}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.crosschain.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryChannelRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) CrossChainPackage(ctx context.Context, in *QueryCrossChainPackageRequest, opts ...grpc.CallOption) (*QueryCrossChainPackageResponse, error) {
-	out := new(QueryCrossChainPackageResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.crosschain.v1.Query/CrossChainPackage", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelRequest.Merge(m, src)
+}
+func (m *QueryChannelRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) SendSequence(ctx context.Context, in *QuerySendSequenceRequest, opts ...grpc.CallOption) (*QuerySendSequenceResponse, error) {
-	out := new(QuerySendSequenceResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.crosschain.v1.Query/SendSequence", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryChannelRequest proto.InternalMessageInfo
+
+func (m *QueryChannelRequest) GetChannelId() uint32 {
+	if m != nil {
+		return m.ChannelId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ReceiveSequence(ctx context.Context, in *QueryReceiveSequenceRequest, opts ...grpc.CallOption) (*QueryReceiveSequenceResponse, error) {
-	out := new(QueryReceiveSequenceResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.crosschain.v1.Query/ReceiveSequence", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryChannelResponse is the response type for the Query/Channel RPC method.
+type QueryChannelResponse struct {
+	// channel defines the registered channel
+	Channel ChannelInfo `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the total set of cross chain parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// CrossChainPackage returns the specified cross chain package
-	CrossChainPackage(context.Context, *QueryCrossChainPackageRequest) (*QueryCrossChainPackageResponse, error)
-	// SendSequence returns the send sequence of the channel
-	SendSequence(context.Context, *QuerySendSequenceRequest) (*QuerySendSequenceResponse, error)
-	// ReceiveSequence returns the receive sequence of the channel
-	ReceiveSequence(context.Context, *QueryReceiveSequenceRequest) (*QueryReceiveSequenceResponse, error)
+func (m *QueryChannelResponse) Reset()         { *m = QueryChannelResponse{} }
+func (m *QueryChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelResponse) ProtoMessage()    {}
+func (*QueryChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c0bc65cbea0cca3, []int{10}
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryChannelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) CrossChainPackage(ctx context.Context, req *QueryCrossChainPackageRequest) (*QueryCrossChainPackageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CrossChainPackage not implemented")
+func (m *QueryChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) SendSequence(ctx context.Context, req *QuerySendSequenceRequest) (*QuerySendSequenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendSequence not implemented")
+func (m *QueryChannelResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ReceiveSequence(ctx context.Context, req *QueryReceiveSequenceRequest) (*QueryReceiveSequenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReceiveSequence not implemented")
+func (m *QueryChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryChannelResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.crosschain.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryChannelResponse) GetChannel() ChannelInfo {
+	if m != nil {
+		return m.Channel
 	}
-	return interceptor(ctx, in, info, handler)
+	return ChannelInfo{}
 }
 
-func _Query_CrossChainPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCrossChainPackageRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CrossChainPackage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.crosschain.v1.Query/CrossChainPackage",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CrossChainPackage(ctx, req.(*QueryCrossChainPackageRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// ChannelInfo defines the metadata of a registered channel.
+type ChannelInfo struct {
+	// channel id
+	ChannelId uint32 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// name of the channel
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// dest_chains defines the states of the channel for each supported dest chain
+	DestChains []ChannelDestChainInfo `protobuf:"bytes,3,rep,name=dest_chains,json=destChains,proto3" json:"dest_chains"`
 }
 
-func _Query_SendSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySendSequenceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SendSequence(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.crosschain.v1.Query/SendSequence",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SendSequence(ctx, req.(*QuerySendSequenceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *ChannelInfo) Reset()         { *m = ChannelInfo{} }
+func (m *ChannelInfo) String() string { return proto.CompactTextString(m) }
+func (*ChannelInfo) ProtoMessage()    {}
+func (*ChannelInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c0bc65cbea0cca3, []int{11}
 }
-
-func _Query_ReceiveSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReceiveSequenceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ReceiveSequence(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.crosschain.v1.Query/ReceiveSequence",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ReceiveSequence(ctx, req.(*QueryReceiveSequenceRequest))
+func (m *ChannelInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.crosschain.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "CrossChainPackage",
-			Handler:    _Query_CrossChainPackage_Handler,
-		},
-		{
-			MethodName: "SendSequence",
-			Handler:    _Query_SendSequence_Handler,
-		},
-		{
-			MethodName: "ReceiveSequence",
-			Handler:    _Query_ReceiveSequence_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/crosschain/v1/query.proto",
+func (m *ChannelInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelInfo.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ChannelInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelInfo.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ChannelInfo proto.InternalMessageInfo
+
+func (m *ChannelInfo) GetChannelId() uint32 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ChannelInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ChannelInfo) GetDestChains() []ChannelDestChainInfo {
+	if m != nil {
+		return m.DestChains
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ChannelDestChainInfo defines the states of a channel for a dest chain.
+type ChannelDestChainInfo struct {
+	// destination chain id
+	DestChainId uint32 `protobuf:"varint,1,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
+	// send permission of the channel, 1 means allowed and 0 means forbidden
+	SendPermission uint32 `protobuf:"varint,2,opt,name=send_permission,json=sendPermission,proto3" json:"send_permission,omitempty"`
+	// current send sequence of the channel
+	SendSequence uint64 `protobuf:"varint,3,opt,name=send_sequence,json=sendSequence,proto3" json:"send_sequence,omitempty"`
+	// current receive sequence of the channel
+	ReceiveSequence uint64 `protobuf:"varint,4,opt,name=receive_sequence,json=receiveSequence,proto3" json:"receive_sequence,omitempty"`
+	// app_bound indicates whether a cross chain app is bound to the channel for the dest chain
+	AppBound bool `protobuf:"varint,5,opt,name=app_bound,json=appBound,proto3" json:"app_bound,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *ChannelDestChainInfo) Reset()         { *m = ChannelDestChainInfo{} }
+func (m *ChannelDestChainInfo) String() string { return proto.CompactTextString(m) }
+func (*ChannelDestChainInfo) ProtoMessage()    {}
+func (*ChannelDestChainInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c0bc65cbea0cca3, []int{12}
+}
+func (m *ChannelDestChainInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelDestChainInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelDestChainInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ChannelDestChainInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelDestChainInfo.Merge(m, src)
+}
+func (m *ChannelDestChainInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelDestChainInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelDestChainInfo.DiscardUnknown(m)
 }
 
-func (m *QueryCrossChainPackageRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ChannelDestChainInfo proto.InternalMessageInfo
+
+func (m *ChannelDestChainInfo) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryCrossChainPackageRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ChannelDestChainInfo) GetSendPermission() uint32 {
+	if m != nil {
+		return m.SendPermission
+	}
+	return 0
 }
 
-func (m *QueryCrossChainPackageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ChannelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChannelId))
-		i--
-		dAtA[i] = 0x8
+func (m *ChannelDestChainInfo) GetSendSequence() uint64 {
+	if m != nil {
+		return m.SendSequence
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryCrossChainPackageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ChannelDestChainInfo) GetReceiveSequence() uint64 {
+	if m != nil {
+		return m.ReceiveSequence
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryCrossChainPackageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ChannelDestChainInfo) GetAppBound() bool {
+	if m != nil {
+		return m.AppBound
+	}
+	return false
 }
 
-func (m *QueryCrossChainPackageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Package) > 0 {
-		i -= len(m.Package)
-		copy(dAtA[i:], m.Package)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Package)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QuerySendSequenceRequest is the request type for the Query/SendSequence RPC method.
+type QuerySendSequenceRequest struct {
+	// channel id of the cross chain package
+	ChannelId uint32 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// destination chain id of the channel
+	DestChainId uint32 `protobuf:"varint,2,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
 }
 
-func (m *QuerySendSequenceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySendSequenceRequest) Reset()         { *m = QuerySendSequenceRequest{} }
+func (m *QuerySendSequenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySendSequenceRequest) ProtoMessage()    {}
+func (*QuerySendSequenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c0bc65cbea0cca3, []int{13}
 }
-
-func (m *QuerySendSequenceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySendSequenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QuerySendSequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChannelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChannelId))
-		i--
-		dAtA[i] = 0x8
+func (m *QuerySendSequenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySendSequenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QuerySendSequenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySendSequenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySendSequenceRequest.Merge(m, src)
 }
-
-func (m *QuerySendSequenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySendSequenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySendSequenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySendSequenceRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySendSequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QuerySendSequenceRequest proto.InternalMessageInfo
+
+func (m *QuerySendSequenceRequest) GetChannelId() uint32 {
+	if m != nil {
+		return m.ChannelId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryReceiveSequenceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySendSequenceRequest) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryReceiveSequenceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySendSequenceResponse is the response type for the Query/SendSequence RPC method.
+type QuerySendSequenceResponse struct {
+	// sequence of the cross chain package
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (m *QueryReceiveSequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChannelId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChannelId))
-		i--
-		dAtA[i] = 0x8
+func (m *QuerySendSequenceResponse) Reset()         { *m = QuerySendSequenceResponse{} }
+func (m *QuerySendSequenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySendSequenceResponse) ProtoMessage()    {}
+func (*QuerySendSequenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c0bc65cbea0cca3, []int{14}
+}
+func (m *QuerySendSequenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySendSequenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySendSequenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QuerySendSequenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySendSequenceResponse.Merge(m, src)
+}
+func (m *QuerySendSequenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySendSequenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySendSequenceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryReceiveSequenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySendSequenceResponse proto.InternalMessageInfo
+
+func (m *QuerySendSequenceResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryReceiveSequenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySendSequenceRequest is the request type for the Query/ReceiveSequence RPC method.
+type QueryReceiveSequenceRequest struct {
+	// channel id of the cross chain package
+	ChannelId uint32 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// destination chain id of the channel
+	DestChainId uint32 `protobuf:"varint,2,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
 }
 
-func (m *QueryReceiveSequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryReceiveSequenceRequest) Reset()         { *m = QueryReceiveSequenceRequest{} }
+func (m *QueryReceiveSequenceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReceiveSequenceRequest) ProtoMessage()    {}
+func (*QueryReceiveSequenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c0bc65cbea0cca3, []int{15}
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryReceiveSequenceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReceiveSequenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReceiveSequenceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
 }
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryReceiveSequenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReceiveSequenceRequest.Merge(m, src)
+}
+func (m *QueryReceiveSequenceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReceiveSequenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReceiveSequenceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCrossChainPackageRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChannelId != 0 {
-		n += 1 + sovQuery(uint64(m.ChannelId))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+var xxx_messageInfo_QueryReceiveSequenceRequest proto.InternalMessageInfo
+
+func (m *QueryReceiveSequenceRequest) GetChannelId() uint32 {
+	if m != nil {
+		return m.ChannelId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryCrossChainPackageResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Package)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryReceiveSequenceRequest) GetDestChainId() uint32 {
+	if m != nil {
+		return m.DestChainId
 	}
-	return n
+	return 0
 }
 
-func (m *QuerySendSequenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChannelId != 0 {
-		n += 1 + sovQuery(uint64(m.ChannelId))
-	}
-	return n
+// QuerySendSequenceResponse is the response type for the Query/ReceiveSequence RPC method.
+type QueryReceiveSequenceResponse struct {
+	// sequence of the cross chain package
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (m *QuerySendSequenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReceiveSequenceResponse) Reset()         { *m = QueryReceiveSequenceResponse{} }
+func (m *QueryReceiveSequenceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReceiveSequenceResponse) ProtoMessage()    {}
+func (*QueryReceiveSequenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c0bc65cbea0cca3, []int{16}
+}
+func (m *QueryReceiveSequenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReceiveSequenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReceiveSequenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReceiveSequenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReceiveSequenceResponse.Merge(m, src)
+}
+func (m *QueryReceiveSequenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReceiveSequenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReceiveSequenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReceiveSequenceResponse proto.InternalMessageInfo
+
+func (m *QueryReceiveSequenceResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.crosschain.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.crosschain.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryCrossChainPackageRequest)(nil), "cosmos.crosschain.v1.QueryCrossChainPackageRequest")
+	proto.RegisterType((*QueryCrossChainPackageResponse)(nil), "cosmos.crosschain.v1.QueryCrossChainPackageResponse")
+	proto.RegisterType((*QueryCrossChainPackagesRequest)(nil), "cosmos.crosschain.v1.QueryCrossChainPackagesRequest")
+	proto.RegisterType((*QueryCrossChainPackagesResponse)(nil), "cosmos.crosschain.v1.QueryCrossChainPackagesResponse")
+	proto.RegisterType((*CrossChainPackageInfo)(nil), "cosmos.crosschain.v1.CrossChainPackageInfo")
+	proto.RegisterType((*QueryChannelsRequest)(nil), "cosmos.crosschain.v1.QueryChannelsRequest")
+	proto.RegisterType((*QueryChannelsResponse)(nil), "cosmos.crosschain.v1.QueryChannelsResponse")
+	proto.RegisterType((*QueryChannelRequest)(nil), "cosmos.crosschain.v1.QueryChannelRequest")
+	proto.RegisterType((*QueryChannelResponse)(nil), "cosmos.crosschain.v1.QueryChannelResponse")
+	proto.RegisterType((*ChannelInfo)(nil), "cosmos.crosschain.v1.ChannelInfo")
+	proto.RegisterType((*ChannelDestChainInfo)(nil), "cosmos.crosschain.v1.ChannelDestChainInfo")
+	proto.RegisterType((*QuerySendSequenceRequest)(nil), "cosmos.crosschain.v1.QuerySendSequenceRequest")
+	proto.RegisterType((*QuerySendSequenceResponse)(nil), "cosmos.crosschain.v1.QuerySendSequenceResponse")
+	proto.RegisterType((*QueryReceiveSequenceRequest)(nil), "cosmos.crosschain.v1.QueryReceiveSequenceRequest")
+	proto.RegisterType((*QueryReceiveSequenceResponse)(nil), "cosmos.crosschain.v1.QueryReceiveSequenceResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/crosschain/v1/query.proto", fileDescriptor_3c0bc65cbea0cca3) }
+
+var fileDescriptor_3c0bc65cbea0cca3 = []byte{
+	// 1063 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xee, 0xa4, 0xbf, 0x92, 0x97, 0x64, 0xbb, 0x3b, 0x64, 0x51, 0xc8, 0x66, 0xdd, 0xd6, 0x4b,
+	0xbb, 0x69, 0xc2, 0xda, 0x4a, 0xba, 0x08, 0xa9, 0x37, 0x5a, 0xb4, 0xa8, 0x07, 0xa4, 0xd6, 0xbb,
+	0x17, 0x10, 0x28, 0x4c, 0x9c, 0xd9, 0xd4, 0x6a, 0x63, 0x7b, 0x3d, 0x4e, 0x45, 0x84, 0xe0, 0x00,
+	0x47, 0x2e, 0x48, 0x2b, 0x6e, 0xdc, 0xe1, 0xca, 0x81, 0x03, 0xff, 0x41, 0x8f, 0x95, 0xb8, 0x70,
+	0x42, 0xa8, 0xe5, 0xc2, 0x1f, 0xc0, 0x1d, 0x79, 0x3c, 0xfe, 0x95, 0x38, 0xa9, 0x2b, 0x71, 0x6a,
+	0xfc, 0xfc, 0xbd, 0x79, 0xdf, 0x9b, 0xf7, 0xcd, 0x37, 0x2e, 0x6c, 0xe8, 0x16, 0x1b, 0x5a, 0x4c,
+	0xd5, 0x1d, 0x8b, 0x31, 0xfd, 0x84, 0x18, 0xa6, 0x7a, 0xde, 0x56, 0x5f, 0x8d, 0xa8, 0x33, 0x56,
+	0x6c, 0xc7, 0x72, 0x2d, 0x5c, 0xf1, 0x11, 0x4a, 0x84, 0x50, 0xce, 0xdb, 0xb5, 0xca, 0xc0, 0x1a,
+	0x58, 0x1c, 0xa0, 0x7a, 0xbf, 0x7c, 0x6c, 0xad, 0x3e, 0xb0, 0xac, 0xc1, 0x19, 0x55, 0x89, 0x6d,
+	0xa8, 0xc4, 0x34, 0x2d, 0x97, 0xb8, 0x86, 0x65, 0x32, 0xf1, 0xb6, 0x29, 0x6a, 0xf5, 0x08, 0xa3,
+	0x7e, 0x09, 0xf5, 0xbc, 0xdd, 0xa3, 0x2e, 0x69, 0xab, 0x36, 0x19, 0x18, 0x26, 0x07, 0x0b, 0xec,
+	0x56, 0x2a, 0xaf, 0x18, 0x07, 0x0e, 0x93, 0x2b, 0x80, 0x8f, 0xbd, 0x85, 0x8e, 0x88, 0x43, 0x86,
+	0x4c, 0xa3, 0xaf, 0x46, 0x94, 0xb9, 0xf2, 0x31, 0xbc, 0x91, 0x88, 0x32, 0xdb, 0x32, 0x19, 0xc5,
+	0x7b, 0xb0, 0x62, 0xf3, 0x48, 0x15, 0x6d, 0xa0, 0x46, 0xb1, 0x53, 0x57, 0xd2, 0x5a, 0x53, 0xfc,
+	0xac, 0xfd, 0xa5, 0x8b, 0x3f, 0xd7, 0x17, 0x34, 0x91, 0x21, 0x7f, 0x0d, 0x0f, 0xf9, 0x92, 0x07,
+	0x1e, 0xf4, 0xc0, 0x83, 0x1e, 0x11, 0xfd, 0x94, 0x0c, 0xa8, 0xa8, 0x89, 0x1f, 0x02, 0xe8, 0x27,
+	0xc4, 0x34, 0xe9, 0x59, 0xd7, 0xe8, 0xf3, 0x02, 0x65, 0xad, 0x20, 0x22, 0x87, 0x7d, 0x5c, 0x83,
+	0x3c, 0xf3, 0x90, 0xa6, 0x4e, 0xab, 0xb9, 0x0d, 0xd4, 0x58, 0xd2, 0xc2, 0x67, 0x2c, 0x43, 0xb9,
+	0x4f, 0x99, 0xdb, 0xe5, 0x0c, 0xbc, 0xec, 0x45, 0x9e, 0x5d, 0xf4, 0x82, 0xbc, 0xd4, 0x61, 0x5f,
+	0xde, 0x03, 0x69, 0x56, 0x7d, 0xd1, 0x5d, 0x15, 0x56, 0x6d, 0x3f, 0xc4, 0xab, 0x97, 0xb4, 0xe0,
+	0x51, 0xfe, 0x17, 0xcd, 0x4a, 0x0e, 0x76, 0x6c, 0x9a, 0x02, 0x9a, 0xa2, 0x30, 0xd1, 0x61, 0x6e,
+	0xb2, 0xc3, 0x2d, 0xb8, 0xc3, 0x5c, 0xe2, 0xb8, 0xdd, 0xb0, 0xcf, 0x45, 0xde, 0x67, 0x99, 0x47,
+	0x9f, 0x07, 0xcd, 0x6e, 0x42, 0x89, 0x9a, 0xfd, 0x08, 0xb4, 0xc4, 0x41, 0x45, 0x6a, 0xf6, 0x43,
+	0xc8, 0x33, 0x80, 0x48, 0x0f, 0xd5, 0x65, 0x3e, 0xab, 0xed, 0x60, 0x56, 0x9e, 0x78, 0x14, 0x5f,
+	0x9f, 0x42, 0x3c, 0xca, 0x51, 0x34, 0x06, 0x2d, 0x96, 0x29, 0xff, 0x86, 0x60, 0x7d, 0x66, 0xdf,
+	0x62, 0xd7, 0x3e, 0x82, 0xbc, 0xd8, 0x26, 0x4f, 0x15, 0x8b, 0x8d, 0x62, 0xa7, 0x95, 0xae, 0x8a,
+	0xa9, 0x35, 0x0e, 0xcd, 0x97, 0x96, 0x10, 0x49, 0xb8, 0x04, 0xfe, 0x30, 0x41, 0x3d, 0xc7, 0xa9,
+	0x3f, 0xbe, 0x91, 0xba, 0xcf, 0x25, 0xc1, 0xfd, 0xa7, 0x1c, 0xdc, 0x4f, 0x2d, 0x99, 0x50, 0x12,
+	0x9a, 0x50, 0xd2, 0x26, 0x94, 0x04, 0x95, 0xae, 0x3b, 0xb6, 0xa9, 0x18, 0x52, 0x51, 0xc4, 0x5e,
+	0x8c, 0x6d, 0x8a, 0xeb, 0x50, 0x70, 0x8d, 0x21, 0x65, 0x2e, 0x19, 0xda, 0x62, 0x42, 0x51, 0x00,
+	0xaf, 0x43, 0xd1, 0xa1, 0x67, 0x64, 0x4c, 0x9d, 0xee, 0x4b, 0xea, 0x0f, 0xa7, 0xa0, 0x81, 0x08,
+	0x3d, 0xa3, 0x14, 0x6f, 0xc3, 0x1a, 0xd1, 0x4f, 0xbb, 0x71, 0xd0, 0x32, 0x07, 0x95, 0x89, 0x7e,
+	0xaa, 0x45, 0xb8, 0x98, 0x1a, 0x57, 0x12, 0x6a, 0xf4, 0x38, 0xb2, 0xb1, 0x19, 0x09, 0x60, 0xd5,
+	0x17, 0x00, 0x1b, 0x9b, 0xa1, 0x00, 0x5a, 0x70, 0xcf, 0xa3, 0x64, 0x8d, 0xdc, 0x6e, 0xc4, 0x35,
+	0xcf, 0x71, 0x77, 0xc5, 0x8b, 0x17, 0x41, 0x5c, 0x7e, 0x13, 0x2a, 0xfe, 0x90, 0x7d, 0x25, 0x86,
+	0x26, 0xf0, 0x29, 0xdc, 0x9f, 0x88, 0x8b, 0x91, 0x1f, 0x40, 0x5e, 0xa8, 0x36, 0x18, 0xf9, 0xe6,
+	0x8c, 0x91, 0x0b, 0x6d, 0xc7, 0x06, 0x1d, 0x24, 0xca, 0x4f, 0x85, 0xc5, 0x08, 0x4c, 0x36, 0x17,
+	0x90, 0x3f, 0x4e, 0x72, 0x0d, 0x29, 0xbd, 0x0f, 0xab, 0x02, 0x24, 0xac, 0x29, 0x33, 0xa3, 0x20,
+	0x4f, 0x7e, 0x8d, 0xa0, 0x18, 0x7b, 0x7d, 0x93, 0x1f, 0x61, 0x58, 0x32, 0xc9, 0xd0, 0x57, 0x48,
+	0x41, 0xe3, 0xbf, 0xf1, 0x31, 0x14, 0x23, 0x13, 0x60, 0xd5, 0x45, 0xbe, 0x37, 0xcd, 0xb9, 0x4c,
+	0x3e, 0x08, 0xfd, 0x21, 0xa2, 0x04, 0xa1, 0x69, 0x30, 0xf9, 0x12, 0x41, 0x25, 0x0d, 0x9a, 0xc9,
+	0x70, 0x1e, 0xc3, 0x1a, 0xf3, 0xbc, 0xc2, 0xa6, 0xce, 0xd0, 0x60, 0x2c, 0x38, 0x51, 0x65, 0xed,
+	0x8e, 0x17, 0x3e, 0x0a, 0xa3, 0xf8, 0x11, 0x94, 0x59, 0xc2, 0x54, 0x7c, 0x5d, 0x97, 0x58, 0xdc,
+	0x55, 0x76, 0xe0, 0xae, 0x43, 0x75, 0x6a, 0x9c, 0xd3, 0x49, 0xf3, 0x59, 0x13, 0xf1, 0x10, 0xfa,
+	0x00, 0x0a, 0xc4, 0xb6, 0xbb, 0x3d, 0x6b, 0x64, 0xf6, 0xb9, 0xbc, 0xf3, 0x5a, 0x9e, 0xd8, 0xf6,
+	0xbe, 0xf7, 0x2c, 0x7f, 0x06, 0x55, 0x3e, 0xc3, 0xe7, 0xb1, 0xc5, 0x33, 0x5e, 0x02, 0x53, 0x4d,
+	0xe7, 0xa6, 0x8d, 0xfe, 0x3d, 0x78, 0x2b, 0x65, 0x79, 0xa1, 0x93, 0x39, 0x67, 0x5f, 0xfe, 0x1c,
+	0x1e, 0xf0, 0x44, 0x2d, 0xd9, 0xcc, 0xff, 0x48, 0x6d, 0x0f, 0xea, 0xe9, 0x15, 0x6e, 0x66, 0xd7,
+	0xf9, 0x27, 0x0f, 0xcb, 0x3c, 0x19, 0x7f, 0x8b, 0x60, 0xc5, 0xbf, 0x62, 0x71, 0x23, 0x5d, 0x5b,
+	0xd3, 0x37, 0x7a, 0x6d, 0x27, 0x03, 0xd2, 0x67, 0x21, 0xbf, 0xfd, 0xcd, 0xef, 0x7f, 0xbf, 0xce,
+	0x49, 0xb8, 0xae, 0xa6, 0x7e, 0x42, 0xf8, 0xf7, 0x39, 0xfe, 0x05, 0xc1, 0xbd, 0x29, 0x7f, 0xc5,
+	0xbb, 0x73, 0xca, 0xcc, 0xba, 0xf9, 0x6b, 0x4f, 0x6f, 0x97, 0x24, 0x68, 0xb6, 0x39, 0xcd, 0x16,
+	0xde, 0x51, 0x67, 0x7f, 0xe9, 0x88, 0x69, 0x04, 0xce, 0xf9, 0x2b, 0x02, 0x3c, 0x7d, 0x95, 0xe1,
+	0x5b, 0xd5, 0x0f, 0x77, 0xf4, 0xdd, 0x5b, 0x66, 0x09, 0xda, 0x1d, 0x4e, 0xfb, 0x1d, 0xdc, 0xcc,
+	0x4c, 0x9b, 0xe1, 0xef, 0x10, 0xe4, 0x03, 0x17, 0xc6, 0xcd, 0x79, 0x75, 0x93, 0x16, 0x5e, 0x6b,
+	0x65, 0xc2, 0x0a, 0x66, 0xdb, 0x9c, 0xd9, 0x06, 0x96, 0x66, 0x30, 0x0b, 0x08, 0xfc, 0x80, 0x60,
+	0x55, 0x24, 0xe3, 0x9d, 0x9b, 0x0b, 0x04, 0x5c, 0x9a, 0x59, 0xa0, 0x82, 0xca, 0x2e, 0xa7, 0xf2,
+	0x04, 0xb7, 0xe6, 0x53, 0x51, 0xbf, 0x8c, 0x4e, 0xe4, 0x57, 0xf8, 0x47, 0x04, 0xa5, 0xf8, 0xa1,
+	0xc7, 0xca, 0x9c, 0x8a, 0x29, 0xe6, 0x53, 0x53, 0x33, 0xe3, 0x05, 0xcd, 0x16, 0xa7, 0xb9, 0x85,
+	0x1f, 0xa5, 0xd3, 0x4c, 0x58, 0x2a, 0xfe, 0x19, 0xc1, 0xda, 0xc4, 0xc1, 0xc7, 0xed, 0x39, 0x15,
+	0xd3, 0x6d, 0xa8, 0xd6, 0xb9, 0x4d, 0x8a, 0xe0, 0xa9, 0x70, 0x9e, 0x0d, 0xbc, 0x9d, 0xce, 0x73,
+	0xd2, 0xd5, 0xf7, 0x0f, 0x2f, 0xae, 0x24, 0x74, 0x79, 0x25, 0xa1, 0xbf, 0xae, 0x24, 0xf4, 0xfd,
+	0xb5, 0xb4, 0x70, 0x79, 0x2d, 0x2d, 0xfc, 0x71, 0x2d, 0x2d, 0x7c, 0xa2, 0x0e, 0x0c, 0xf7, 0x64,
+	0xd4, 0x53, 0x74, 0x6b, 0x18, 0xae, 0xc5, 0xff, 0x3c, 0x61, 0xfd, 0x53, 0xf5, 0x8b, 0xf8, 0xc2,
+	0xde, 0xf7, 0x13, 0xeb, 0xad, 0xf0, 0x7f, 0x33, 0x76, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xfc,
+	0x0c, 0x3a, 0xd3, 0x27, 0x0d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the total set of cross chain parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// CrossChainPackage returns the specified cross chain package
+	CrossChainPackage(ctx context.Context, in *QueryCrossChainPackageRequest, opts ...grpc.CallOption) (*QueryCrossChainPackageResponse, error)
+	// CrossChainPackages returns the cross chain packages of the channel within a sequence range
+	CrossChainPackages(ctx context.Context, in *QueryCrossChainPackagesRequest, opts ...grpc.CallOption) (*QueryCrossChainPackagesResponse, error)
+	// Channels returns all the registered channels
+	Channels(ctx context.Context, in *QueryChannelsRequest, opts ...grpc.CallOption) (*QueryChannelsResponse, error)
+	// Channel returns the specified registered channel
+	Channel(ctx context.Context, in *QueryChannelRequest, opts ...grpc.CallOption) (*QueryChannelResponse, error)
+	// SendSequence returns the send sequence of the channel
+	SendSequence(ctx context.Context, in *QuerySendSequenceRequest, opts ...grpc.CallOption) (*QuerySendSequenceResponse, error)
+	// ReceiveSequence returns the receive sequence of the channel
+	ReceiveSequence(ctx context.Context, in *QueryReceiveSequenceRequest, opts ...grpc.CallOption) (*QueryReceiveSequenceResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crosschain.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CrossChainPackage(ctx context.Context, in *QueryCrossChainPackageRequest, opts ...grpc.CallOption) (*QueryCrossChainPackageResponse, error) {
+	out := new(QueryCrossChainPackageResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crosschain.v1.Query/CrossChainPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CrossChainPackages(ctx context.Context, in *QueryCrossChainPackagesRequest, opts ...grpc.CallOption) (*QueryCrossChainPackagesResponse, error) {
+	out := new(QueryCrossChainPackagesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crosschain.v1.Query/CrossChainPackages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Channels(ctx context.Context, in *QueryChannelsRequest, opts ...grpc.CallOption) (*QueryChannelsResponse, error) {
+	out := new(QueryChannelsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crosschain.v1.Query/Channels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Channel(ctx context.Context, in *QueryChannelRequest, opts ...grpc.CallOption) (*QueryChannelResponse, error) {
+	out := new(QueryChannelResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crosschain.v1.Query/Channel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SendSequence(ctx context.Context, in *QuerySendSequenceRequest, opts ...grpc.CallOption) (*QuerySendSequenceResponse, error) {
+	out := new(QuerySendSequenceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crosschain.v1.Query/SendSequence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReceiveSequence(ctx context.Context, in *QueryReceiveSequenceRequest, opts ...grpc.CallOption) (*QueryReceiveSequenceResponse, error) {
+	out := new(QueryReceiveSequenceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crosschain.v1.Query/ReceiveSequence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the total set of cross chain parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// CrossChainPackage returns the specified cross chain package
+	CrossChainPackage(context.Context, *QueryCrossChainPackageRequest) (*QueryCrossChainPackageResponse, error)
+	// CrossChainPackages returns the cross chain packages of the channel within a sequence range
+	CrossChainPackages(context.Context, *QueryCrossChainPackagesRequest) (*QueryCrossChainPackagesResponse, error)
+	// Channels returns all the registered channels
+	Channels(context.Context, *QueryChannelsRequest) (*QueryChannelsResponse, error)
+	// Channel returns the specified registered channel
+	Channel(context.Context, *QueryChannelRequest) (*QueryChannelResponse, error)
+	// SendSequence returns the send sequence of the channel
+	SendSequence(context.Context, *QuerySendSequenceRequest) (*QuerySendSequenceResponse, error)
+	// ReceiveSequence returns the receive sequence of the channel
+	ReceiveSequence(context.Context, *QueryReceiveSequenceRequest) (*QueryReceiveSequenceResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) CrossChainPackage(ctx context.Context, req *QueryCrossChainPackageRequest) (*QueryCrossChainPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrossChainPackage not implemented")
+}
+func (*UnimplementedQueryServer) CrossChainPackages(ctx context.Context, req *QueryCrossChainPackagesRequest) (*QueryCrossChainPackagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrossChainPackages not implemented")
+}
+func (*UnimplementedQueryServer) Channels(ctx context.Context, req *QueryChannelsRequest) (*QueryChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Channels not implemented")
+}
+func (*UnimplementedQueryServer) Channel(ctx context.Context, req *QueryChannelRequest) (*QueryChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Channel not implemented")
+}
+func (*UnimplementedQueryServer) SendSequence(ctx context.Context, req *QuerySendSequenceRequest) (*QuerySendSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendSequence not implemented")
+}
+func (*UnimplementedQueryServer) ReceiveSequence(ctx context.Context, req *QueryReceiveSequenceRequest) (*QueryReceiveSequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveSequence not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crosschain.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CrossChainPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCrossChainPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CrossChainPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crosschain.v1.Query/CrossChainPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CrossChainPackage(ctx, req.(*QueryCrossChainPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CrossChainPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCrossChainPackagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CrossChainPackages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crosschain.v1.Query/CrossChainPackages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CrossChainPackages(ctx, req.(*QueryCrossChainPackagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Channels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Channels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crosschain.v1.Query/Channels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Channels(ctx, req.(*QueryChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Channel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Channel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crosschain.v1.Query/Channel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Channel(ctx, req.(*QueryChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SendSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySendSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SendSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crosschain.v1.Query/SendSequence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SendSequence(ctx, req.(*QuerySendSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReceiveSequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReceiveSequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReceiveSequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crosschain.v1.Query/ReceiveSequence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReceiveSequence(ctx, req.(*QueryReceiveSequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.crosschain.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "CrossChainPackage",
+			Handler:    _Query_CrossChainPackage_Handler,
+		},
+		{
+			MethodName: "CrossChainPackages",
+			Handler:    _Query_CrossChainPackages_Handler,
+		},
+		{
+			MethodName: "Channels",
+			Handler:    _Query_Channels_Handler,
+		},
+		{
+			MethodName: "Channel",
+			Handler:    _Query_Channel_Handler,
+		},
+		{
+			MethodName: "SendSequence",
+			Handler:    _Query_SendSequence_Handler,
+		},
+		{
+			MethodName: "ReceiveSequence",
+			Handler:    _Query_ReceiveSequence_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/crosschain/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCrossChainPackageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossChainPackageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossChainPackageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DestChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCrossChainPackageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossChainPackageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossChainPackageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Package) > 0 {
+		i -= len(m.Package)
+		copy(dAtA[i:], m.Package)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Package)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCrossChainPackagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossChainPackagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossChainPackagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EndSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndSequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DestChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCrossChainPackagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCrossChainPackagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCrossChainPackagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Packages) > 0 {
+		for iNdEx := len(m.Packages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CrossChainPackageInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossChainPackageInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossChainPackageInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SynSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SynSequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Package) > 0 {
+		i -= len(m.Package)
+		copy(dAtA[i:], m.Package)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Package)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AckRelayerFee) > 0 {
+		i -= len(m.AckRelayerFee)
+		copy(dAtA[i:], m.AckRelayerFee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AckRelayerFee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RelayerFee) > 0 {
+		i -= len(m.RelayerFee)
+		copy(dAtA[i:], m.RelayerFee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RelayerFee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PackageType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PackageType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChannelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Channel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestChains) > 0 {
+		for iNdEx := len(m.DestChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DestChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelDestChainInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelDestChainInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelDestChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AppBound {
+		i--
+		if m.AppBound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ReceiveSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReceiveSequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SendSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SendSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SendPermission != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SendPermission))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DestChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySendSequenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySendSequenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySendSequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DestChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySendSequenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySendSequenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySendSequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReceiveSequenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReceiveSequenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReceiveSequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DestChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChannelId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChannelId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReceiveSequenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReceiveSequenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReceiveSequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCrossChainPackageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelId != 0 {
+		n += 1 + sovQuery(uint64(m.ChannelId))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovQuery(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *QueryCrossChainPackageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Package)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCrossChainPackagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestChainId != 0 {
+		n += 1 + sovQuery(uint64(m.DestChainId))
+	}
+	if m.ChannelId != 0 {
+		n += 1 + sovQuery(uint64(m.ChannelId))
+	}
+	if m.StartSequence != 0 {
+		n += 1 + sovQuery(uint64(m.StartSequence))
+	}
+	if m.EndSequence != 0 {
+		n += 1 + sovQuery(uint64(m.EndSequence))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCrossChainPackagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packages) > 0 {
+		for _, e := range m.Packages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CrossChainPackageInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.PackageType != 0 {
+		n += 1 + sovQuery(uint64(m.PackageType))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	l = len(m.RelayerFee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AckRelayerFee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Package)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SynSequence != 0 {
+		n += 1 + sovQuery(uint64(m.SynSequence))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *QueryChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelId != 0 {
+		n += 1 + sovQuery(uint64(m.ChannelId))
+	}
+	return n
+}
+
+func (m *QueryChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Channel.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ChannelInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelId != 0 {
+		n += 1 + sovQuery(uint64(m.ChannelId))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.DestChains) > 0 {
+		for _, e := range m.DestChains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelDestChainInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DestChainId != 0 {
+		n += 1 + sovQuery(uint64(m.DestChainId))
+	}
+	if m.SendPermission != 0 {
+		n += 1 + sovQuery(uint64(m.SendPermission))
+	}
+	if m.SendSequence != 0 {
+		n += 1 + sovQuery(uint64(m.SendSequence))
+	}
+	if m.ReceiveSequence != 0 {
+		n += 1 + sovQuery(uint64(m.ReceiveSequence))
+	}
+	if m.AppBound {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySendSequenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelId != 0 {
+		n += 1 + sovQuery(uint64(m.ChannelId))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovQuery(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *QuerySendSequenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryReceiveSequenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChannelId != 0 {
+		n += 1 + sovQuery(uint64(m.ChannelId))
+	}
+	if m.DestChainId != 0 {
+		n += 1 + sovQuery(uint64(m.DestChainId))
+	}
+	return n
+}
+
+func (m *QueryReceiveSequenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCrossChainPackageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCrossChainPackageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCrossChainPackageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCrossChainPackageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCrossChainPackageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCrossChainPackageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Package = append(m.Package[:0], dAtA[iNdEx:postIndex]...)
+			if m.Package == nil {
+				m.Package = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCrossChainPackagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCrossChainPackagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCrossChainPackagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSequence", wireType)
+			}
+			m.StartSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSequence", wireType)
+			}
+			m.EndSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCrossChainPackagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCrossChainPackagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCrossChainPackagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packages = append(m.Packages, CrossChainPackageInfo{})
+			if err := m.Packages[len(m.Packages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrossChainPackageInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossChainPackageInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossChainPackageInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackageType", wireType)
+			}
+			m.PackageType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PackageType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckRelayerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckRelayerFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Package = append(m.Package[:0], dAtA[iNdEx:postIndex]...)
+			if m.Package == nil {
+				m.Package = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynSequence", wireType)
+			}
+			m.SynSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SynSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryReceiveSequenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if m.ChannelId != 0 {
-		n += 1 + sovQuery(uint64(m.ChannelId))
+	return nil
+}
+func (m *QueryChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, ChannelInfo{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryReceiveSequenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1010,12 +3338,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1037,7 +3384,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1060,15 +3407,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1095,7 +3442,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1120,7 +3467,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCrossChainPackageRequest) Unmarshal(dAtA []byte) error {
+func (m *ChannelInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1143,10 +3490,10 @@ func (m *QueryCrossChainPackageRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCrossChainPackageRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCrossChainPackageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1169,10 +3516,10 @@ func (m *QueryCrossChainPackageRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Sequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1182,11 +3529,58 @@ func (m *QueryCrossChainPackageRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestChains = append(m.DestChains, ChannelDestChainInfo{})
+			if err := m.DestChains[len(m.DestChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1208,7 +3602,7 @@ func (m *QueryCrossChainPackageRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCrossChainPackageResponse) Unmarshal(dAtA []byte) error {
+func (m *ChannelDestChainInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1231,17 +3625,17 @@ func (m *QueryCrossChainPackageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCrossChainPackageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelDestChainInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCrossChainPackageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelDestChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
 			}
-			var byteLen int
+			m.DestChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1251,26 +3645,88 @@ func (m *QueryCrossChainPackageResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.DestChainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendPermission", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.SendPermission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendPermission |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendSequence", wireType)
 			}
-			m.Package = append(m.Package[:0], dAtA[iNdEx:postIndex]...)
-			if m.Package == nil {
-				m.Package = []byte{}
+			m.SendSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveSequence", wireType)
+			}
+			m.ReceiveSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiveSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppBound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AppBound = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1340,6 +3796,25 @@ func (m *QuerySendSequenceRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1478,6 +3953,25 @@ func (m *QueryReceiveSequenceRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TypedCrossChainApplication is a cross chain application working with typed packages instead of raw payloads.
+// Syn is the type of the syn packages and Ack is the type of the ack packages of the channel, the payloads are
+// decoded into and encoded from them with rlp.
+type TypedCrossChainApplication[Syn, Ack any] interface {
+	// ExecuteSynPackage executes a syn package, the returned ack package is sent back to the src chain if it is not nil.
+	ExecuteSynPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, pack *Syn) TypedExecuteResult[Ack]
+	ExecuteAckPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, pack *Ack) sdk.ExecuteResult
+	// ExecuteFailAckPackage executes a fail ack package, pack is the origin syn package.
+	ExecuteFailAckPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, pack *Syn) sdk.ExecuteResult
+	// ExecuteTimeoutPackage executes a timed out syn package, pack is the origin syn package.
+	ExecuteTimeoutPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, pack *Syn) sdk.ExecuteResult
+}
+
+// TypedExecuteResult is the result of executing a typed syn package
+type TypedExecuteResult[Ack any] struct {
+	Err error
+	// Ack is the ack package to send back, no ack package is sent if it is nil
+	Ack *Ack
+}
+
+var _ sdk.CrossChainApplication = typedCrossChainApp[struct{}, struct{}]{}
+
+// typedCrossChainApp adapts a TypedCrossChainApplication to sdk.CrossChainApplication
+type typedCrossChainApp[Syn, Ack any] struct {
+	app TypedCrossChainApplication[Syn, Ack]
+}
+
+// NewTypedCrossChainApp returns a sdk.CrossChainApplication which decodes the payloads for the typed app before
+// executing the packages and encodes the ack packages returned by it. A syn package which fails to be decoded
+// results in ErrDecodePackage, and a fail ack package is sent back to the src chain for it.
+func NewTypedCrossChainApp[Syn, Ack any](app TypedCrossChainApplication[Syn, Ack]) sdk.CrossChainApplication {
+	return typedCrossChainApp[Syn, Ack]{app: app}
+}
+
+func (t typedCrossChainApp[Syn, Ack]) ExecuteSynPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	pack, err := decodePackage[Syn](payload)
+	if err != nil {
+		return sdk.ExecuteResult{Err: err}
+	}
+
+	result := t.app.ExecuteSynPackage(ctx, appCtx, pack)
+	if result.Ack == nil {
+		return sdk.ExecuteResult{Err: result.Err}
+	}
+
+	ackPayload, err := rlp.EncodeToBytes(result.Ack)
+	if err != nil {
+		return sdk.ExecuteResult{Err: sdkerrors.Wrap(ErrEncodePackage, err.Error())}
+	}
+	return sdk.ExecuteResult{Err: result.Err, Payload: ackPayload}
+}
+
+func (t typedCrossChainApp[Syn, Ack]) ExecuteAckPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	pack, err := decodePackage[Ack](payload)
+	if err != nil {
+		return sdk.ExecuteResult{Err: err}
+	}
+	return t.app.ExecuteAckPackage(ctx, appCtx, pack)
+}
+
+func (t typedCrossChainApp[Syn, Ack]) ExecuteFailAckPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	pack, err := decodePackage[Syn](payload)
+	if err != nil {
+		return sdk.ExecuteResult{Err: err}
+	}
+	return t.app.ExecuteFailAckPackage(ctx, appCtx, pack)
+}
+
+func (t typedCrossChainApp[Syn, Ack]) ExecuteTimeoutPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	pack, err := decodePackage[Syn](payload)
+	if err != nil {
+		return sdk.ExecuteResult{Err: err}
+	}
+	return t.app.ExecuteTimeoutPackage(ctx, appCtx, pack)
+}
+
+func decodePackage[T any](payload []byte) (*T, error) {
+	pack := new(T)
+	if err := rlp.DecodeBytes(payload, pack); err != nil {
+		return nil, sdkerrors.Wrap(ErrDecodePackage, err.Error())
+	}
+	return pack, nil
+}
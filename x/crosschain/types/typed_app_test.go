@@ -0,0 +1,93 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crosschain/types"
+)
+
+type testSynPackage struct {
+	Amount   uint64
+	Receiver string
+}
+
+type testAckPackage struct {
+	Status uint32
+}
+
+type testTypedApp struct {
+	synPackages     []*testSynPackage
+	ackPackages     []*testAckPackage
+	failAckPackages []*testSynPackage
+	timeoutPackages []*testSynPackage
+}
+
+func (app *testTypedApp) ExecuteSynPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, pack *testSynPackage) types.TypedExecuteResult[testAckPackage] {
+	app.synPackages = append(app.synPackages, pack)
+	if pack.Amount == 0 {
+		return types.TypedExecuteResult[testAckPackage]{}
+	}
+	return types.TypedExecuteResult[testAckPackage]{Ack: &testAckPackage{Status: 1}}
+}
+
+func (app *testTypedApp) ExecuteAckPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, pack *testAckPackage) sdk.ExecuteResult {
+	app.ackPackages = append(app.ackPackages, pack)
+	return sdk.ExecuteResult{}
+}
+
+func (app *testTypedApp) ExecuteFailAckPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, pack *testSynPackage) sdk.ExecuteResult {
+	app.failAckPackages = append(app.failAckPackages, pack)
+	return sdk.ExecuteResult{}
+}
+
+func (app *testTypedApp) ExecuteTimeoutPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, pack *testSynPackage) sdk.ExecuteResult {
+	app.timeoutPackages = append(app.timeoutPackages, pack)
+	return sdk.ExecuteResult{}
+}
+
+func TestTypedCrossChainApp(t *testing.T) {
+	typedApp := &testTypedApp{}
+	app := types.NewTypedCrossChainApp[testSynPackage, testAckPackage](typedApp)
+
+	synPayload, err := rlp.EncodeToBytes(&testSynPackage{Amount: 10, Receiver: "receiver"})
+	require.NoError(t, err)
+	ackPayload, err := rlp.EncodeToBytes(&testAckPackage{Status: 1})
+	require.NoError(t, err)
+
+	// syn package with ack
+	result := app.ExecuteSynPackage(sdk.Context{}, &sdk.CrossChainAppContext{}, synPayload)
+	require.NoError(t, result.Err)
+	require.Equal(t, ackPayload, result.Payload)
+	require.Equal(t, []*testSynPackage{{Amount: 10, Receiver: "receiver"}}, typedApp.synPackages)
+
+	// syn package without ack
+	emptySynPayload, err := rlp.EncodeToBytes(&testSynPackage{})
+	require.NoError(t, err)
+	result = app.ExecuteSynPackage(sdk.Context{}, &sdk.CrossChainAppContext{}, emptySynPayload)
+	require.NoError(t, result.Err)
+	require.Empty(t, result.Payload)
+
+	// invalid syn package
+	result = app.ExecuteSynPackage(sdk.Context{}, &sdk.CrossChainAppContext{}, []byte("invalid payload"))
+	require.ErrorIs(t, result.Err, types.ErrDecodePackage)
+	require.Len(t, typedApp.synPackages, 2)
+
+	result = app.ExecuteAckPackage(sdk.Context{}, &sdk.CrossChainAppContext{}, ackPayload)
+	require.NoError(t, result.Err)
+	require.Equal(t, []*testAckPackage{{Status: 1}}, typedApp.ackPackages)
+
+	result = app.ExecuteAckPackage(sdk.Context{}, &sdk.CrossChainAppContext{}, []byte("invalid payload"))
+	require.ErrorIs(t, result.Err, types.ErrDecodePackage)
+
+	result = app.ExecuteFailAckPackage(sdk.Context{}, &sdk.CrossChainAppContext{}, synPayload)
+	require.NoError(t, result.Err)
+	require.Len(t, typedApp.failAckPackages, 1)
+
+	result = app.ExecuteTimeoutPackage(sdk.Context{}, &sdk.CrossChainAppContext{}, synPayload)
+	require.NoError(t, result.Err)
+	require.Len(t, typedApp.timeoutPackages, 1)
+}
@@ -0,0 +1,17 @@
+package gashub
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gashub/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gashub/types"
+)
+
+// EndBlocker called every block, adjusts the base fee of the next block by the gas used by the current block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.UpdateBaseFee(ctx)
+}
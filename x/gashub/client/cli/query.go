@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	"github.com/cosmos/cosmos-sdk/x/gashub/types"
 )
 
@@ -22,6 +23,9 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		QueryParamsCmd(),
+		QueryEstimateGasCmd(),
+		QueryUncoveredMsgTypesCmd(),
+		QueryBaseFeeCmd(),
 	)
 
 	return cmd
@@ -57,3 +61,106 @@ $ <appd> query gashub params
 
 	return cmd
 }
+
+// QueryEstimateGasCmd returns the command handler for estimating the gas charged by the gashub module for a tx.
+func QueryEstimateGasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-gas [file]",
+		Short: "Estimate the gas charged by the gashub module for a tx",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(`Estimate the gas charged by the gashub module for a JSON encoded tx, the tx does not need to be signed:
+
+$ <appd> query gashub estimate-gas tx.json
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EstimateGas(cmd.Context(), &types.QueryEstimateGasRequest{TxBytes: txBytes})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryUncoveredMsgTypesCmd returns the command handler for querying the routable msg types without gas params.
+func QueryUncoveredMsgTypesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uncovered-msg-types",
+		Short: "Query the routable msg types which have no gas params",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the type urls of the routable msgs which have no gas params:
+
+$ <appd> query gashub uncovered-msg-types
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.UncoveredMsgTypes(cmd.Context(), &types.QueryUncoveredMsgTypesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryBaseFeeCmd returns the command handler for querying the base fee per gas.
+func QueryBaseFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee",
+		Short: "Query the base fee per gas of the next block",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the base fee per gas of the next block:
+
+$ <appd> query gashub base-fee
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BaseFee(cmd.Context(), &types.QueryBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gashub/types"
+)
+
+// GetBaseFee returns the base fee per gas of the current block
+func (ghk Keeper) GetBaseFee(ctx sdk.Context) sdk.Int {
+	bz := ctx.KVStore(ghk.key).Get(types.BaseFeeKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var baseFee sdk.Int
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return baseFee
+}
+
+// SetBaseFee sets the base fee per gas of the next block
+func (ghk Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Int) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(ghk.key).Set(types.BaseFeeKey, bz)
+}
+
+// UpdateBaseFee adjusts the base fee of the next block by the gas used by the current block
+func (ghk Keeper) UpdateBaseFee(ctx sdk.Context) {
+	params := ghk.GetParams(ctx)
+	if !params.BaseFeeEnabled {
+		return
+	}
+
+	var maxGas int64
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil {
+		maxGas = cp.Block.MaxGas
+	}
+
+	var gasUsed uint64
+	if ctx.BlockGasMeter() != nil {
+		gasUsed = ctx.BlockGasMeter().GasConsumedToLimit()
+	}
+
+	baseFee := ghk.GetBaseFee(ctx)
+	nextBaseFee := types.CalcNextBaseFee(params, baseFee, gasUsed, maxGas)
+	if nextBaseFee.Equal(baseFee) {
+		return
+	}
+
+	ghk.SetBaseFee(ctx, nextBaseFee)
+	ghk.Logger(ctx).Debug("updated base fee", "from", baseFee.String(), "to", nextBaseFee.String(), "gas_used", gasUsed)
+}
@@ -10,6 +10,7 @@ import (
 // InitGenesis - Init store state from genesis data
 func (ghk Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	ghk.SetParams(ctx, data.Params)
+	ghk.SetBaseFee(ctx, data.BaseFee)
 
 	// init gas calculators from genesis data
 	registerAllGasCalculators(data.Params)
@@ -19,7 +20,7 @@ func (ghk Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 func (ghk Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	params := ghk.GetParams(ctx)
 
-	return types.NewGenesisState(params)
+	return types.NewGenesisStateWithBaseFee(params, ghk.GetBaseFee(ctx))
 }
 
 func registerAllGasCalculators(params types.Params) {
@@ -44,6 +45,8 @@ func registerSingleGasCalculator(gasParams *types.MsgGasParams) error {
 		types.RegisterCalculatorGen(msgTypeUrl, types.MsgMultiSendGasCalculatorGen)
 	case *types.MsgGasParams_GrantAllowanceType:
 		types.RegisterCalculatorGen(msgTypeUrl, types.MsgGrantAllowanceGasCalculatorGen)
+	case *types.MsgGasParams_LinearType:
+		types.RegisterCalculatorGen(msgTypeUrl, types.LinearGasCalculatorGen(msgTypeUrl))
 	default:
 		return fmt.Errorf("unknown gas params type")
 	}
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/gashub/types"
 )
 
@@ -22,3 +23,100 @@ func (ghk Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*typ
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// EstimateGas returns the gas charged by the gashub module for a tx, the tx size is padded the same as
+// in the simulate mode of the ante handler
+func (ghk Keeper) EstimateGas(c context.Context, req *types.QueryEstimateGasRequest) (*types.QueryEstimateGasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	txBytes := req.TxBytes
+	if len(txBytes) == 0 {
+		if len(req.Msgs) == 0 {
+			return nil, status.Error(codes.InvalidArgument, "empty tx bytes and msgs")
+		}
+
+		var err error
+		txBytes, err = ghk.cdc.Marshal(&txtypes.Tx{
+			Body:     &txtypes.TxBody{Messages: req.Msgs},
+			AuthInfo: &txtypes.AuthInfo{Fee: &txtypes.Fee{}},
+		})
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var tx txtypes.Tx
+	if err := ghk.cdc.Unmarshal(txBytes, &tx); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx: %s", err)
+	}
+	if tx.Body == nil || len(tx.Body.Messages) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tx has no msgs")
+	}
+	if tx.AuthInfo == nil || tx.AuthInfo.Fee == nil {
+		return nil, status.Error(codes.InvalidArgument, "tx has no auth info or fee")
+	}
+
+	signerInfos := tx.AuthInfo.SignerInfos
+
+	missingSigs, missingPubKeys := 0, 0
+	for i := range tx.GetSigners() {
+		if i < len(signerInfos) {
+			if i >= len(tx.Signatures) || len(tx.Signatures[i]) == 0 {
+				missingSigs++
+			}
+			if signerInfos[i].PublicKey == nil {
+				missingPubKeys++
+			}
+		} else {
+			missingSigs++
+			missingPubKeys++
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := ghk.GetParams(ctx)
+
+	txSize := types.SimulatedTxSize(uint64(len(txBytes)), missingSigs, missingPubKeys)
+	if txSize > params.GetMaxTxSize() {
+		return nil, status.Errorf(codes.InvalidArgument, "tx length: %d, limit: %d", txSize, params.GetMaxTxSize())
+	}
+
+	gas, gasByTxSize, gasByMsgType, err := types.GetTxGas(params, txSize, tx.GetMsgs())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEstimateGasResponse{
+		Gas:          gas,
+		GasByTxSize:  gasByTxSize,
+		GasByMsgType: gasByMsgType,
+		TxSize:       txSize,
+	}, nil
+}
+
+// UncoveredMsgTypes returns the type urls of the routable msgs which have no gas params
+func (ghk Keeper) UncoveredMsgTypes(c context.Context, req *types.QueryUncoveredMsgTypesRequest) (*types.QueryUncoveredMsgTypesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	params := ghk.GetParams(ctx)
+
+	return &types.QueryUncoveredMsgTypesResponse{MsgTypeUrls: ghk.GetUncoveredMsgTypeUrls(params.MsgGasParamsSet)}, nil
+}
+
+// BaseFee returns the base fee per gas of the next block
+func (ghk Keeper) BaseFee(c context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	params := ghk.GetParams(ctx)
+
+	return &types.QueryBaseFeeResponse{
+		BaseFee: sdk.NewCoin(params.BaseFeeDenom, ghk.GetBaseFee(ctx)),
+		Enabled: params.BaseFeeEnabled,
+	}, nil
+}
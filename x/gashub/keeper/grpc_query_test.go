@@ -3,7 +3,20 @@ package keeper_test
 import (
 	gocontext "context"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gashub"
+	"github.com/cosmos/cosmos-sdk/x/gashub/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gashub/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func (suite *IntegrationTestSuite) TestQueryParams() {
@@ -12,3 +25,181 @@ func (suite *IntegrationTestSuite) TestQueryParams() {
 	suite.Require().NotNil(res)
 	suite.Require().Equal(suite.app.GashubKeeper.GetParams(suite.ctx), res.GetParams())
 }
+
+func (suite *IntegrationTestSuite) TestQueryEstimateGas() {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	msg := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	suite.Require().NoError(err)
+
+	// the unsigned tx built from the msgs is padded with a signature, a public key and the fee
+	txBytes, err := suite.app.AppCodec().Marshal(&txtypes.Tx{
+		Body:     &txtypes.TxBody{Messages: []*codectypes.Any{msgAny}},
+		AuthInfo: &txtypes.AuthInfo{Fee: &txtypes.Fee{}},
+	})
+	suite.Require().NoError(err)
+	expectedSize := uint64(len(txBytes)) + types.EthSecp256k1SigSize + types.EthSecp256k1PubkeySize + types.FeeSize
+
+	res, err := suite.queryClient.EstimateGas(gocontext.Background(), &types.QueryEstimateGasRequest{Msgs: []*codectypes.Any{msgAny}})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedSize, res.TxSize)
+	suite.Require().Equal(uint64(0), res.GasByTxSize)
+	suite.Require().Equal(uint64(1.2e3), res.GasByMsgType)
+	suite.Require().Equal(uint64(1.2e3), res.Gas)
+
+	// the same result is returned for the tx bytes
+	txRes, err := suite.queryClient.EstimateGas(gocontext.Background(), &types.QueryEstimateGasRequest{TxBytes: txBytes})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res, txRes)
+
+	// the gas by tx size is charged if it is larger than the gas by msg type
+	params := suite.app.GashubKeeper.GetParams(suite.ctx)
+	params.MaxTxSize = expectedSize
+	suite.app.GashubKeeper.SetParams(suite.ctx, params)
+
+	res, err = suite.queryClient.EstimateGas(gocontext.Background(), &types.QueryEstimateGasRequest{TxBytes: txBytes})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params.MinGasPerByte*expectedSize, res.GasByTxSize)
+	suite.Require().Equal(params.MinGasPerByte*expectedSize, res.Gas)
+
+	// the tx exceeding the max tx size is rejected
+	params.MaxTxSize = expectedSize - 1
+	suite.app.GashubKeeper.SetParams(suite.ctx, params)
+
+	_, err = suite.queryClient.EstimateGas(gocontext.Background(), &types.QueryEstimateGasRequest{TxBytes: txBytes})
+	suite.Require().Error(err)
+
+	// the tx without msgs is rejected
+	_, err = suite.queryClient.EstimateGas(gocontext.Background(), &types.QueryEstimateGasRequest{})
+	suite.Require().Error(err)
+
+	// the tx without auth info or fee is rejected
+	for _, authInfo := range []*txtypes.AuthInfo{nil, {}} {
+		txBytes, err = suite.app.AppCodec().Marshal(&txtypes.Tx{
+			Body:     &txtypes.TxBody{Messages: []*codectypes.Any{msgAny}},
+			AuthInfo: authInfo,
+		})
+		suite.Require().NoError(err)
+
+		_, err = suite.queryClient.EstimateGas(gocontext.Background(), &types.QueryEstimateGasRequest{TxBytes: txBytes})
+		suite.Require().Error(err)
+		suite.Require().Equal(codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQueryUncoveredMsgTypes() {
+	res, err := suite.queryClient.UncoveredMsgTypes(gocontext.Background(), &types.QueryUncoveredMsgTypesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.MsgTypeUrls)
+
+	params := suite.app.GashubKeeper.GetParams(suite.ctx)
+	msgGasParamsSet := make([]*types.MsgGasParams, 0, len(params.MsgGasParamsSet))
+	for _, msgGasParams := range params.MsgGasParamsSet {
+		if msgGasParams.MsgTypeUrl != sdk.MsgTypeURL(&banktypes.MsgSend{}) {
+			msgGasParamsSet = append(msgGasParamsSet, msgGasParams)
+		}
+	}
+	params.MsgGasParamsSet = msgGasParamsSet
+	suite.app.GashubKeeper.SetParams(suite.ctx, params)
+
+	res, err = suite.queryClient.UncoveredMsgTypes(gocontext.Background(), &types.QueryUncoveredMsgTypesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, res.MsgTypeUrls)
+
+	// the genesis leaving any msg without gas params is rejected by the genesis validation
+	appModule := gashub.AppModuleBasic{}
+	suite.Require().NoError(appModule.ValidateGenesis(suite.app.AppCodec(), nil, appModule.DefaultGenesis(suite.app.AppCodec())))
+	bz := suite.app.AppCodec().MustMarshalJSON(types.NewGenesisState(params))
+	suite.Require().Error(appModule.ValidateGenesis(suite.app.AppCodec(), nil, bz))
+
+	// the update leaving any other routable msg without gas params is allowed on an already uncovered chain
+	msgServer := keeper.NewMsgServerImpl(suite.app.GashubKeeper)
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	newParams := types.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), 1e3)
+	_, err = msgServer.UpdateMsgGasParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateMsgGasParams(govAddr, []*types.MsgGasParams{newParams}))
+	suite.Require().NoError(err)
+
+	newParams = types.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&banktypes.MsgSend{}), 1e3)
+	_, err = msgServer.UpdateMsgGasParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateMsgGasParams(govAddr, []*types.MsgGasParams{newParams}))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.app.GashubKeeper.GetUncoveredMsgTypeUrls(suite.app.GashubKeeper.GetParams(suite.ctx).MsgGasParamsSet))
+}
+
+func (suite *IntegrationTestSuite) TestUpdateMsgGasParams() {
+	msgServer := keeper.NewMsgServerImpl(suite.app.GashubKeeper)
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	msgSendTypeUrl := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	suite.app.GashubKeeper.RegisterGasCalculators(suite.ctx)
+
+	// the rejected update leaves both the params and the gas calculators untouched
+	params := suite.app.GashubKeeper.GetParams(suite.ctx)
+	_, err := msgServer.UpdateMsgGasParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateMsgGasParams(govAddr, []*types.MsgGasParams{
+		types.NewMsgGasParamsWithLinearGas(msgSendTypeUrl, 1e3, 0, 0, ""),
+		types.NewMsgGasParamsWithFixedGas(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), 0),
+	}))
+	suite.Require().Error(err)
+	suite.Require().Equal(params, suite.app.GashubKeeper.GetParams(suite.ctx))
+	suite.Require().NotPanics(func() {
+		types.GetGasCalculatorGen(msgSendTypeUrl)(params)
+	})
+
+	// the accepted update switches the gas calculator
+	_, err = msgServer.UpdateMsgGasParams(sdk.WrapSDKContext(suite.ctx), types.NewMsgUpdateMsgGasParams(govAddr, []*types.MsgGasParams{
+		types.NewMsgGasParamsWithLinearGas(msgSendTypeUrl, 1e3, 0, 0, ""),
+	}))
+	suite.Require().NoError(err)
+	params = suite.app.GashubKeeper.GetParams(suite.ctx)
+	gas, err := types.GetGasCalculatorGen(msgSendTypeUrl)(params)(&banktypes.MsgSend{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1e3), gas)
+
+	// the param change leaving any routable msg without gas params is rejected
+	subspace, ok := suite.app.ParamsKeeper.GetSubspace(types.ModuleName)
+	suite.Require().True(ok)
+	msgGasParamsSet := make([]*types.MsgGasParams, 0, len(params.MsgGasParamsSet))
+	for _, msgGasParams := range params.MsgGasParamsSet {
+		if msgGasParams.MsgTypeUrl != msgSendTypeUrl {
+			msgGasParamsSet = append(msgGasParamsSet, msgGasParams)
+		}
+	}
+	bz, err := suite.app.LegacyAmino().MarshalJSON(msgGasParamsSet)
+	suite.Require().NoError(err)
+	suite.Require().Error(subspace.Update(suite.ctx, types.KeyMsgGasParamsSet, bz))
+	suite.Require().Equal(params, suite.app.GashubKeeper.GetParams(suite.ctx))
+
+	// the param change keeping all routable msgs covered is accepted
+	newMsgGasParamsSet := append(msgGasParamsSet, types.NewMsgGasParamsWithFixedGas(msgSendTypeUrl, 2e3))
+	bz, err = suite.app.LegacyAmino().MarshalJSON(newMsgGasParamsSet)
+	suite.Require().NoError(err)
+	suite.Require().NoError(subspace.Update(suite.ctx, types.KeyMsgGasParamsSet, bz))
+	suite.Require().Empty(suite.app.GashubKeeper.GetUncoveredMsgTypeUrls(suite.app.GashubKeeper.GetParams(suite.ctx).MsgGasParamsSet))
+
+	// the param change on an already uncovered chain is accepted as long as no more msgs are left uncovered
+	params.MsgGasParamsSet = msgGasParamsSet
+	suite.app.GashubKeeper.SetParams(suite.ctx, params)
+	bz, err = suite.app.LegacyAmino().MarshalJSON(append(msgGasParamsSet, types.NewMsgGasParamsWithFixedGas("/unknown.MsgUnknown", 1e3)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(subspace.Update(suite.ctx, types.KeyMsgGasParamsSet, bz))
+}
+
+func (suite *IntegrationTestSuite) TestQueryBaseFee() {
+	params := suite.app.GashubKeeper.GetParams(suite.ctx)
+	params.BaseFeeEnabled = true
+	suite.app.GashubKeeper.SetParams(suite.ctx, params)
+	suite.app.GashubKeeper.SetBaseFee(suite.ctx, sdk.NewInt(1000))
+
+	// the base fee goes up after a full block
+	blockGasMeter := sdk.NewGasMeter(1000)
+	blockGasMeter.ConsumeGas(1000, "test")
+	ctx := suite.ctx.
+		WithConsensusParams(&abci.ConsensusParams{Block: &abci.BlockParams{MaxGas: 1000}}).
+		WithBlockGasMeter(blockGasMeter)
+	gashub.EndBlocker(ctx, suite.app.GashubKeeper)
+
+	res, err := suite.queryClient.BaseFee(gocontext.Background(), &types.QueryBaseFeeRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Enabled)
+	suite.Require().Equal(sdk.NewCoin(params.BaseFeeDenom, sdk.NewInt(1125)), res.BaseFee)
+}
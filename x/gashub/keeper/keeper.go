@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,22 +17,29 @@ type Keeper struct {
 	key           storetypes.StoreKey
 	cdc           codec.BinaryCodec
 	paramSubspace paramtypes.Subspace
+	router        *baseapp.MsgServiceRouter
 }
 
 // NewKeeper returns a new gashub keeper
 func NewKeeper(
-	cdc codec.BinaryCodec, key storetypes.StoreKey, paramstore paramtypes.Subspace,
+	cdc codec.BinaryCodec, key storetypes.StoreKey, paramstore paramtypes.Subspace, router *baseapp.MsgServiceRouter,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return Keeper{
-		key:           key,
-		cdc:           cdc,
-		paramSubspace: paramstore,
+	ghk := Keeper{
+		key:    key,
+		cdc:    cdc,
+		router: router,
 	}
+
+	// the param change proposals must not leave any routable msg without gas params either
+	ghk.paramSubspace = paramstore.WithUpdateValidator(types.KeyMsgGasParamsSet, func(_ sdk.Context, oldValue, newValue interface{}) error {
+		return ghk.ValidateMsgGasParamsUpdate(oldValue.([]*types.MsgGasParams), newValue.([]*types.MsgGasParams))
+	})
+	return ghk
 }
 
 // Logger returns a module-specific logger.
@@ -46,3 +54,15 @@ func (ghk Keeper) RegisterGasCalculators(ctx sdk.Context) {
 	params := ghk.GetParams(ctx)
 	registerAllGasCalculators(params)
 }
+
+// GetUncoveredMsgTypeUrls returns the type urls of the msgs registered in the msg service router which have no
+// gas params in the msg gas params set
+func (ghk Keeper) GetUncoveredMsgTypeUrls(msgGasParamsSet []*types.MsgGasParams) []string {
+	return types.GetUncoveredMsgTypeUrls(msgGasParamsSet, ghk.router.MsgTypeURLs())
+}
+
+// ValidateMsgGasParamsUpdate checks that the update of the msg gas params set leaves no msg registered in the msg
+// service router without gas params, unless the msg has no gas params before the update
+func (ghk Keeper) ValidateMsgGasParamsUpdate(oldMsgGasParamsSet, newMsgGasParamsSet []*types.MsgGasParams) error {
+	return types.ValidateMsgGasParamsCoverageUpdate(oldMsgGasParamsSet, newMsgGasParamsSet, ghk.router.MsgTypeURLs())
+}
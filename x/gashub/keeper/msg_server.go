@@ -31,11 +31,15 @@ func (k msgServer) UpdateMsgGasParams(goCtx context.Context, msg *types.MsgUpdat
 	}
 
 	params := k.GetParams(ctx)
-	msgGasParamsSet := params.MsgGasParamsSet
 	newMsgGasParamsSet := msg.NewParamsSet
 	if err := types.ValidateMsgGasParams(newMsgGasParamsSet); err != nil {
 		return nil, err
 	}
+
+	// build the merged msg gas params set before touching the gas calculators
+	msgGasParamsSet := make([]*types.MsgGasParams, len(params.MsgGasParamsSet))
+	copy(msgGasParamsSet, params.MsgGasParamsSet)
+	events := make([]*types.EventUpdateMsgGasParams, 0, len(newMsgGasParamsSet))
 	for _, newParams := range newMsgGasParamsSet {
 		typeUrl := newParams.MsgTypeUrl
 
@@ -48,24 +52,37 @@ func (k msgServer) UpdateMsgGasParams(goCtx context.Context, msg *types.MsgUpdat
 			}
 		}
 		if fromValue == "" {
-			params.MsgGasParamsSet = append(params.MsgGasParamsSet, newParams)
+			msgGasParamsSet = append(msgGasParamsSet, newParams)
 		}
 
-		// register gas calculator
+		events = append(events, &types.EventUpdateMsgGasParams{
+			MsgTypeUrl: newParams.MsgTypeUrl,
+			FromValue:  fromValue,
+			ToValue:    newParams.String(),
+		})
+	}
+	if err := types.ValidateMsgGasParams(msgGasParamsSet); err != nil {
+		return nil, err
+	}
+
+	// reject the update which leaves any routable msg without gas params
+	if err := k.ValidateMsgGasParamsUpdate(params.MsgGasParamsSet, msgGasParamsSet); err != nil {
+		return nil, err
+	}
+
+	// register gas calculators only once the update is accepted, the merged set is already validated
+	for _, newParams := range newMsgGasParamsSet {
 		if err := registerSingleGasCalculator(newParams); err != nil {
 			return nil, err
 		}
-
-		ctx.EventManager().EmitTypedEvent(
-			&types.EventUpdateMsgGasParams{
-				MsgTypeUrl: newParams.MsgTypeUrl,
-				FromValue:  fromValue,
-				ToValue:    newParams.String(),
-			},
-		)
 	}
 
+	params.MsgGasParamsSet = msgGasParamsSet
 	k.SetParams(ctx, params)
 
+	for _, event := range events {
+		ctx.EventManager().EmitTypedEvent(event)
+	}
+
 	return &types.MsgUpdateMsgGasParamsResponse{}, nil
 }
@@ -0,0 +1,26 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gashub/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 3 to 4.
+// The migration includes:
+//
+// - Setting the base fee params (BaseFeeEnabled, BaseFeeDenom, MinBaseFee, BaseFeeChangeDenominator,
+// ElasticityMultiplier, BaseFeeBurnRatio) in the paramstore
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeyBaseFeeEnabled, types.DefaultBaseFeeEnabled)
+	paramstore.Set(ctx, types.KeyBaseFeeDenom, types.DefaultBaseFeeDenom)
+	paramstore.Set(ctx, types.KeyMinBaseFee, types.DefaultMinBaseFee)
+	paramstore.Set(ctx, types.KeyBaseFeeChangeDenominator, types.DefaultBaseFeeChangeDenominator)
+	paramstore.Set(ctx, types.KeyElasticityMultiplier, types.DefaultElasticityMultiplier)
+	paramstore.Set(ctx, types.KeyBaseFeeBurnRatio, types.DefaultBaseFeeBurnRatio)
+
+	return nil
+}
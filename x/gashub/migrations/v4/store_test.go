@@ -0,0 +1,51 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v4 "github.com/cosmos/cosmos-sdk/x/gashub/migrations/v4"
+	"github.com/cosmos/cosmos-sdk/x/gashub/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	gashubKey := sdk.NewKVStoreKey(types.StoreKey)
+	tGashubKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(gashubKey, tGashubKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, gashubKey, tGashubKey, types.ModuleName)
+
+	newParams := [][]byte{
+		types.KeyBaseFeeEnabled,
+		types.KeyBaseFeeDenom,
+		types.KeyMinBaseFee,
+		types.KeyBaseFeeChangeDenominator,
+		types.KeyElasticityMultiplier,
+		types.KeyBaseFeeBurnRatio,
+	}
+
+	// Check no params
+	for _, key := range newParams {
+		require.False(t, paramstore.Has(ctx, key))
+	}
+
+	// Run migrations.
+	err := v4.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	for _, key := range newParams {
+		require.True(t, paramstore.Has(ctx, key))
+	}
+	var denom string
+	paramstore.Get(ctx, types.KeyBaseFeeDenom, &denom)
+	require.Equal(t, types.DefaultBaseFeeDenom, denom)
+	var minBaseFee sdk.Int
+	paramstore.Get(ctx, types.KeyMinBaseFee, &minBaseFee)
+	require.True(t, types.DefaultMinBaseFee.Equal(minBaseFee))
+}
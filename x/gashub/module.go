@@ -26,6 +26,7 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ module.EndBlockAppModule   = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the gashub module.
@@ -53,8 +54,20 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
 		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
 	}
+	if err := types.ValidateGenesis(data); err != nil {
+		return err
+	}
+
+	// there is no msg service router at genesis validation, so the coverage is checked against every msg
+	// implementation known to the codec
+	if protoCdc, ok := cdc.(codec.ProtoCodecMarshaler); ok {
+		msgTypeUrls := protoCdc.InterfaceRegistry().ListImplementations(sdk.MsgInterfaceProtoName)
+		if err := types.ValidateMsgGasParamsCoverage(data.Params.MsgGasParamsSet, msgTypeUrls); err != nil {
+			return fmt.Errorf("invalid %s genesis state: %w", types.ModuleName, err)
+		}
+	}
 
-	return types.ValidateGenesis(data)
+	return nil
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the gashub module.
@@ -122,6 +135,11 @@ func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sd
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/gashub from version 3 to 4: %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the gashub module. It returns
@@ -140,8 +158,15 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// EndBlock returns the end blocker for the gashub module. It returns no validator
+// updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
+	return []abci.ValidatorUpdate{}
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 3 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
 
 // AppModuleSimulation functions
 
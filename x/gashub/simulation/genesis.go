@@ -54,7 +54,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { msgGasParams = GenMsgGasParams(r) },
 	)
 
-	params := types.NewParams(maxTxSize, minGasPerByte, []*types.MsgGasParams{msgGasParams})
+	// the default msg gas params set is kept so that all the routable msgs are covered
+	msgGasParamsSet := append([]*types.MsgGasParams{msgGasParams}, types.DefaultParams().MsgGasParamsSet...)
+	params := types.NewParams(maxTxSize, minGasPerByte, msgGasParamsSet)
 
 	gashubGenesis := types.NewGenesisState(params)
 
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CalcNextBaseFee returns the base fee of the next block following EIP-1559, the base fee goes up if the gas used
+// by the block is above the gas target, which is the block max gas divided by the elasticity multiplier, and goes
+// down if below. The change is bounded by the base fee change denominator and the result is never less than the
+// min base fee. The base fee is kept unchanged if the block max gas is unlimited.
+func CalcNextBaseFee(params Params, baseFee sdk.Int, gasUsed uint64, maxGas int64) sdk.Int {
+	if maxGas <= 0 || params.ElasticityMultiplier == 0 || params.BaseFeeChangeDenominator == 0 {
+		return baseFee
+	}
+
+	gasTarget := uint64(maxGas) / uint64(params.ElasticityMultiplier)
+	if gasTarget == 0 || gasUsed == gasTarget {
+		return sdk.MaxInt(baseFee, params.MinBaseFee)
+	}
+
+	target := sdk.NewIntFromUint64(gasTarget)
+	denominator := sdk.NewInt(int64(params.BaseFeeChangeDenominator))
+
+	var nextBaseFee sdk.Int
+	if gasUsed > gasTarget {
+		delta := baseFee.Mul(sdk.NewIntFromUint64(gasUsed - gasTarget)).Quo(target).Quo(denominator)
+		nextBaseFee = baseFee.Add(sdk.MaxInt(delta, sdk.OneInt()))
+	} else {
+		delta := baseFee.Mul(sdk.NewIntFromUint64(gasTarget - gasUsed)).Quo(target).Quo(denominator)
+		nextBaseFee = baseFee.Sub(delta)
+	}
+
+	return sdk.MaxInt(nextBaseFee, params.MinBaseFee)
+}
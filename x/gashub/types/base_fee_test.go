@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCalcNextBaseFee(t *testing.T) {
+	params := DefaultParams()
+	params.MinBaseFee = sdk.NewInt(100)
+
+	testCases := []struct {
+		name        string
+		baseFee     sdk.Int
+		gasUsed     uint64
+		maxGas      int64
+		nextBaseFee sdk.Int
+	}{
+		{"unlimited max gas", sdk.NewInt(1000), 1000, -1, sdk.NewInt(1000)},
+		{"gas used equal to the target", sdk.NewInt(1000), 500, 1000, sdk.NewInt(1000)},
+		{"full block", sdk.NewInt(1000), 1000, 1000, sdk.NewInt(1125)},
+		{"empty block", sdk.NewInt(1000), 0, 1000, sdk.NewInt(875)},
+		{"gas used slightly above the target", sdk.NewInt(100), 501, 1000, sdk.NewInt(101)},
+		{"base fee bounded by the min base fee", sdk.NewInt(101), 0, 1000, sdk.NewInt(100)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			nextBaseFee := CalcNextBaseFee(params, tc.baseFee, tc.gasUsed, tc.maxGas)
+			require.Equal(t, tc.nextBaseFee.String(), nextBaseFee.String())
+		})
+	}
+}
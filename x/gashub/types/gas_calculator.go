@@ -2,6 +2,9 @@ package types
 
 import (
 	"fmt"
+	"math/bits"
+	"reflect"
+	"strings"
 
 	"cosmossdk.io/errors"
 
@@ -19,7 +22,10 @@ type (
 
 var calculatorsGen = make(map[string]GasCalculatorGenerator)
 
-var ErrInvalidMsgGas = fmt.Errorf("msg gas param is invalid")
+var (
+	ErrInvalidMsgGas  = fmt.Errorf("msg gas param is invalid")
+	ErrMsgGasOverflow = fmt.Errorf("msg gas overflows uint64")
+)
 
 func RegisterCalculatorGen(msgType string, feeCalcGen GasCalculatorGenerator) {
 	calculatorsGen[msgType] = feeCalcGen
@@ -54,6 +60,124 @@ func FixedGasCalculatorGen(msgTypeUrl string) GasCalculatorGenerator {
 	}
 }
 
+func LinearGasCalculator(fixedGas, gasPerItem, gasPerByte uint64, fieldName string) GasCalculator {
+	return func(msg types.Msg) (uint64, error) {
+		if fixedGas == 0 {
+			return 0, errors.Wrapf(ErrInvalidMsgGas, "msg type: %s", types.MsgTypeURL(msg))
+		}
+		if fieldName == "" {
+			return fixedGas, nil
+		}
+
+		items, bytes, err := countMsgField(msg, fieldName)
+		if err != nil {
+			return 0, errors.Wrapf(ErrInvalidMsgGas, "msg type: %s, %s", types.MsgTypeURL(msg), err)
+		}
+
+		totalGas, ok := addMulGas(fixedGas, items, gasPerItem)
+		if ok {
+			totalGas, ok = addMulGas(totalGas, bytes, gasPerByte)
+		}
+		if !ok {
+			return 0, errors.Wrapf(ErrMsgGasOverflow, "msg type: %s, items: %d, bytes: %d", types.MsgTypeURL(msg), items, bytes)
+		}
+		return totalGas, nil
+	}
+}
+
+// addMulGas returns gas + count*gasPerCount, it returns false if the result overflows uint64
+func addMulGas(gas, count, gasPerCount uint64) (uint64, bool) {
+	hi, product := bits.Mul64(count, gasPerCount)
+	if hi != 0 {
+		return 0, false
+	}
+	sum, carry := bits.Add64(gas, product, 0)
+	return sum, carry == 0
+}
+
+func LinearGasCalculatorGen(msgTypeUrl string) GasCalculatorGenerator {
+	return func(params Params) GasCalculator {
+		msgGasParamsSet := params.GetMsgGasParamsSet()
+		for _, gasParams := range msgGasParamsSet {
+			if gasParams.GetMsgTypeUrl() == msgTypeUrl {
+				p := gasParams.GetLinearType()
+				if p == nil {
+					panic(fmt.Errorf("get msg gas params failed for %s", msgTypeUrl))
+				}
+				return LinearGasCalculator(p.FixedGas, p.GasPerItem, p.GasPerByte, p.FieldName)
+			}
+		}
+		panic(fmt.Sprintf("no params for %s", msgTypeUrl))
+	}
+}
+
+// countMsgField returns the number of items and bytes of the msg field with the given proto name.
+// A repeated field counts one item per element, a singular field counts one item if it is not empty.
+func countMsgField(msg types.Msg, fieldName string) (items uint64, bytes uint64, err error) {
+	v := reflect.ValueOf(msg)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return 0, 0, fmt.Errorf("msg is nil")
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return 0, 0, fmt.Errorf("msg is not a struct")
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if protoFieldName(t.Field(i).Tag.Get("protobuf")) != fieldName {
+			continue
+		}
+
+		field := v.Field(i)
+		if field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8 {
+			for j := 0; j < field.Len(); j++ {
+				bytes += fieldSize(field.Index(j))
+			}
+			return uint64(field.Len()), bytes, nil
+		}
+
+		if !field.IsZero() {
+			items = 1
+		}
+		return items, fieldSize(field), nil
+	}
+
+	return 0, 0, fmt.Errorf("field %s not found", fieldName)
+}
+
+// protoFieldName returns the field name in the protobuf struct tag, like bytes,1,opt,name=payload,proto3
+func protoFieldName(tag string) string {
+	for _, part := range strings.Split(tag, ",") {
+		if strings.HasPrefix(part, "name=") {
+			return strings.TrimPrefix(part, "name=")
+		}
+	}
+	return ""
+}
+
+// fieldSize returns the number of bytes of a bytes or string field, or the encoded size of a message field
+func fieldSize(v reflect.Value) uint64 {
+	switch {
+	case v.Kind() == reflect.String:
+		return uint64(v.Len())
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8:
+		return uint64(v.Len())
+	case v.Kind() == reflect.Ptr && v.IsNil():
+		return 0
+	}
+
+	if v.Kind() != reflect.Ptr && v.CanAddr() {
+		v = v.Addr()
+	}
+	if sizer, ok := v.Interface().(interface{ Size() int }); ok {
+		return uint64(sizer.Size())
+	}
+	return 0
+}
+
 func GrantCalculator(fixedGas, gasPerItem uint64) GasCalculator {
 	return func(msg types.Msg) (uint64, error) {
 		if fixedGas == 0 || gasPerItem == 0 {
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	oracletypes "github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+func TestLinearGasCalculator(t *testing.T) {
+	addr := types.AccAddress([]byte("addr1_______________"))
+	msgSend := bank.NewMsgSend(addr, addr, types.NewCoins(types.NewInt64Coin("stake", 1)))
+
+	msgExec := authz.NewMsgExec(addr, []types.Msg{msgSend, msgSend, msgSend})
+	msgSubmitProposal, err := govv1.NewMsgSubmitProposal([]types.Msg{msgSend, msgSend}, nil, addr.String(), "")
+	require.NoError(t, err)
+	msgClaim := &oracletypes.MsgClaim{Payload: make([]byte, 100)}
+
+	testCases := []struct {
+		name        string
+		msg         types.Msg
+		gasParams   *MsgGasParams_LinearGasParams
+		expectedGas uint64
+		expErr      bool
+	}{
+		{
+			"fixed gas only",
+			msgSend,
+			&MsgGasParams_LinearGasParams{FixedGas: 1000},
+			1000,
+			false,
+		},
+		{
+			"gas per inner msg of MsgExec",
+			&msgExec,
+			&MsgGasParams_LinearGasParams{FixedGas: 1000, GasPerItem: 100, FieldName: "msgs"},
+			1300,
+			false,
+		},
+		{
+			"gas per msg of MsgSubmitProposal",
+			msgSubmitProposal,
+			&MsgGasParams_LinearGasParams{FixedGas: 1000, GasPerItem: 100, FieldName: "messages"},
+			1200,
+			false,
+		},
+		{
+			"gas per payload byte of MsgClaim",
+			msgClaim,
+			&MsgGasParams_LinearGasParams{FixedGas: 1000, GasPerItem: 50, GasPerByte: 2, FieldName: "payload"},
+			1250,
+			false,
+		},
+		{
+			"gas per byte of the inner msgs",
+			&msgExec,
+			&MsgGasParams_LinearGasParams{FixedGas: 1000, GasPerByte: 1, FieldName: "msgs"},
+			1000 + uint64(msgExec.Msgs[0].Size()*3),
+			false,
+		},
+		{
+			"unknown field",
+			msgSend,
+			&MsgGasParams_LinearGasParams{FixedGas: 1000, GasPerItem: 100, FieldName: "msgs"},
+			0,
+			true,
+		},
+		{
+			"overflow of the gas per item",
+			&msgExec,
+			&MsgGasParams_LinearGasParams{FixedGas: 1000, GasPerItem: math.MaxUint64 / 2, FieldName: "msgs"},
+			0,
+			true,
+		},
+		{
+			"overflow of the gas per byte",
+			msgClaim,
+			&MsgGasParams_LinearGasParams{FixedGas: math.MaxUint64 - 99, GasPerByte: 1, FieldName: "payload"},
+			0,
+			true,
+		},
+		{
+			"zero fixed gas",
+			msgSend,
+			&MsgGasParams_LinearGasParams{},
+			0,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			calculator := LinearGasCalculator(tc.gasParams.FixedGas, tc.gasParams.GasPerItem, tc.gasParams.GasPerByte, tc.gasParams.FieldName)
+			gas, err := calculator(tc.msg)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedGas, gas)
+		})
+	}
+}
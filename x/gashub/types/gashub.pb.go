@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -31,6 +33,18 @@ type Params struct {
 	MinGasPerByte uint64 `protobuf:"varint,2,opt,name=min_gas_per_byte,json=minGasPerByte,proto3" json:"min_gas_per_byte,omitempty"`
 	// msg_gas_params is the list of gas params for each msg type
 	MsgGasParamsSet []*MsgGasParams `protobuf:"bytes,3,rep,name=msg_gas_params_set,json=msgGasParamsSet,proto3" json:"msg_gas_params_set,omitempty"`
+	// base_fee_enabled defines whether the EIP-1559 style base fee is enforced on txs.
+	BaseFeeEnabled bool `protobuf:"varint,4,opt,name=base_fee_enabled,json=baseFeeEnabled,proto3" json:"base_fee_enabled,omitempty"`
+	// base_fee_denom is the denom in which the base fee is paid.
+	BaseFeeDenom string `protobuf:"bytes,5,opt,name=base_fee_denom,json=baseFeeDenom,proto3" json:"base_fee_denom,omitempty"`
+	// min_base_fee is the lower bound of the base fee per gas.
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_base_fee"`
+	// base_fee_change_denominator bounds the amount the base fee can change between blocks.
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,7,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+	// elasticity_multiplier bounds the maximum gas limit of a block relative to the gas target of the base fee.
+	ElasticityMultiplier uint32 `protobuf:"varint,8,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3" json:"elasticity_multiplier,omitempty"`
+	// base_fee_burn_ratio is the ratio of the base fee burned, the rest is left to the fee collector.
+	BaseFeeBurnRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=base_fee_burn_ratio,json=baseFeeBurnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_burn_ratio"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -86,16 +100,46 @@ func (m *Params) GetMsgGasParamsSet() []*MsgGasParams {
 	return nil
 }
 
+func (m *Params) GetBaseFeeEnabled() bool {
+	if m != nil {
+		return m.BaseFeeEnabled
+	}
+	return false
+}
+
+func (m *Params) GetBaseFeeDenom() string {
+	if m != nil {
+		return m.BaseFeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *Params) GetElasticityMultiplier() uint32 {
+	if m != nil {
+		return m.ElasticityMultiplier
+	}
+	return 0
+}
+
 // MsgGasParams defines gas for a msg type
 type MsgGasParams struct {
 	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
 	// gas_params is the oneof that represents either fixed_gas_params or dynamic_gas_params
 	//
 	// Types that are valid to be assigned to GasParams:
+	//
 	//	*MsgGasParams_FixedType
 	//	*MsgGasParams_GrantType
 	//	*MsgGasParams_MultiSendType
 	//	*MsgGasParams_GrantAllowanceType
+	//	*MsgGasParams_LinearType
 	GasParams isMsgGasParams_GasParams `protobuf_oneof:"gas_params"`
 }
 
@@ -151,11 +195,15 @@ type MsgGasParams_MultiSendType struct {
 type MsgGasParams_GrantAllowanceType struct {
 	GrantAllowanceType *MsgGasParams_DynamicGasParams `protobuf:"bytes,5,opt,name=grant_allowance_type,json=grantAllowanceType,proto3,oneof" json:"grant_allowance_type,omitempty"`
 }
+type MsgGasParams_LinearType struct {
+	LinearType *MsgGasParams_LinearGasParams `protobuf:"bytes,6,opt,name=linear_type,json=linearType,proto3,oneof" json:"linear_type,omitempty"`
+}
 
 func (*MsgGasParams_FixedType) isMsgGasParams_GasParams()          {}
 func (*MsgGasParams_GrantType) isMsgGasParams_GasParams()          {}
 func (*MsgGasParams_MultiSendType) isMsgGasParams_GasParams()      {}
 func (*MsgGasParams_GrantAllowanceType) isMsgGasParams_GasParams() {}
+func (*MsgGasParams_LinearType) isMsgGasParams_GasParams()         {}
 
 func (m *MsgGasParams) GetGasParams() isMsgGasParams_GasParams {
 	if m != nil {
@@ -199,6 +247,13 @@ func (m *MsgGasParams) GetGrantAllowanceType() *MsgGasParams_DynamicGasParams {
 	return nil
 }
 
+func (m *MsgGasParams) GetLinearType() *MsgGasParams_LinearGasParams {
+	if x, ok := m.GetGasParams().(*MsgGasParams_LinearType); ok {
+		return x.LinearType
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MsgGasParams) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -206,6 +261,7 @@ func (*MsgGasParams) XXX_OneofWrappers() []interface{} {
 		(*MsgGasParams_GrantType)(nil),
 		(*MsgGasParams_MultiSendType)(nil),
 		(*MsgGasParams_GrantAllowanceType)(nil),
+		(*MsgGasParams_LinearType)(nil),
 	}
 }
 
@@ -310,11 +366,87 @@ func (m *MsgGasParams_DynamicGasParams) GetGasPerItem() uint64 {
 	return 0
 }
 
+// LinearGasParams defines the parameters for linear gas type, the gas of a msg is
+// fixed_gas + gas_per_item * number of items + gas_per_byte * number of bytes of the named field.
+type MsgGasParams_LinearGasParams struct {
+	// fixed_gas is the base gas cost for a linear type msg
+	FixedGas uint64 `protobuf:"varint,1,opt,name=fixed_gas,json=fixedGas,proto3" json:"fixed_gas,omitempty"`
+	// gas_per_item is the gas cost per item of the named repeated field
+	GasPerItem uint64 `protobuf:"varint,2,opt,name=gas_per_item,json=gasPerItem,proto3" json:"gas_per_item,omitempty"`
+	// gas_per_byte is the gas cost per byte of the named field
+	GasPerByte uint64 `protobuf:"varint,3,opt,name=gas_per_byte,json=gasPerByte,proto3" json:"gas_per_byte,omitempty"`
+	// field_name is the proto name of the msg field the items and bytes are counted over, like payload and msgs.
+	// A repeated field counts one item per element, a singular field counts one item if it is not empty.
+	FieldName string `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+}
+
+func (m *MsgGasParams_LinearGasParams) Reset()         { *m = MsgGasParams_LinearGasParams{} }
+func (m *MsgGasParams_LinearGasParams) String() string { return proto.CompactTextString(m) }
+func (*MsgGasParams_LinearGasParams) ProtoMessage()    {}
+func (*MsgGasParams_LinearGasParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f79bf23b48853a4a, []int{1, 2}
+}
+func (m *MsgGasParams_LinearGasParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGasParams_LinearGasParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGasParams_LinearGasParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGasParams_LinearGasParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGasParams_LinearGasParams.Merge(m, src)
+}
+func (m *MsgGasParams_LinearGasParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGasParams_LinearGasParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGasParams_LinearGasParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGasParams_LinearGasParams proto.InternalMessageInfo
+
+func (m *MsgGasParams_LinearGasParams) GetFixedGas() uint64 {
+	if m != nil {
+		return m.FixedGas
+	}
+	return 0
+}
+
+func (m *MsgGasParams_LinearGasParams) GetGasPerItem() uint64 {
+	if m != nil {
+		return m.GasPerItem
+	}
+	return 0
+}
+
+func (m *MsgGasParams_LinearGasParams) GetGasPerByte() uint64 {
+	if m != nil {
+		return m.GasPerByte
+	}
+	return 0
+}
+
+func (m *MsgGasParams_LinearGasParams) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.gashub.v1alpha1.Params")
 	proto.RegisterType((*MsgGasParams)(nil), "cosmos.gashub.v1alpha1.MsgGasParams")
 	proto.RegisterType((*MsgGasParams_FixedGasParams)(nil), "cosmos.gashub.v1alpha1.MsgGasParams.FixedGasParams")
 	proto.RegisterType((*MsgGasParams_DynamicGasParams)(nil), "cosmos.gashub.v1alpha1.MsgGasParams.DynamicGasParams")
+	proto.RegisterType((*MsgGasParams_LinearGasParams)(nil), "cosmos.gashub.v1alpha1.MsgGasParams.LinearGasParams")
 }
 
 func init() {
@@ -322,40 +454,57 @@ func init() {
 }
 
 var fileDescriptor_f79bf23b48853a4a = []byte{
-	// 521 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0x6f, 0xda, 0x4c,
-	0x10, 0xc6, 0xf1, 0x0b, 0x89, 0x60, 0x80, 0x90, 0x77, 0x1b, 0x55, 0x88, 0x83, 0x1d, 0xa5, 0x3d,
-	0xa4, 0xaa, 0x02, 0x4d, 0xa2, 0x5e, 0xb8, 0xc5, 0xea, 0xdf, 0x03, 0x52, 0x64, 0x68, 0x0f, 0xbd,
-	0x58, 0x0b, 0x59, 0x36, 0xab, 0x7a, 0x6d, 0xe4, 0x5d, 0x5a, 0x93, 0x4f, 0xd0, 0x63, 0x8f, 0x3d,
-	0xe6, 0xe3, 0xf4, 0x98, 0x63, 0x4f, 0x56, 0x65, 0x54, 0xa9, 0x1f, 0xa3, 0xda, 0x5d, 0x9b, 0x42,
-	0xd5, 0x43, 0xaa, 0x5c, 0x60, 0x76, 0xf5, 0xcc, 0x6f, 0x66, 0xc7, 0xcf, 0xc0, 0x83, 0x49, 0x24,
-	0x78, 0x24, 0x7a, 0x14, 0x8b, 0xcb, 0xf9, 0xb8, 0xf7, 0xe1, 0x18, 0x07, 0xb3, 0x4b, 0x7c, 0x9c,
-	0x9f, 0xbb, 0xb3, 0x38, 0x92, 0x11, 0xba, 0x6f, 0x44, 0xdd, 0xfc, 0xb2, 0x10, 0x75, 0xf6, 0x68,
-	0x44, 0x23, 0x2d, 0xe9, 0xa9, 0xc8, 0xa8, 0x0f, 0x7e, 0x58, 0xb0, 0x7d, 0x8e, 0x63, 0xcc, 0x05,
-	0x3a, 0x82, 0x3a, 0xc7, 0x89, 0x2f, 0x13, 0x5f, 0xb0, 0x2b, 0xd2, 0xb6, 0xf6, 0xad, 0xc3, 0x8a,
-	0xdb, 0xcc, 0x52, 0xa7, 0x36, 0xc0, 0xc9, 0x28, 0x19, 0xb2, 0x2b, 0xe2, 0xd5, 0x78, 0x11, 0xa2,
-	0x3e, 0xec, 0x72, 0x16, 0xfa, 0x14, 0x0b, 0x7f, 0x46, 0x62, 0x7f, 0xbc, 0x90, 0xa4, 0xfd, 0x9f,
-	0xce, 0xf9, 0x3f, 0x4b, 0x9d, 0xe6, 0x80, 0x85, 0x2f, 0xb1, 0x38, 0x27, 0xb1, 0xbb, 0x90, 0xc4,
-	0x6b, 0xf2, 0xf5, 0x23, 0x9a, 0x02, 0xe2, 0x82, 0x9a, 0x5c, 0x5d, 0xdc, 0x17, 0x44, 0xb6, 0xcb,
-	0xfb, 0xe5, 0xc3, 0xfa, 0xc9, 0xc3, 0xee, 0xdf, 0x1f, 0xd0, 0x1d, 0x08, 0xaa, 0x10, 0x5a, 0xef,
-	0xde, 0xcb, 0x52, 0xa7, 0xb5, 0x7e, 0x33, 0x24, 0xd2, 0x6b, 0xf1, 0xcd, 0x8b, 0x7e, 0xf5, 0xcb,
-	0xb5, 0x53, 0xfa, 0x79, 0xed, 0x58, 0x07, 0x9f, 0xb6, 0xa0, 0xb1, 0x2e, 0x47, 0x4f, 0xa0, 0xa1,
-	0x5a, 0x90, 0x8b, 0x19, 0xf1, 0xe7, 0x71, 0xa0, 0x9f, 0x5b, 0x73, 0x77, 0xb2, 0xd4, 0x81, 0x81,
-	0xa0, 0xa3, 0xc5, 0x8c, 0xbc, 0x89, 0x03, 0x0f, 0xf8, 0x2a, 0x46, 0x23, 0x80, 0x29, 0x4b, 0xc8,
-	0x85, 0xce, 0xd1, 0x4f, 0xad, 0x9f, 0x9c, 0xde, 0xa6, 0xd9, 0xee, 0x0b, 0x95, 0xb6, 0x3a, 0xbe,
-	0x2a, 0x79, 0x35, 0x0d, 0x52, 0x60, 0xf4, 0x16, 0x80, 0xc6, 0x38, 0x94, 0x86, 0x5a, 0xd6, 0xd4,
-	0xa7, 0xb7, 0xa2, 0x3e, 0x5b, 0x84, 0x98, 0xb3, 0xc9, 0x06, 0x57, 0xa3, 0x34, 0xd7, 0x87, 0x16,
-	0x9f, 0x07, 0x92, 0xf9, 0x82, 0x84, 0x79, 0xcb, 0x95, 0xbb, 0xc1, 0x9b, 0x9a, 0x37, 0x24, 0xa1,
-	0x69, 0x9c, 0xc1, 0x9e, 0x69, 0x1c, 0x07, 0x41, 0xf4, 0x11, 0x87, 0x13, 0x62, 0xaa, 0x6c, 0xdd,
-	0xad, 0x0a, 0xd2, 0xd0, 0xb3, 0x82, 0xa9, 0x4a, 0x75, 0xce, 0x60, 0x67, 0x73, 0x84, 0xe8, 0x11,
-	0x98, 0x11, 0x2a, 0x0b, 0xe5, 0x4e, 0x6d, 0x64, 0xa9, 0x53, 0x2d, 0x64, 0x5e, 0x75, 0x9a, 0x47,
-	0xfd, 0x8a, 0xfa, 0xfe, 0x9d, 0x39, 0xec, 0xfe, 0x59, 0xec, 0x1f, 0x20, 0xca, 0x2d, 0x85, 0xd1,
-	0x99, 0x24, 0x3c, 0x37, 0xba, 0x76, 0x8b, 0xb1, 0xf5, 0x6b, 0x49, 0xb8, 0x07, 0x74, 0x15, 0x9b,
-	0xb2, 0xe6, 0xd7, 0x6d, 0x00, 0xfc, 0xb6, 0xba, 0xfb, 0xfc, 0x6b, 0x66, 0x5b, 0x37, 0x99, 0x6d,
-	0x7d, 0xcf, 0x6c, 0xeb, 0xf3, 0xd2, 0x2e, 0xdd, 0x2c, 0xed, 0xd2, 0xb7, 0xa5, 0x5d, 0x7a, 0xf7,
-	0x98, 0x32, 0xa9, 0x86, 0x35, 0x89, 0x78, 0x2f, 0x5f, 0x75, 0xf3, 0x77, 0x24, 0x2e, 0xde, 0xf7,
-	0x92, 0x62, 0xef, 0xd5, 0x98, 0xc5, 0x78, 0x5b, 0x2f, 0xf0, 0xe9, 0xaf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xce, 0xaf, 0x02, 0x3e, 0x15, 0x04, 0x00, 0x00,
+	// 794 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x8e, 0x1b, 0x45,
+	0x10, 0xf6, 0x60, 0xc7, 0xb1, 0xcb, 0xbf, 0x74, 0x16, 0x34, 0x18, 0xc9, 0x63, 0x85, 0x08, 0x19,
+	0xc1, 0xda, 0x24, 0x0b, 0x97, 0x15, 0x1c, 0x32, 0x38, 0x0b, 0x2b, 0x61, 0x14, 0xcd, 0x2e, 0x20,
+	0x71, 0x60, 0xd4, 0x1e, 0xb7, 0x67, 0x5b, 0x99, 0xee, 0xb1, 0xa6, 0xdb, 0x60, 0xe7, 0x29, 0x38,
+	0x72, 0xcc, 0x43, 0x70, 0xe0, 0x11, 0x72, 0x8c, 0x10, 0x07, 0xc4, 0xc1, 0x42, 0xb3, 0x17, 0x9e,
+	0x80, 0x33, 0xea, 0x1f, 0x7b, 0xbd, 0x2b, 0x84, 0x36, 0x5a, 0xe5, 0x62, 0x77, 0x57, 0x7f, 0xf5,
+	0x7d, 0x55, 0xd3, 0x35, 0xdf, 0xc0, 0x3b, 0x51, 0x2a, 0x58, 0x2a, 0x86, 0x31, 0x16, 0x67, 0x8b,
+	0xc9, 0xf0, 0x87, 0xfb, 0x38, 0x99, 0x9f, 0xe1, 0xfb, 0x76, 0x3f, 0x98, 0x67, 0xa9, 0x4c, 0xd1,
+	0x9b, 0x06, 0x34, 0xb0, 0xc1, 0x0d, 0xa8, 0xb3, 0x17, 0xa7, 0x71, 0xaa, 0x21, 0x43, 0xb5, 0x32,
+	0xe8, 0xce, 0x5b, 0x06, 0x1d, 0x9a, 0x03, 0x9b, 0xaa, 0x37, 0x77, 0xff, 0x29, 0x41, 0xf9, 0x31,
+	0xce, 0x30, 0x13, 0x68, 0x1f, 0x6a, 0x0c, 0x2f, 0x43, 0xb9, 0x0c, 0x05, 0x7d, 0x4a, 0x5c, 0xa7,
+	0xe7, 0xf4, 0x4b, 0x7e, 0x23, 0x5f, 0x7b, 0xd5, 0x31, 0x5e, 0x9e, 0x2e, 0x4f, 0xe8, 0x53, 0x12,
+	0x54, 0xd9, 0x66, 0x89, 0x0e, 0xa1, 0xcd, 0x28, 0x0f, 0x63, 0x2c, 0xc2, 0x39, 0xc9, 0xc2, 0xc9,
+	0x4a, 0x12, 0xf7, 0x35, 0x9d, 0xf3, 0x7a, 0xbe, 0xf6, 0x1a, 0x63, 0xca, 0x3f, 0xc7, 0xe2, 0x31,
+	0xc9, 0xfc, 0x95, 0x24, 0x41, 0x83, 0xed, 0x6e, 0xd1, 0x0c, 0x10, 0x13, 0xb1, 0xc9, 0xd5, 0xe2,
+	0xa1, 0x20, 0xd2, 0x2d, 0xf6, 0x8a, 0xfd, 0xda, 0x83, 0x7b, 0x83, 0xff, 0xee, 0x6d, 0x30, 0x16,
+	0xb1, 0xa2, 0xd0, 0x78, 0xff, 0x4e, 0xbe, 0xf6, 0x5a, 0xbb, 0x91, 0x13, 0x22, 0x83, 0x16, 0xbb,
+	0x1c, 0x40, 0x7d, 0x68, 0x4f, 0xb0, 0x20, 0xe1, 0x8c, 0x90, 0x90, 0x70, 0x3c, 0x49, 0xc8, 0xd4,
+	0x2d, 0xf5, 0x9c, 0x7e, 0x25, 0x68, 0xaa, 0xf8, 0x11, 0x21, 0x8f, 0x4c, 0x14, 0xdd, 0x83, 0xe6,
+	0x16, 0x39, 0x25, 0x3c, 0x65, 0xee, 0xad, 0x9e, 0xd3, 0xaf, 0x06, 0x75, 0x8b, 0x1b, 0xa9, 0x18,
+	0xfa, 0x1e, 0xea, 0xaa, 0xe7, 0x0d, 0xd2, 0x2d, 0x2b, 0x8c, 0xff, 0xc9, 0xf3, 0xb5, 0x57, 0xf8,
+	0x73, 0xed, 0xbd, 0x1b, 0x53, 0xa9, 0x2a, 0x8e, 0x52, 0x66, 0x1f, 0xb2, 0xfd, 0xdb, 0x17, 0xd3,
+	0x27, 0x43, 0xb9, 0x9a, 0x13, 0x31, 0x38, 0xe6, 0xf2, 0xb7, 0x5f, 0xf6, 0xc1, 0xb6, 0x78, 0xcc,
+	0x65, 0x00, 0x8c, 0x72, 0xdf, 0x88, 0xa0, 0x4f, 0xe1, 0xed, 0x6d, 0x15, 0xd1, 0x19, 0xe6, 0xb1,
+	0x2d, 0x86, 0x72, 0x2c, 0xd3, 0xcc, 0xbd, 0xdd, 0x73, 0xfa, 0x8d, 0xc0, 0xb5, 0x25, 0x7d, 0xa6,
+	0x01, 0xa3, 0x8b, 0x73, 0x74, 0x00, 0x6f, 0x90, 0x04, 0x0b, 0x49, 0x23, 0x2a, 0x57, 0x21, 0x5b,
+	0x24, 0x92, 0xce, 0x13, 0x4a, 0x32, 0xb7, 0xa2, 0x13, 0xf7, 0x2e, 0x0e, 0xc7, 0xdb, 0x33, 0xf4,
+	0x04, 0xee, 0x6c, 0x35, 0x27, 0x8b, 0x8c, 0x87, 0x19, 0x96, 0x34, 0x75, 0xab, 0x2f, 0xdd, 0xda,
+	0x88, 0x44, 0x3b, 0xad, 0x8d, 0x48, 0x14, 0xb4, 0x6d, 0xa5, 0xfe, 0x22, 0xe3, 0x81, 0x62, 0x3d,
+	0xac, 0xfc, 0xfc, 0xcc, 0x2b, 0xfc, 0xfd, 0xcc, 0x73, 0xee, 0xfe, 0x7a, 0x1b, 0xea, 0xbb, 0xf7,
+	0x87, 0x3e, 0x84, 0xba, 0x9a, 0x09, 0xc5, 0x15, 0x2e, 0xb2, 0x44, 0xcf, 0x5f, 0xd5, 0x6f, 0xe6,
+	0x6b, 0x0f, 0xc6, 0x22, 0x3e, 0x5d, 0xcd, 0xc9, 0xd7, 0x59, 0x12, 0x00, 0xdb, 0xae, 0xd1, 0x29,
+	0xc0, 0x8c, 0x2e, 0xc9, 0x54, 0xe7, 0xe8, 0xd9, 0xab, 0x3d, 0x38, 0xb8, 0xce, 0xf4, 0x0c, 0x8e,
+	0x54, 0xda, 0x76, 0xfb, 0x45, 0x21, 0xa8, 0x6a, 0x22, 0x45, 0x8c, 0xbe, 0x01, 0x88, 0x33, 0xcc,
+	0xa5, 0x61, 0x2d, 0x6a, 0xd6, 0x8f, 0xaf, 0xc5, 0x3a, 0x5a, 0x71, 0xcc, 0x68, 0x74, 0x89, 0x57,
+	0x53, 0x69, 0xde, 0x10, 0x5a, 0xfa, 0x46, 0x42, 0x41, 0xb8, 0x2d, 0xb9, 0x74, 0x33, 0xf2, 0x86,
+	0xe6, 0x3b, 0x21, 0xdc, 0x14, 0x4e, 0x61, 0xcf, 0x14, 0x8e, 0x93, 0x24, 0xfd, 0x11, 0xf3, 0x88,
+	0x18, 0x95, 0x5b, 0x37, 0x53, 0x41, 0x9a, 0xf4, 0xe1, 0x86, 0x53, 0x4b, 0x7d, 0x0b, 0xb5, 0x84,
+	0x72, 0x82, 0x33, 0xa3, 0x50, 0xd6, 0x0a, 0x1f, 0x5d, 0x4b, 0xe1, 0x4b, 0x9d, 0xb7, 0x2b, 0x00,
+	0x86, 0x4a, 0x11, 0x77, 0x1e, 0x42, 0xf3, 0xf2, 0xdd, 0xa0, 0xf7, 0xc0, 0xdc, 0x8d, 0x32, 0x0b,
+	0xeb, 0x49, 0xf5, 0x7c, 0xed, 0x55, 0x36, 0xb0, 0xa0, 0x32, 0xb3, 0xab, 0xc3, 0x92, 0x1a, 0xac,
+	0xce, 0x02, 0xda, 0x57, 0xbb, 0x78, 0x09, 0x12, 0x35, 0x86, 0x1b, 0x4b, 0xa3, 0x92, 0x30, 0x6b,
+	0x69, 0x7a, 0x0c, 0x8d, 0x81, 0x1d, 0x4b, 0xc2, 0x02, 0x88, 0xb7, 0x6b, 0x2b, 0xfb, 0xbb, 0x03,
+	0xad, 0x2b, 0xbd, 0xbd, 0x52, 0xd9, 0xdd, 0x0c, 0xed, 0xbd, 0xc5, 0xab, 0x19, 0xda, 0x78, 0x6d,
+	0x86, 0x76, 0xdd, 0x0f, 0xd4, 0xfb, 0x42, 0x92, 0x69, 0xc8, 0x31, 0x33, 0xc3, 0x57, 0x35, 0xfe,
+	0x7e, 0xa4, 0xa2, 0x5f, 0x61, 0x46, 0xd4, 0x7b, 0x60, 0x97, 0xa6, 0x2d, 0xf3, 0xeb, 0xd7, 0x01,
+	0x2e, 0xbc, 0xda, 0x7f, 0xf4, 0x3c, 0xef, 0x3a, 0x2f, 0xf2, 0xae, 0xf3, 0x57, 0xde, 0x75, 0x7e,
+	0x3a, 0xef, 0x16, 0x5e, 0x9c, 0x77, 0x0b, 0x7f, 0x9c, 0x77, 0x0b, 0xdf, 0xbd, 0xff, 0xbf, 0x36,
+	0xb1, 0xdc, 0x7c, 0xd3, 0xb4, 0x5f, 0x4c, 0xca, 0xfa, 0x0b, 0x74, 0xf0, 0x6f, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0x0a, 0x95, 0x04, 0xf1, 0xf1, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -391,6 +540,24 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.BaseFeeEnabled != that1.BaseFeeEnabled {
+		return false
+	}
+	if this.BaseFeeDenom != that1.BaseFeeDenom {
+		return false
+	}
+	if !this.MinBaseFee.Equal(that1.MinBaseFee) {
+		return false
+	}
+	if this.BaseFeeChangeDenominator != that1.BaseFeeChangeDenominator {
+		return false
+	}
+	if this.ElasticityMultiplier != that1.ElasticityMultiplier {
+		return false
+	}
+	if !this.BaseFeeBurnRatio.Equal(that1.BaseFeeBurnRatio) {
+		return false
+	}
 	return true
 }
 func (this *MsgGasParams) Equal(that interface{}) bool {
@@ -522,6 +689,30 @@ func (this *MsgGasParams_GrantAllowanceType) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgGasParams_LinearType) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgGasParams_LinearType)
+	if !ok {
+		that2, ok := that.(MsgGasParams_LinearType)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.LinearType.Equal(that1.LinearType) {
+		return false
+	}
+	return true
+}
 func (this *MsgGasParams_FixedGasParams) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -573,6 +764,39 @@ func (this *MsgGasParams_DynamicGasParams) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgGasParams_LinearGasParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgGasParams_LinearGasParams)
+	if !ok {
+		that2, ok := that.(MsgGasParams_LinearGasParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.FixedGas != that1.FixedGas {
+		return false
+	}
+	if this.GasPerItem != that1.GasPerItem {
+		return false
+	}
+	if this.GasPerByte != that1.GasPerByte {
+		return false
+	}
+	if this.FieldName != that1.FieldName {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -593,6 +817,53 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BaseFeeBurnRatio.Size()
+		i -= size
+		if _, err := m.BaseFeeBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGashub(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.ElasticityMultiplier != 0 {
+		i = encodeVarintGashub(dAtA, i, uint64(m.ElasticityMultiplier))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintGashub(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGashub(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BaseFeeDenom) > 0 {
+		i -= len(m.BaseFeeDenom)
+		copy(dAtA[i:], m.BaseFeeDenom)
+		i = encodeVarintGashub(dAtA, i, uint64(len(m.BaseFeeDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BaseFeeEnabled {
+		i--
+		if m.BaseFeeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.MsgGasParamsSet) > 0 {
 		for iNdEx := len(m.MsgGasParamsSet) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -743,6 +1014,27 @@ func (m *MsgGasParams_GrantAllowanceType) MarshalToSizedBuffer(dAtA []byte) (int
 	}
 	return len(dAtA) - i, nil
 }
+func (m *MsgGasParams_LinearType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasParams_LinearType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LinearType != nil {
+		{
+			size, err := m.LinearType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGashub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
 func (m *MsgGasParams_FixedGasParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -804,6 +1096,51 @@ func (m *MsgGasParams_DynamicGasParams) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgGasParams_LinearGasParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGasParams_LinearGasParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasParams_LinearGasParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FieldName) > 0 {
+		i -= len(m.FieldName)
+		copy(dAtA[i:], m.FieldName)
+		i = encodeVarintGashub(dAtA, i, uint64(len(m.FieldName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasPerByte != 0 {
+		i = encodeVarintGashub(dAtA, i, uint64(m.GasPerByte))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasPerItem != 0 {
+		i = encodeVarintGashub(dAtA, i, uint64(m.GasPerItem))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FixedGas != 0 {
+		i = encodeVarintGashub(dAtA, i, uint64(m.FixedGas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGashub(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGashub(v)
 	base := offset
@@ -833,6 +1170,23 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGashub(uint64(l))
 		}
 	}
+	if m.BaseFeeEnabled {
+		n += 2
+	}
+	l = len(m.BaseFeeDenom)
+	if l > 0 {
+		n += 1 + l + sovGashub(uint64(l))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovGashub(uint64(l))
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovGashub(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.ElasticityMultiplier != 0 {
+		n += 1 + sovGashub(uint64(m.ElasticityMultiplier))
+	}
+	l = m.BaseFeeBurnRatio.Size()
+	n += 1 + l + sovGashub(uint64(l))
 	return n
 }
 
@@ -900,6 +1254,18 @@ func (m *MsgGasParams_GrantAllowanceType) Size() (n int) {
 	}
 	return n
 }
+func (m *MsgGasParams_LinearType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LinearType != nil {
+		l = m.LinearType.Size()
+		n += 1 + l + sovGashub(uint64(l))
+	}
+	return n
+}
 func (m *MsgGasParams_FixedGasParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -927,6 +1293,28 @@ func (m *MsgGasParams_DynamicGasParams) Size() (n int) {
 	return n
 }
 
+func (m *MsgGasParams_LinearGasParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FixedGas != 0 {
+		n += 1 + sovGashub(uint64(m.FixedGas))
+	}
+	if m.GasPerItem != 0 {
+		n += 1 + sovGashub(uint64(m.GasPerItem))
+	}
+	if m.GasPerByte != 0 {
+		n += 1 + sovGashub(uint64(m.GasPerByte))
+	}
+	l = len(m.FieldName)
+	if l > 0 {
+		n += 1 + l + sovGashub(uint64(l))
+	}
+	return n
+}
+
 func sovGashub(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1034,20 +1422,178 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGashub(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeEnabled", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGashub
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.BaseFeeEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeDenom", wireType)
 			}
-			iNdEx += skippy
-		}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGashub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGashub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGashub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGashub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			}
+			m.ElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGashub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGashub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGashub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGashub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -1256,6 +1802,41 @@ func (m *MsgGasParams) Unmarshal(dAtA []byte) error {
 			}
 			m.GasParams = &MsgGasParams_GrantAllowanceType{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinearType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGashub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGashub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgGasParams_LinearGasParams{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.GasParams = &MsgGasParams_LinearType{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGashub(dAtA[iNdEx:])
@@ -1434,6 +2015,145 @@ func (m *MsgGasParams_DynamicGasParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgGasParams_LinearGasParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGashub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinearGasParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinearGasParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedGas", wireType)
+			}
+			m.FixedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FixedGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerItem", wireType)
+			}
+			m.GasPerItem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerItem |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerByte", wireType)
+			}
+			m.GasPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGashub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGashub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGashub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGashub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGashub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGashub(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
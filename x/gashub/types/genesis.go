@@ -2,14 +2,22 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// NewGenesisState - Create a new genesis state
+// NewGenesisState - Create a new genesis state, the base fee starts from the min base fee
 func NewGenesisState(params Params) *GenesisState {
+	return NewGenesisStateWithBaseFee(params, params.MinBaseFee)
+}
+
+// NewGenesisStateWithBaseFee - Create a new genesis state with the base fee of the first block
+func NewGenesisStateWithBaseFee(params Params, baseFee sdk.Int) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:  params,
+		BaseFee: baseFee,
 	}
 }
 
@@ -36,5 +44,8 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
+	if data.BaseFee.IsNil() || data.BaseFee.IsNegative() {
+		return fmt.Errorf("invalid base fee: %s", data.BaseFee)
+	}
 	return nil
 }
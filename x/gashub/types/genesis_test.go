@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestGenesisStateValidate(t *testing.T) {
@@ -15,10 +17,19 @@ func TestGenesisStateValidate(t *testing.T) {
 		{
 			"valid genesisState",
 			GenesisState{
-				Params: DefaultParams(),
+				Params:  DefaultParams(),
+				BaseFee: sdk.NewInt(1),
 			},
 			false,
 		},
+		{
+			"invalid base fee",
+			GenesisState{
+				Params:  DefaultParams(),
+				BaseFee: sdk.NewInt(-1),
+			},
+			true,
+		},
 		{"empty genesisState", GenesisState{}, true},
 		{
 			"invalid params ",
@@ -13,3 +13,6 @@ const (
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
 )
+
+// BaseFeeKey is the key of the base fee per gas of the next block
+var BaseFeeKey = []byte{0x01}
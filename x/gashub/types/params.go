@@ -2,10 +2,12 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	"sigs.k8s.io/yaml"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -13,6 +15,16 @@ import (
 const (
 	DefaultMaxTxSize     uint64 = 64 * 1024 // 32kb
 	DefaultMinGasPerByte uint64 = 5
+
+	DefaultBaseFeeEnabled                  = false
+	DefaultBaseFeeChangeDenominator uint32 = 8
+	DefaultElasticityMultiplier     uint32 = 2
+)
+
+var (
+	DefaultBaseFeeDenom     = sdk.DefaultBondDenom
+	DefaultMinBaseFee       = sdk.ZeroInt()
+	DefaultBaseFeeBurnRatio = sdk.ZeroDec()
 )
 
 // Parameter keys
@@ -20,6 +32,13 @@ var (
 	KeyMaxTxSize       = []byte("MaxTxSize")
 	KeyMinGasPerByte   = []byte("MinGasPerByte")
 	KeyMsgGasParamsSet = []byte("MsgGasParamsSet")
+
+	KeyBaseFeeEnabled           = []byte("BaseFeeEnabled")
+	KeyBaseFeeDenom             = []byte("BaseFeeDenom")
+	KeyMinBaseFee               = []byte("MinBaseFee")
+	KeyBaseFeeChangeDenominator = []byte("BaseFeeChangeDenominator")
+	KeyElasticityMultiplier     = []byte("ElasticityMultiplier")
+	KeyBaseFeeBurnRatio         = []byte("BaseFeeBurnRatio")
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -40,14 +59,33 @@ func NewMsgGasParamsWithDynamicGas(msgTypeUrl string, msgGasParams isMsgGasParam
 	}
 }
 
-// NewParams creates a new Params object
+// NewMsgGasParamsWithLinearGas creates a new MsgGasParams object with linear formula gas
+func NewMsgGasParamsWithLinearGas(msgTypeUrl string, fixedGas, gasPerItem, gasPerByte uint64, fieldName string) *MsgGasParams {
+	return &MsgGasParams{
+		MsgTypeUrl: msgTypeUrl,
+		GasParams: &MsgGasParams_LinearType{LinearType: &MsgGasParams_LinearGasParams{
+			FixedGas:   fixedGas,
+			GasPerItem: gasPerItem,
+			GasPerByte: gasPerByte,
+			FieldName:  fieldName,
+		}},
+	}
+}
+
+// NewParams creates a new Params object, the base fee parameters are set to the default values
 func NewParams(
 	maxTxSize, minGasPerByte uint64, msgGasParamsSet []*MsgGasParams,
 ) Params {
 	return Params{
-		MaxTxSize:       maxTxSize,
-		MinGasPerByte:   minGasPerByte,
-		MsgGasParamsSet: msgGasParamsSet,
+		MaxTxSize:                maxTxSize,
+		MinGasPerByte:            minGasPerByte,
+		MsgGasParamsSet:          msgGasParamsSet,
+		BaseFeeEnabled:           DefaultBaseFeeEnabled,
+		BaseFeeDenom:             DefaultBaseFeeDenom,
+		MinBaseFee:               DefaultMinBaseFee,
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenominator,
+		ElasticityMultiplier:     DefaultElasticityMultiplier,
+		BaseFeeBurnRatio:         DefaultBaseFeeBurnRatio,
 	}
 }
 
@@ -63,6 +101,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMaxTxSize, &p.MaxTxSize, validateMaxTxSize),
 		paramtypes.NewParamSetPair(KeyMinGasPerByte, &p.MinGasPerByte, validateMinGasPerByte),
 		paramtypes.NewParamSetPair(KeyMsgGasParamsSet, &p.MsgGasParamsSet, ValidateMsgGasParams),
+		paramtypes.NewParamSetPair(KeyBaseFeeEnabled, &p.BaseFeeEnabled, validateBaseFeeEnabled),
+		paramtypes.NewParamSetPair(KeyBaseFeeDenom, &p.BaseFeeDenom, validateBaseFeeDenom),
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateMinBaseFee),
+		paramtypes.NewParamSetPair(KeyBaseFeeChangeDenominator, &p.BaseFeeChangeDenominator, validateBaseFeeChangeDenominator),
+		paramtypes.NewParamSetPair(KeyElasticityMultiplier, &p.ElasticityMultiplier, validateElasticityMultiplier),
+		paramtypes.NewParamSetPair(KeyBaseFeeBurnRatio, &p.BaseFeeBurnRatio, validateBaseFeeBurnRatio),
 	}
 }
 
@@ -72,15 +116,40 @@ func DefaultParams() Params {
 		NewMsgGasParamsWithFixedGas("/cosmos.authz.v1beta1.MsgExec", 1.2e3),
 		NewMsgGasParamsWithFixedGas("/cosmos.authz.v1beta1.MsgRevoke", 1.2e3),
 		NewMsgGasParamsWithFixedGas("/cosmos.bank.v1beta1.MsgSend", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.crisis.v1beta1.MsgVerifyInvariant", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.distribution.v1beta1.MsgFundCommunityPool", 1.2e3),
 		NewMsgGasParamsWithFixedGas("/cosmos.distribution.v1beta1.MsgSetWithdrawAddress", 1.2e3),
 		NewMsgGasParamsWithFixedGas("/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward", 1.2e3),
 		NewMsgGasParamsWithFixedGas("/cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.evidence.v1beta1.MsgSubmitEvidence", 1.2e3),
 		NewMsgGasParamsWithFixedGas("/cosmos.feegrant.v1beta1.MsgRevokeAllowance", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.gashub.v1alpha1.MsgUpdateMsgGasParams", 1.2e3),
 		NewMsgGasParamsWithFixedGas("/cosmos.gov.v1.MsgDeposit", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.gov.v1.MsgExecLegacyContent", 1.2e3),
 		NewMsgGasParamsWithFixedGas("/cosmos.gov.v1.MsgSubmitProposal", 2e8),
 		NewMsgGasParamsWithFixedGas("/cosmos.gov.v1.MsgVote", 2e7),
 		NewMsgGasParamsWithFixedGas("/cosmos.gov.v1.MsgVoteWeighted", 2e7),
+		NewMsgGasParamsWithFixedGas("/cosmos.gov.v1beta1.MsgDeposit", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.gov.v1beta1.MsgSubmitProposal", 2e8),
+		NewMsgGasParamsWithFixedGas("/cosmos.gov.v1beta1.MsgVote", 2e7),
+		NewMsgGasParamsWithFixedGas("/cosmos.gov.v1beta1.MsgVoteWeighted", 2e7),
+		NewMsgGasParamsWithFixedGas("/cosmos.group.v1.MsgCreateGroup", 2.4e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.group.v1.MsgCreateGroupPolicy", 2.4e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.group.v1.MsgCreateGroupWithPolicy", 2.4e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.group.v1.MsgExec", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.group.v1.MsgLeaveGroup", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.group.v1.MsgSubmitProposal", 2e8),
+		NewMsgGasParamsWithFixedGas("/cosmos.group.v1.MsgUpdateGroupAdmin", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.group.v1.MsgUpdateGroupMembers", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.group.v1.MsgUpdateGroupMetadata", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.group.v1.MsgUpdateGroupPolicyAdmin", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.group.v1.MsgUpdateGroupPolicyDecisionPolicy", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.group.v1.MsgUpdateGroupPolicyMetadata", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.group.v1.MsgVote", 2e7),
+		NewMsgGasParamsWithFixedGas("/cosmos.group.v1.MsgWithdrawProposal", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.nft.v1beta1.MsgSend", 1.2e3),
 		NewMsgGasParamsWithFixedGas("/cosmos.oracle.v1.MsgClaim", 1e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.slashing.v1beta1.MsgImpeach", 1.2e3),
 		NewMsgGasParamsWithFixedGas("/cosmos.slashing.v1beta1.MsgUnjail", 1.2e3),
 		NewMsgGasParamsWithFixedGas("/cosmos.staking.v1beta1.MsgBeginRedelegate", 1.2e3),
 		NewMsgGasParamsWithFixedGas("/cosmos.staking.v1beta1.MsgCancelUnbondingDelegation", 1.2e3),
@@ -88,6 +157,9 @@ func DefaultParams() Params {
 		NewMsgGasParamsWithFixedGas("/cosmos.staking.v1beta1.MsgDelegate", 1.2e3),
 		NewMsgGasParamsWithFixedGas("/cosmos.staking.v1beta1.MsgEditValidator", 2e7),
 		NewMsgGasParamsWithFixedGas("/cosmos.staking.v1beta1.MsgUndelegate", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.vesting.v1beta1.MsgCreatePeriodicVestingAccount", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.vesting.v1beta1.MsgCreatePermanentLockedAccount", 1.2e3),
+		NewMsgGasParamsWithFixedGas("/cosmos.vesting.v1beta1.MsgCreateVestingAccount", 1.2e3),
 		NewMsgGasParamsWithFixedGas("/bnbchain.greenfield.bridge.MsgTransferOut", 1.2e3),
 		NewMsgGasParamsWithFixedGas("/bnbchain.greenfield.sp.MsgCreateStorageProvider", 2e8),
 		NewMsgGasParamsWithFixedGas("/bnbchain.greenfield.sp.MsgDeposit", 1.2e3),
@@ -147,11 +219,7 @@ func DefaultParams() Params {
 			},
 		),
 	}
-	return Params{
-		MaxTxSize:       DefaultMaxTxSize,
-		MinGasPerByte:   DefaultMinGasPerByte,
-		MsgGasParamsSet: defaultMsgGasParamsSet,
-	}
+	return NewParams(DefaultMaxTxSize, DefaultMinGasPerByte, defaultMsgGasParamsSet)
 }
 
 // String implements the stringer interface.
@@ -215,6 +283,13 @@ func ValidateMsgGasParams(i interface{}) error {
 			if p.GrantAllowanceType.FixedGas == 0 || p.GrantAllowanceType.GasPerItem == 0 {
 				return fmt.Errorf("invalid gas. cannot be zero")
 			}
+		case *MsgGasParams_LinearType:
+			if p.LinearType.FixedGas == 0 {
+				return fmt.Errorf("invalid gas. cannot be zero")
+			}
+			if (p.LinearType.GasPerItem != 0 || p.LinearType.GasPerByte != 0) && p.LinearType.FieldName == "" {
+				return fmt.Errorf("invalid field name. cannot be empty when the gas per item or byte is set")
+			}
 		default:
 			return fmt.Errorf("unknown or unspecified gas type")
 		}
@@ -223,6 +298,134 @@ func ValidateMsgGasParams(i interface{}) error {
 	return nil
 }
 
+// validateBaseFeeEnabled performs basic validation of BaseFeeEnabled.
+func validateBaseFeeEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// validateBaseFeeDenom performs basic validation of BaseFeeDenom.
+func validateBaseFeeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := sdk.ValidateDenom(v); err != nil {
+		return fmt.Errorf("invalid base fee denom: %w", err)
+	}
+
+	return nil
+}
+
+// validateMinBaseFee performs basic validation of MinBaseFee.
+func validateMinBaseFee(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("invalid min base fee: %s", v)
+	}
+
+	return nil
+}
+
+// validateBaseFeeChangeDenominator performs basic validation of BaseFeeChangeDenominator.
+func validateBaseFeeChangeDenominator(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid base fee change denominator: %d", v)
+	}
+
+	return nil
+}
+
+// validateElasticityMultiplier performs basic validation of ElasticityMultiplier.
+func validateElasticityMultiplier(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("invalid elasticity multiplier: %d", v)
+	}
+
+	return nil
+}
+
+// validateBaseFeeBurnRatio performs basic validation of BaseFeeBurnRatio.
+func validateBaseFeeBurnRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid base fee burn ratio: %s", v)
+	}
+
+	return nil
+}
+
+// GetUncoveredMsgTypeUrls returns the msg type urls which have no gas params in the msg gas params set.
+func GetUncoveredMsgTypeUrls(msgGasParamsSet []*MsgGasParams, msgTypeUrls []string) []string {
+	covered := make(map[string]bool, len(msgGasParamsSet))
+	for _, msgGasParams := range msgGasParamsSet {
+		covered[msgGasParams.MsgTypeUrl] = true
+	}
+
+	uncovered := make([]string, 0)
+	for _, msgTypeUrl := range msgTypeUrls {
+		if !covered[msgTypeUrl] {
+			uncovered = append(uncovered, msgTypeUrl)
+		}
+	}
+	return uncovered
+}
+
+// ValidateMsgGasParamsCoverage checks that every msg type url has its gas params in the msg gas params set.
+func ValidateMsgGasParamsCoverage(msgGasParamsSet []*MsgGasParams, msgTypeUrls []string) error {
+	uncovered := GetUncoveredMsgTypeUrls(msgGasParamsSet, msgTypeUrls)
+	if len(uncovered) != 0 {
+		return fmt.Errorf("msg gas params not found for msg types: %s", strings.Join(uncovered, ", "))
+	}
+
+	return nil
+}
+
+// ValidateMsgGasParamsCoverageUpdate checks that the update of the msg gas params set leaves no msg type url without
+// gas params which has its gas params before the update. The msg type urls already without gas params are allowed to
+// stay uncovered, so that they can be fixed by partial updates.
+func ValidateMsgGasParamsCoverageUpdate(oldMsgGasParamsSet, newMsgGasParamsSet []*MsgGasParams, msgTypeUrls []string) error {
+	uncoveredBefore := make(map[string]bool)
+	for _, msgTypeUrl := range GetUncoveredMsgTypeUrls(oldMsgGasParamsSet, msgTypeUrls) {
+		uncoveredBefore[msgTypeUrl] = true
+	}
+
+	uncovered := make([]string, 0)
+	for _, msgTypeUrl := range GetUncoveredMsgTypeUrls(newMsgGasParamsSet, msgTypeUrls) {
+		if !uncoveredBefore[msgTypeUrl] {
+			uncovered = append(uncovered, msgTypeUrl)
+		}
+	}
+	if len(uncovered) != 0 {
+		return fmt.Errorf("msg gas params can not be removed for msg types: %s", strings.Join(uncovered, ", "))
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateMaxTxSize(p.MaxTxSize); err != nil {
@@ -234,6 +437,21 @@ func (p Params) Validate() error {
 	if err := ValidateMsgGasParams(p.MsgGasParamsSet); err != nil {
 		return err
 	}
+	if err := validateBaseFeeDenom(p.BaseFeeDenom); err != nil {
+		return err
+	}
+	if err := validateMinBaseFee(p.MinBaseFee); err != nil {
+		return err
+	}
+	if err := validateBaseFeeChangeDenominator(p.BaseFeeChangeDenominator); err != nil {
+		return err
+	}
+	if err := validateElasticityMultiplier(p.ElasticityMultiplier); err != nil {
+		return err
+	}
+	if err := validateBaseFeeBurnRatio(p.BaseFeeBurnRatio); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -112,9 +115,319 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryEstimateGasRequest is the request type for the Query/EstimateGas RPC method.
+type QueryEstimateGasRequest struct {
+	// tx_bytes is the raw bytes of the tx to estimate, the tx does not need to be signed.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// msgs are the msgs to estimate, it is used to build an unsigned tx if tx_bytes is empty.
+	Msgs []*types.Any `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *QueryEstimateGasRequest) Reset()         { *m = QueryEstimateGasRequest{} }
+func (m *QueryEstimateGasRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateGasRequest) ProtoMessage()    {}
+func (*QueryEstimateGasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f928c856cebbb195, []int{2}
+}
+func (m *QueryEstimateGasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateGasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateGasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateGasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateGasRequest.Merge(m, src)
+}
+func (m *QueryEstimateGasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateGasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateGasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateGasRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateGasRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *QueryEstimateGasRequest) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+// QueryEstimateGasResponse is the response type for the Query/EstimateGas RPC method.
+type QueryEstimateGasResponse struct {
+	// gas is the gas charged for the tx, the larger one of gas_by_tx_size and gas_by_msg_type.
+	Gas uint64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty"`
+	// gas_by_tx_size is the gas charged by the size of the tx.
+	GasByTxSize uint64 `protobuf:"varint,2,opt,name=gas_by_tx_size,json=gasByTxSize,proto3" json:"gas_by_tx_size,omitempty"`
+	// gas_by_msg_type is the gas charged by the msg types of the tx.
+	GasByMsgType uint64 `protobuf:"varint,3,opt,name=gas_by_msg_type,json=gasByMsgType,proto3" json:"gas_by_msg_type,omitempty"`
+	// tx_size is the size of the tx used to calculate the gas, including the padding of the missing signatures,
+	// public keys and fee.
+	TxSize uint64 `protobuf:"varint,4,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
+}
+
+func (m *QueryEstimateGasResponse) Reset()         { *m = QueryEstimateGasResponse{} }
+func (m *QueryEstimateGasResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateGasResponse) ProtoMessage()    {}
+func (*QueryEstimateGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f928c856cebbb195, []int{3}
+}
+func (m *QueryEstimateGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateGasResponse.Merge(m, src)
+}
+func (m *QueryEstimateGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateGasResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateGasResponse) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *QueryEstimateGasResponse) GetGasByTxSize() uint64 {
+	if m != nil {
+		return m.GasByTxSize
+	}
+	return 0
+}
+
+func (m *QueryEstimateGasResponse) GetGasByMsgType() uint64 {
+	if m != nil {
+		return m.GasByMsgType
+	}
+	return 0
+}
+
+func (m *QueryEstimateGasResponse) GetTxSize() uint64 {
+	if m != nil {
+		return m.TxSize
+	}
+	return 0
+}
+
+// QueryUncoveredMsgTypesRequest is the request type for the Query/UncoveredMsgTypes RPC method.
+type QueryUncoveredMsgTypesRequest struct {
+}
+
+func (m *QueryUncoveredMsgTypesRequest) Reset()         { *m = QueryUncoveredMsgTypesRequest{} }
+func (m *QueryUncoveredMsgTypesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUncoveredMsgTypesRequest) ProtoMessage()    {}
+func (*QueryUncoveredMsgTypesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f928c856cebbb195, []int{4}
+}
+func (m *QueryUncoveredMsgTypesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUncoveredMsgTypesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUncoveredMsgTypesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUncoveredMsgTypesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUncoveredMsgTypesRequest.Merge(m, src)
+}
+func (m *QueryUncoveredMsgTypesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUncoveredMsgTypesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUncoveredMsgTypesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUncoveredMsgTypesRequest proto.InternalMessageInfo
+
+// QueryUncoveredMsgTypesResponse is the response type for the Query/UncoveredMsgTypes RPC method.
+type QueryUncoveredMsgTypesResponse struct {
+	// msg_type_urls are the type urls of the routable msgs which have no gas params.
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+}
+
+func (m *QueryUncoveredMsgTypesResponse) Reset()         { *m = QueryUncoveredMsgTypesResponse{} }
+func (m *QueryUncoveredMsgTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUncoveredMsgTypesResponse) ProtoMessage()    {}
+func (*QueryUncoveredMsgTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f928c856cebbb195, []int{5}
+}
+func (m *QueryUncoveredMsgTypesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUncoveredMsgTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUncoveredMsgTypesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUncoveredMsgTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUncoveredMsgTypesResponse.Merge(m, src)
+}
+func (m *QueryUncoveredMsgTypesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUncoveredMsgTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUncoveredMsgTypesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUncoveredMsgTypesResponse proto.InternalMessageInfo
+
+func (m *QueryUncoveredMsgTypesResponse) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+// QueryBaseFeeRequest is the request type for the Query/BaseFee RPC method.
+type QueryBaseFeeRequest struct {
+}
+
+func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
+func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f928c856cebbb195, []int{6}
+}
+func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBaseFeeResponse is the response type for the Query/BaseFee RPC method.
+type QueryBaseFeeResponse struct {
+	// base_fee is the base fee per gas of the next block.
+	BaseFee types1.Coin `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3" json:"base_fee"`
+	// enabled defines whether the base fee is enforced on txs.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
+func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f928c856cebbb195, []int{7}
+}
+func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeResponse) GetBaseFee() types1.Coin {
+	if m != nil {
+		return m.BaseFee
+	}
+	return types1.Coin{}
+}
+
+func (m *QueryBaseFeeResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.gashub.v1alpha1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.gashub.v1alpha1.QueryParamsResponse")
+	proto.RegisterType((*QueryEstimateGasRequest)(nil), "cosmos.gashub.v1alpha1.QueryEstimateGasRequest")
+	proto.RegisterType((*QueryEstimateGasResponse)(nil), "cosmos.gashub.v1alpha1.QueryEstimateGasResponse")
+	proto.RegisterType((*QueryUncoveredMsgTypesRequest)(nil), "cosmos.gashub.v1alpha1.QueryUncoveredMsgTypesRequest")
+	proto.RegisterType((*QueryUncoveredMsgTypesResponse)(nil), "cosmos.gashub.v1alpha1.QueryUncoveredMsgTypesResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "cosmos.gashub.v1alpha1.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.gashub.v1alpha1.QueryBaseFeeResponse")
 }
 
 func init() {
@@ -122,25 +435,50 @@ func init() {
 }
 
 var fileDescriptor_f928c856cebbb195 = []byte{
-	// 283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4f, 0x2c, 0xce, 0x28, 0x4d, 0xd2, 0x2f, 0x33, 0x4c, 0xcc, 0x29, 0xc8,
-	0x48, 0x34, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x83, 0xa8, 0xd1, 0x83, 0xa8, 0xd1, 0x83, 0xa9, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x64, 0xd2, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5, 0x13, 0x0b,
-	0x32, 0xf5, 0x13, 0xf3, 0xf2, 0xf2, 0x4b, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x8a, 0xa1, 0xb2, 0xca,
-	0x38, 0xec, 0x83, 0x9a, 0x0d, 0x56, 0xa4, 0x24, 0xc2, 0x25, 0x14, 0x08, 0xb2, 0x3f, 0x20, 0xb1,
-	0x28, 0x31, 0xb7, 0x38, 0x28, 0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x44, 0x29, 0x98, 0x4b, 0x18, 0x45,
-	0xb4, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0x55, 0xc8, 0x86, 0x8b, 0xad, 0x00, 0x2c, 0x22, 0xc1, 0xa8,
-	0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa7, 0x87, 0xdd, 0xb9, 0x7a, 0x10, 0x7d, 0x4e, 0x2c, 0x27, 0xee,
-	0xc9, 0x33, 0x04, 0x41, 0xf5, 0x18, 0x4d, 0x66, 0xe4, 0x62, 0x05, 0x9b, 0x2a, 0xd4, 0xc9, 0xc8,
-	0xc5, 0x06, 0x51, 0x22, 0xa4, 0x85, 0xcb, 0x08, 0x4c, 0x57, 0x49, 0x69, 0x13, 0xa5, 0x16, 0xe2,
-	0x56, 0x25, 0xb5, 0xa6, 0xcb, 0x4f, 0x26, 0x33, 0x29, 0x08, 0xc9, 0xe9, 0xe3, 0x08, 0x06, 0x88,
-	0xab, 0x9c, 0x5c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
-	0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3b, 0x3d,
-	0xb3, 0x04, 0x64, 0x4d, 0x72, 0x7e, 0x2e, 0xcc, 0x0c, 0x08, 0xa5, 0x5b, 0x9c, 0x92, 0xad, 0x5f,
-	0x01, 0x33, 0xb0, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x9c, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xff, 0x7d, 0xea, 0x23, 0xe5, 0x01, 0x00, 0x00,
+	// 686 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0xd2, 0xd2, 0xf2, 0x9b, 0xf2, 0xfb, 0x37, 0x56, 0x29, 0x8d, 0x2e, 0xcd, 0xa2, 0xa6,
+	0x82, 0xec, 0x58, 0x08, 0x1e, 0x88, 0x17, 0xab, 0xe8, 0x89, 0x44, 0x17, 0xb8, 0x78, 0xd9, 0xcc,
+	0xb6, 0xc3, 0xb0, 0xa1, 0xbb, 0xb3, 0x74, 0xa6, 0xa4, 0xcb, 0x91, 0x9b, 0x17, 0xa3, 0xf1, 0x13,
+	0xf8, 0x1d, 0x4c, 0xfc, 0x0a, 0xc4, 0x13, 0x89, 0x17, 0x4f, 0xc6, 0x80, 0x1f, 0xc4, 0xec, 0xfc,
+	0x21, 0x92, 0xba, 0x04, 0x4f, 0x9d, 0x99, 0xf7, 0x79, 0xdf, 0xe7, 0x79, 0xdf, 0xf7, 0xe9, 0x02,
+	0xa7, 0xcb, 0x78, 0xc4, 0x38, 0xa2, 0x98, 0xef, 0x0e, 0x03, 0x74, 0xd0, 0xc6, 0xfd, 0x64, 0x17,
+	0xb7, 0xd1, 0xfe, 0x90, 0x0c, 0x52, 0x37, 0x19, 0x30, 0xc1, 0xe0, 0x0d, 0x85, 0x71, 0x15, 0xc6,
+	0x35, 0x98, 0x46, 0x8d, 0x32, 0xca, 0x24, 0x04, 0x65, 0x27, 0x85, 0x6e, 0xdc, 0xa4, 0x8c, 0xd1,
+	0x3e, 0x41, 0x38, 0x09, 0x11, 0x8e, 0x63, 0x26, 0xb0, 0x08, 0x59, 0xcc, 0x75, 0x74, 0x56, 0x47,
+	0xe5, 0x2d, 0x18, 0xee, 0x20, 0x1c, 0xa7, 0x26, 0xa4, 0x68, 0x7c, 0x55, 0x51, 0x73, 0xaa, 0x90,
+	0xad, 0x55, 0x06, 0x98, 0x13, 0x74, 0xd0, 0x0e, 0x88, 0xc0, 0x6d, 0xd4, 0x65, 0x61, 0xac, 0xe3,
+	0xf3, 0x39, 0x5d, 0x68, 0xc5, 0x12, 0xe4, 0xd4, 0x00, 0x7c, 0x99, 0x75, 0xf5, 0x02, 0x0f, 0x70,
+	0xc4, 0x3d, 0xb2, 0x3f, 0x24, 0x5c, 0x38, 0x9b, 0xe0, 0xda, 0x85, 0x57, 0x9e, 0xb0, 0x98, 0x13,
+	0xf8, 0x08, 0x94, 0x13, 0xf9, 0x52, 0xb7, 0x9a, 0x56, 0xab, 0xba, 0x6c, 0xbb, 0xbf, 0x1f, 0x82,
+	0xab, 0xf2, 0x3a, 0xa5, 0xe3, 0x6f, 0x73, 0x05, 0x4f, 0xe7, 0x38, 0x7b, 0x60, 0x46, 0x16, 0x5d,
+	0xe7, 0x22, 0x8c, 0xb0, 0x20, 0xcf, 0xb1, 0xe1, 0x83, 0xb3, 0x60, 0x4a, 0x8c, 0xfc, 0x20, 0x15,
+	0x44, 0x95, 0x9e, 0xf6, 0x2a, 0x62, 0xd4, 0xc9, 0xae, 0x70, 0x15, 0x94, 0x22, 0x4e, 0x79, 0x7d,
+	0xa2, 0x59, 0x6c, 0x55, 0x97, 0x6b, 0xae, 0x1a, 0x95, 0x6b, 0x46, 0xe5, 0x3e, 0x8e, 0xd3, 0x4e,
+	0xf5, 0xf3, 0xc7, 0xa5, 0x0a, 0xef, 0xed, 0xb9, 0x1b, 0x9c, 0x7a, 0x12, 0xee, 0xbc, 0xb3, 0x40,
+	0x7d, 0x9c, 0x4d, 0xf7, 0xf1, 0x1f, 0x28, 0x52, 0xac, 0x98, 0x4a, 0x5e, 0x76, 0x84, 0xf3, 0xe0,
+	0x1f, 0x8a, 0xb9, 0x1f, 0xa4, 0xbe, 0x18, 0xf9, 0x3c, 0x3c, 0x24, 0xf5, 0x09, 0x19, 0xac, 0x52,
+	0xcc, 0x3b, 0xe9, 0xd6, 0x68, 0x33, 0x3c, 0x24, 0xf0, 0x0e, 0xf8, 0x57, 0x83, 0x22, 0x4e, 0x7d,
+	0x91, 0x26, 0xa4, 0x5e, 0x94, 0xa8, 0x69, 0x89, 0xda, 0xe0, 0x74, 0x2b, 0x4d, 0x08, 0x9c, 0x01,
+	0x15, 0x53, 0xa4, 0x24, 0xc3, 0x65, 0x21, 0xf3, 0x9d, 0x39, 0x70, 0x4b, 0x4a, 0xda, 0x8e, 0xbb,
+	0xec, 0x80, 0x0c, 0x48, 0x4f, 0x67, 0x9c, 0x8f, 0xfd, 0x29, 0xb0, 0xf3, 0x00, 0x5a, 0xb9, 0x03,
+	0xfe, 0x36, 0xdc, 0xfe, 0x70, 0xd0, 0xcf, 0x7a, 0x28, 0xb6, 0xfe, 0xf2, 0xaa, 0x91, 0x02, 0x6e,
+	0x0f, 0xfa, 0xdc, 0xb9, 0xae, 0x97, 0xd7, 0xc1, 0x9c, 0x3c, 0x23, 0xc4, 0x14, 0xef, 0x83, 0xda,
+	0xc5, 0x67, 0x5d, 0x72, 0x0d, 0x4c, 0x65, 0x0e, 0xf2, 0x77, 0x08, 0xd1, 0x6b, 0x9d, 0x35, 0x6b,
+	0xcd, 0xde, 0x5d, 0xed, 0x2c, 0xf7, 0x09, 0x0b, 0x63, 0xbd, 0xd1, 0x4a, 0xa0, 0x6a, 0xc0, 0x3a,
+	0xa8, 0x90, 0x18, 0x07, 0x7d, 0xd2, 0x93, 0xf3, 0x9a, 0xf2, 0xcc, 0x75, 0xf9, 0x68, 0x12, 0x4c,
+	0x4a, 0x3a, 0xf8, 0xda, 0x02, 0x65, 0xe5, 0x07, 0xb8, 0x90, 0xe7, 0x97, 0x71, 0x0b, 0x36, 0x16,
+	0xaf, 0x84, 0x55, 0x3d, 0x38, 0x77, 0x8f, 0xbe, 0xfc, 0x78, 0x3f, 0xd1, 0x84, 0x36, 0xca, 0xf1,
+	0xbc, 0xb2, 0x20, 0xfc, 0x60, 0x81, 0xea, 0x2f, 0x86, 0x80, 0xe8, 0x52, 0x92, 0x71, 0xa3, 0x36,
+	0x1e, 0x5c, 0x3d, 0x41, 0x4b, 0x43, 0x52, 0xda, 0xbd, 0x35, 0x6b, 0xc1, 0xb9, 0x9d, 0xa7, 0x8e,
+	0xe8, 0x3c, 0x3f, 0xb3, 0xe2, 0x27, 0x0b, 0xfc, 0x3f, 0x66, 0x00, 0xb8, 0x7a, 0x29, 0x71, 0x9e,
+	0xa3, 0x1a, 0x0f, 0xff, 0x34, 0x4d, 0xab, 0x5e, 0x91, 0xaa, 0x97, 0xe0, 0x62, 0x9e, 0xe4, 0xa1,
+	0x49, 0x3d, 0xff, 0x2f, 0x70, 0xf8, 0xc6, 0x02, 0x15, 0xed, 0x2e, 0x78, 0xf9, 0xfa, 0x2e, 0x5a,
+	0xb3, 0x71, 0xff, 0x6a, 0x60, 0xad, 0xad, 0x25, 0xb5, 0x39, 0xb0, 0x99, 0xa7, 0xcd, 0xd8, 0xb9,
+	0xb3, 0x7e, 0x7c, 0x6a, 0x5b, 0x27, 0xa7, 0xb6, 0xf5, 0xfd, 0xd4, 0xb6, 0xde, 0x9e, 0xd9, 0x85,
+	0x93, 0x33, 0xbb, 0xf0, 0xf5, 0xcc, 0x2e, 0xbc, 0x5a, 0xa4, 0xa1, 0xc8, 0x98, 0xba, 0x2c, 0x32,
+	0x55, 0xd4, 0xcf, 0x12, 0xef, 0xed, 0xa1, 0x91, 0x29, 0x29, 0xfb, 0x0a, 0xca, 0xf2, 0x63, 0xb3,
+	0xf2, 0x33, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x4d, 0xb9, 0xcd, 0x17, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -157,6 +495,12 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries all parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EstimateGas estimates the gas charged by the gashub module for a tx without simulating it.
+	EstimateGas(ctx context.Context, in *QueryEstimateGasRequest, opts ...grpc.CallOption) (*QueryEstimateGasResponse, error)
+	// UncoveredMsgTypes queries the type urls of the routable msgs which have no gas params.
+	UncoveredMsgTypes(ctx context.Context, in *QueryUncoveredMsgTypesRequest, opts ...grpc.CallOption) (*QueryUncoveredMsgTypesResponse, error)
+	// BaseFee queries the base fee per gas of the next block.
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -176,10 +520,43 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) EstimateGas(ctx context.Context, in *QueryEstimateGasRequest, opts ...grpc.CallOption) (*QueryEstimateGasResponse, error) {
+	out := new(QueryEstimateGasResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gashub.v1alpha1.Query/EstimateGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UncoveredMsgTypes(ctx context.Context, in *QueryUncoveredMsgTypesRequest, opts ...grpc.CallOption) (*QueryUncoveredMsgTypesResponse, error) {
+	out := new(QueryUncoveredMsgTypesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gashub.v1alpha1.Query/UncoveredMsgTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gashub.v1alpha1.Query/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// EstimateGas estimates the gas charged by the gashub module for a tx without simulating it.
+	EstimateGas(context.Context, *QueryEstimateGasRequest) (*QueryEstimateGasResponse, error)
+	// UncoveredMsgTypes queries the type urls of the routable msgs which have no gas params.
+	UncoveredMsgTypes(context.Context, *QueryUncoveredMsgTypesRequest) (*QueryUncoveredMsgTypesResponse, error)
+	// BaseFee queries the base fee per gas of the next block.
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -189,6 +566,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) EstimateGas(ctx context.Context, req *QueryEstimateGasRequest) (*QueryEstimateGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateGas not implemented")
+}
+func (*UnimplementedQueryServer) UncoveredMsgTypes(ctx context.Context, req *QueryUncoveredMsgTypesRequest) (*QueryUncoveredMsgTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncoveredMsgTypes not implemented")
+}
+func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -212,6 +598,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gashub.v1alpha1.Query/EstimateGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateGas(ctx, req.(*QueryEstimateGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UncoveredMsgTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUncoveredMsgTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UncoveredMsgTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gashub.v1alpha1.Query/UncoveredMsgTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UncoveredMsgTypes(ctx, req.(*QueryUncoveredMsgTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gashub.v1alpha1.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gashub.v1alpha1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -220,6 +660,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "EstimateGas",
+			Handler:    _Query_EstimateGas_Handler,
+		},
+		{
+			MethodName: "UncoveredMsgTypes",
+			Handler:    _Query_UncoveredMsgTypes_Handler,
+		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gashub/v1alpha1/query.proto",
@@ -281,24 +733,232 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryEstimateGasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryEstimateGasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateGasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasByMsgType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasByMsgType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasByTxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasByTxSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUncoveredMsgTypesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUncoveredMsgTypesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUncoveredMsgTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUncoveredMsgTypesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUncoveredMsgTypesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUncoveredMsgTypesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.BaseFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryParamsResponse) Size() (n int) {
@@ -312,6 +972,93 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryEstimateGasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	if m.GasByTxSize != 0 {
+		n += 1 + sovQuery(uint64(m.GasByTxSize))
+	}
+	if m.GasByMsgType != 0 {
+		n += 1 + sovQuery(uint64(m.GasByMsgType))
+	}
+	if m.TxSize != 0 {
+		n += 1 + sovQuery(uint64(m.TxSize))
+	}
+	return n
+}
+
+func (m *QueryUncoveredMsgTypesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUncoveredMsgTypesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -451,6 +1198,535 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEstimateGasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateGasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateGasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateGasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateGasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasByTxSize", wireType)
+			}
+			m.GasByTxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasByTxSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasByMsgType", wireType)
+			}
+			m.GasByMsgType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasByMsgType |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSize", wireType)
+			}
+			m.TxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUncoveredMsgTypesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUncoveredMsgTypesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUncoveredMsgTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUncoveredMsgTypesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUncoveredMsgTypesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUncoveredMsgTypesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// Length of the protobuf encoded bytes
+	EthSecp256k1PubkeySize = 79
+	EthSecp256k1SigSize    = 65
+	FeeSize                = 42
+)
+
+// SimulatedTxSize returns the size of a tx in simulate mode, the size of the missing signatures and public keys
+// of the signers and the size of the fee are added to the size of the tx bytes
+func SimulatedTxSize(txSize uint64, missingSigs, missingPubKeys int) uint64 {
+	return txSize + uint64(missingSigs)*EthSecp256k1SigSize + uint64(missingPubKeys)*EthSecp256k1PubkeySize + FeeSize
+}
+
+// GetMsgsGas returns the total gas of the msgs calculated by the registered gas calculators
+func GetMsgsGas(params Params, msgs []types.Msg) (uint64, error) {
+	totalGas := uint64(0)
+	for _, msg := range msgs {
+		feeCalcGen := GetGasCalculatorGen(types.MsgTypeURL(msg))
+		if feeCalcGen == nil {
+			return 0, fmt.Errorf("failed to find fee calculator")
+		}
+		feeCalc := feeCalcGen(params)
+		gas, err := feeCalc(msg)
+		if err != nil {
+			return 0, err
+		}
+		totalGas += gas
+	}
+	return totalGas, nil
+}
+
+// GetTxSizeGas returns the gas of a tx by its size, the txs smaller than half of the max tx size are not charged by size
+func GetTxSizeGas(params Params, txSize uint64) uint64 {
+	if txSize < params.GetMaxTxSize()/2 {
+		return 0
+	}
+	return params.GetMinGasPerByte() * txSize
+}
+
+// GetTxGas returns the gas charged for a tx, which is the larger one of the gas by tx size and the gas by msg types
+func GetTxGas(params Params, txSize uint64, msgs []types.Msg) (gas, gasByTxSize, gasByMsgType uint64, err error) {
+	gasByTxSize = GetTxSizeGas(params, txSize)
+	gasByMsgType, err = GetMsgsGas(params, msgs)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	if gasByTxSize > gasByMsgType {
+		return gasByTxSize, gasByTxSize, gasByMsgType, nil
+	}
+	return gasByMsgType, gasByTxSize, gasByMsgType, nil
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -23,6 +25,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		QueryParamsCmd(),
 		QueryInturnRelayerCmd(),
+		QueryRelayerScheduleCmd(),
+		QueryRelayerStatsCmd(),
+		QueryAllRelayerStatsCmd(),
 	)
 
 	return cmd
@@ -89,3 +94,111 @@ $ <appd> query oracle inturn-relayer
 
 	return cmd
 }
+
+// QueryRelayerScheduleCmd returns the command handler for the in-turn relayer schedule querying.
+func QueryRelayerScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayer-schedule [count]",
+		Short: "Query the in-turn relayers of the current and the upcoming relay intervals",
+		Args:  cobra.MaximumNArgs(1),
+		Long: strings.TrimSpace(`Query the in-turn relayers of the current and the upcoming relay intervals,
+one round of all the validators is returned if the count is not specified:
+
+$ <appd> query oracle relayer-schedule 10
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var count uint64
+			if len(args) > 0 {
+				count, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid count %s: %w", args[0], err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RelayerSchedule(cmd.Context(), &types.QueryRelayerScheduleRequest{Count: count})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryRelayerStatsCmd returns the command handler for the claim statistics of a relayer querying.
+func QueryRelayerStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayer-stats [relayer]",
+		Short: "Query the claim statistics of a relayer",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(`Query the claims submitted, packages relayed, fees earned and last claim time of a relayer:
+
+$ <appd> query oracle relayer-stats [relayer]
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RelayerStats(cmd.Context(), &types.QueryRelayerStatsRequest{Relayer: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.RelayerStats)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryAllRelayerStatsCmd returns the command handler for the claim statistics of all the relayers querying.
+func QueryAllRelayerStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-relayer-stats",
+		Short: "Query the claim statistics of all the relayers",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(fmt.Sprintf(`Query the claims submitted, packages relayed, fees earned and last claim time of all the relayers:
+
+$ <appd> query oracle all-relayer-stats --%s 10
+`, flags.FlagLimit)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AllRelayerStats(cmd.Context(), &types.QueryAllRelayerStatsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "all-relayer-stats")
+
+	return cmd
+}
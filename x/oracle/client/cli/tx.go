@@ -0,0 +1,251 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const (
+	FlagVoteAddressSet = "vote-address-set"
+	FlagAggSignature   = "agg-signature"
+)
+
+// GetTxCmd returns the transaction commands for the oracle module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Oracle transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ClaimCmd(),
+		SignClaimCmd(),
+		AggregateVotesCmd(),
+	)
+
+	return cmd
+}
+
+// ClaimCmd returns the command handler for creating a MsgClaim transaction.
+func ClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim [src-chain-id] [dest-chain-id] [sequence] [timestamp] [payload]",
+		Short: "Claim cross chain packages relayed from the src chain",
+		Args:  cobra.ExactArgs(5),
+		Long: strings.TrimSpace(`Claim cross chain packages relayed from the src chain, the payload is the hex encoded
+rlp packages and the vote address set and aggregated signature can be generated by the aggregate-votes command:
+
+$ <appd> tx oracle claim 714 9000 10 1668063050 <payload> --vote-address-set 7,0,0,0 --agg-signature <signature> --from relayer
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseClaim(clientCtx.GetFromAddress().String(), args)
+			if err != nil {
+				return err
+			}
+
+			voteAddressSetStr, err := cmd.Flags().GetString(FlagVoteAddressSet)
+			if err != nil {
+				return err
+			}
+			for _, word := range strings.Split(voteAddressSetStr, ",") {
+				value, err := strconv.ParseUint(strings.TrimSpace(word), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid vote address set %s: %w", voteAddressSetStr, err)
+				}
+				msg.VoteAddressSet = append(msg.VoteAddressSet, value)
+			}
+
+			aggSignatureStr, err := cmd.Flags().GetString(FlagAggSignature)
+			if err != nil {
+				return err
+			}
+			msg.AggSignature, err = hex.DecodeString(aggSignatureStr)
+			if err != nil {
+				return fmt.Errorf("invalid aggregated signature %s: %w", aggSignatureStr, err)
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagVoteAddressSet, "", "Comma separated words of the bit set of the voted validators")
+	cmd.Flags().String(FlagAggSignature, "", "Hex encoded aggregated bls signature of the voted validators")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagVoteAddressSet)
+	_ = cmd.MarkFlagRequired(FlagAggSignature)
+
+	return cmd
+}
+
+// SignClaimCmd returns the command handler for signing the bls sign bytes of a claim.
+func SignClaimCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-claim [bls-key-name] [src-chain-id] [dest-chain-id] [sequence] [timestamp] [payload]",
+		Short: "Sign a claim with an eth_bls key in the keyring",
+		Args:  cobra.ExactArgs(6),
+		Long: strings.TrimSpace(`Sign the bls sign bytes of a claim with an eth_bls key in the keyring, the vote is printed
+in JSON and can be aggregated with the votes of other validators by the aggregate-votes command:
+
+$ <appd> tx oracle sign-claim bls 714 9000 10 1668063050 <payload> > vote.json
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			record, err := clientCtx.Keyring.Key(args[0])
+			if err != nil {
+				return err
+			}
+			pubKey, err := record.GetPubKey()
+			if err != nil {
+				return err
+			}
+			if pubKey.Type() != bls.KeyType {
+				return fmt.Errorf("key %s is not an %s key", args[0], bls.KeyType)
+			}
+
+			msg, err := parseClaim("", args[1:])
+			if err != nil {
+				return err
+			}
+
+			signBytes := msg.GetBlsSignBytes()
+			signature, _, err := clientCtx.Keyring.Sign(args[0], signBytes[:])
+			if err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, types.BlsVote{
+				PubKey:    hex.EncodeToString(pubKey.Bytes()),
+				Signature: hex.EncodeToString(signature),
+				SignBytes: hex.EncodeToString(signBytes[:]),
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagKeyringDir, "", "The client Keyring directory; if omitted, the default 'home' directory will be used")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+
+	return cmd
+}
+
+// AggregateVotesCmd returns the command handler for aggregating the bls votes of a claim.
+func AggregateVotesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-votes [height] [vote-file]...",
+		Short: "Aggregate the bls votes of a claim against the historical validator set of a height",
+		Args:  cobra.MinimumNArgs(2),
+		Long: strings.TrimSpace(`Aggregate the bls votes generated by the sign-claim command, the vote address set is computed
+against the historical validator set of the height at which the claim is going to be included:
+
+$ <appd> tx oracle aggregate-votes 1000 vote1.json vote2.json vote3.json
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+
+			votes := make([]types.BlsVote, 0, len(args)-1)
+			for _, file := range args[1:] {
+				bz, err := os.ReadFile(file)
+				if err != nil {
+					return err
+				}
+
+				var vote types.BlsVote
+				if err := json.Unmarshal(bz, &vote); err != nil {
+					return fmt.Errorf("invalid vote file %s: %w", file, err)
+				}
+				votes = append(votes, vote)
+			}
+
+			queryClient := stakingtypes.NewQueryClient(clientCtx)
+			res, err := queryClient.HistoricalInfo(cmd.Context(), &stakingtypes.QueryHistoricalInfoRequest{Height: height})
+			if err != nil {
+				return err
+			}
+			if res.Hist == nil {
+				return fmt.Errorf("historical info of height %d not found", height)
+			}
+
+			aggregated, err := types.AggregateBlsVotes(res.Hist.Valset, votes)
+			if err != nil {
+				return err
+			}
+
+			return printJSON(clientCtx, aggregated)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseClaim parses the args of [src-chain-id] [dest-chain-id] [sequence] [timestamp] [payload] into a MsgClaim
+func parseClaim(fromAddress string, args []string) (*types.MsgClaim, error) {
+	srcChainID, err := strconv.ParseUint(args[0], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid src chain id %s: %w", args[0], err)
+	}
+	destChainID, err := strconv.ParseUint(args[1], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dest chain id %s: %w", args[1], err)
+	}
+	sequence, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sequence %s: %w", args[2], err)
+	}
+	timestamp, err := strconv.ParseUint(args[3], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timestamp %s: %w", args[3], err)
+	}
+	payload, err := hex.DecodeString(args[4])
+	if err != nil {
+		return nil, fmt.Errorf("invalid payload: %w", err)
+	}
+
+	return types.NewMsgClaim(fromAddress, uint32(srcChainID), uint32(destChainID), sequence, timestamp, payload, nil, nil), nil
+}
+
+func printJSON(clientCtx client.Context, v interface{}) error {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintBytes(bz)
+}
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/oracle/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -20,3 +22,80 @@ func (k Keeper) InturnRelayer(c context.Context, req *types.QueryInturnRelayerRe
 	_, relayerInterval := k.GetRelayerParams(ctx)
 	return k.GetInturnRelayer(ctx, relayerInterval)
 }
+
+// RelayerSchedule returns the in-turn relayers of the current and the upcoming relay intervals
+func (k Keeper) RelayerSchedule(c context.Context, req *types.QueryRelayerScheduleRequest) (*types.QueryRelayerScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Count > types.MaxRelayerScheduleCount {
+		return nil, status.Errorf(codes.InvalidArgument, "count %d should not be larger than %d", req.Count, types.MaxRelayerScheduleCount)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	count := req.Count
+	if count == 0 {
+		historicalInfo, ok := k.StakingKeeper.GetHistoricalInfo(ctx, ctx.BlockHeight())
+		if !ok {
+			return nil, status.Error(codes.NotFound, "historical validators not found")
+		}
+		count = uint64(len(historicalInfo.Valset))
+	}
+
+	_, relayerInterval := k.GetRelayerParams(ctx)
+	schedule, err := k.GetRelayerSchedule(ctx, relayerInterval, count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRelayerScheduleResponse{Schedule: schedule}, nil
+}
+
+// RelayerStats returns the claim statistics of a relayer
+func (k Keeper) RelayerStats(c context.Context, req *types.QueryRelayerStatsRequest) (*types.QueryRelayerStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	relayer, err := sdk.AccAddressFromHexUnsafe(req.Relayer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid relayer address %s", req.Relayer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	stats, found := k.GetRelayerStats(ctx, relayer)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "relayer stats of %s not found", req.Relayer)
+	}
+
+	return &types.QueryRelayerStatsResponse{RelayerStats: stats}, nil
+}
+
+// AllRelayerStats returns the claim statistics of all the relayers
+func (k Keeper) AllRelayerStats(c context.Context, req *types.QueryAllRelayerStatsRequest) (*types.QueryAllRelayerStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	statsStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RelayerStatsKeyPrefix)
+
+	allStats := make([]types.RelayerStats, 0)
+	pageRes, err := query.Paginate(statsStore, req.Pagination, func(key []byte, value []byte) error {
+		var stats types.RelayerStats
+		if err := k.cdc.Unmarshal(value, &stats); err != nil {
+			return err
+		}
+		allStats = append(allStats, stats)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRelayerStatsResponse{
+		RelayerStats: allStats,
+		Pagination:   pageRes,
+	}, nil
+}
@@ -1,9 +1,16 @@
 package keeper_test
 
 import (
+	"bytes"
 	gocontext "context"
+	"encoding/hex"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func (s *TestSuite) TestQueryParams() {
@@ -12,3 +19,90 @@ func (s *TestSuite) TestQueryParams() {
 	s.Require().NotNil(res)
 	s.Require().Equal(s.app.OracleKeeper.GetParams(s.ctx), res.GetParams())
 }
+
+func (s *TestSuite) TestQueryRelayerStats() {
+	relayers := []sdk.AccAddress{
+		sdk.AccAddress(bytes.Repeat([]byte{1}, 20)),
+		sdk.AccAddress(bytes.Repeat([]byte{2}, 20)),
+		sdk.AccAddress(bytes.Repeat([]byte{3}, 20)),
+	}
+	for idx, relayer := range relayers {
+		s.app.OracleKeeper.SetRelayerStats(s.ctx, types.RelayerStats{
+			Relayer:       relayer.String(),
+			ClaimCount:    uint64(idx + 1),
+			PackageCount:  uint64(idx + 2),
+			FeesEarned:    sdk.NewInt(int64(idx + 3)),
+			LastClaimTime: time.Unix(int64(idx+4), 0).UTC(),
+		})
+	}
+
+	res, err := s.queryClient.RelayerStats(gocontext.Background(), &types.QueryRelayerStatsRequest{Relayer: relayers[1].String()})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), res.RelayerStats.ClaimCount)
+	s.Require().Equal(uint64(3), res.RelayerStats.PackageCount)
+	s.Require().Equal(sdk.NewInt(4), res.RelayerStats.FeesEarned)
+
+	_, err = s.queryClient.RelayerStats(gocontext.Background(), &types.QueryRelayerStatsRequest{Relayer: "invalid"})
+	s.Require().Error(err)
+
+	_, err = s.queryClient.RelayerStats(gocontext.Background(), &types.QueryRelayerStatsRequest{
+		Relayer: sdk.AccAddress(bytes.Repeat([]byte{4}, 20)).String(),
+	})
+	s.Require().Error(err)
+
+	allRes, err := s.queryClient.AllRelayerStats(gocontext.Background(), &types.QueryAllRelayerStatsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(allRes.RelayerStats, 2)
+	s.Require().Equal(uint64(3), allRes.Pagination.Total)
+
+	allRes, err = s.queryClient.AllRelayerStats(gocontext.Background(), &types.QueryAllRelayerStatsRequest{
+		Pagination: &query.PageRequest{Key: allRes.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(allRes.RelayerStats, 1)
+	s.Require().Equal(relayers[2].String(), allRes.RelayerStats[0].Relayer)
+}
+
+func (s *TestSuite) TestQueryRelayerSchedule() {
+	_, _, newValidators, _ := createValidators(s.T(), s.ctx, s.app, []int64{9, 8, 7})
+	s.app.StakingKeeper.SetHistoricalInfo(s.ctx, s.ctx.BlockHeight(), &stakingtypes.HistoricalInfo{
+		Header: s.ctx.BlockHeader(),
+		Valset: newValidators,
+	})
+
+	params := types.DefaultParams()
+	params.RelayerInterval = 600
+	s.app.OracleKeeper.SetParams(s.ctx, params)
+
+	// the current relay interval [1800, 2400) belongs to the first validator
+	ctx := s.ctx.WithBlockTime(time.Unix(1992, 0))
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, s.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, s.app.OracleKeeper)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	inturnRes, err := queryClient.InturnRelayer(gocontext.Background(), &types.QueryInturnRelayerRequest{})
+	s.Require().NoError(err)
+
+	res, err := queryClient.RelayerSchedule(gocontext.Background(), &types.QueryRelayerScheduleRequest{Count: 5})
+	s.Require().NoError(err)
+	s.Require().Len(res.Schedule, 5)
+	s.Require().Equal(inturnRes.BlsPubKey, res.Schedule[0].BlsPubKey)
+	s.Require().Equal(*inturnRes.RelayInterval, res.Schedule[0].RelayInterval)
+	for i, scheduled := range res.Schedule {
+		validator := newValidators[i%len(newValidators)]
+		s.Require().Equal(validator.OperatorAddress, scheduled.ValidatorAddress)
+		s.Require().Equal(validator.RelayerAddress, scheduled.RelayerAddress)
+		s.Require().Equal(hex.EncodeToString(validator.BlsKey), scheduled.BlsPubKey)
+		s.Require().Equal(uint64(1800+600*i), scheduled.RelayInterval.Start)
+		s.Require().Equal(uint64(2400+600*i), scheduled.RelayInterval.End)
+	}
+
+	res, err = queryClient.RelayerSchedule(gocontext.Background(), &types.QueryRelayerScheduleRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Schedule, len(newValidators))
+
+	_, err = queryClient.RelayerSchedule(gocontext.Background(), &types.QueryRelayerScheduleRequest{Count: types.MaxRelayerScheduleCount + 1})
+	s.Require().Error(err)
+}
@@ -26,6 +26,8 @@ type Keeper struct {
 	StakingKeeper    types.StakingKeeper
 	CrossChainKeeper types.CrossChainKeeper
 	BankKeeper       types.BankKeeper
+	SlashingKeeper   types.SlashingKeeper
+	DistrKeeper      types.DistributionKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -33,6 +35,7 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace, feeCollector string,
 	crossChainKeeper types.CrossChainKeeper, bankKeeper types.BankKeeper, stakingKeeper types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper, distrKeeper types.DistributionKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -48,6 +51,8 @@ func NewKeeper(
 		CrossChainKeeper: crossChainKeeper,
 		BankKeeper:       bankKeeper,
 		StakingKeeper:    stakingKeeper,
+		SlashingKeeper:   slashingKeeper,
+		DistrKeeper:      distrKeeper,
 	}
 }
 
@@ -60,6 +65,19 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) {
 	k.Logger(ctx).Info("set oracle genesis state", "params", state.Params.String())
 	k.SetParams(ctx, state.Params)
+
+	for _, stats := range state.RelayerStats {
+		k.SetRelayerStats(ctx, stats)
+	}
+
+	for _, interval := range state.MissedInturnIntervals {
+		k.SetMissedInturnInterval(ctx, interval)
+	}
+}
+
+// ExportGenesis returns the genesis state of oracle module
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetAllRelayerStats(ctx), k.GetAllMissedInturnIntervals(ctx))
 }
 
 // SetParams sets the params of oarcle module
@@ -83,6 +101,13 @@ func (k Keeper) GetRelayerRewardShare(ctx sdk.Context) uint32 {
 	return relayerRewardShare
 }
 
+// GetQuorumType returns how the quorum of the bls votes of the claims is counted
+func (k Keeper) GetQuorumType(ctx sdk.Context) types.QuorumType {
+	var quorumType types.QuorumType
+	k.paramSpace.Get(ctx, types.KeyParamQuorumType, &quorumType)
+	return quorumType
+}
+
 // IsRelayerValid returns true if the relayer is valid and allowed to send the claim message
 func (k Keeper) IsRelayerValid(ctx sdk.Context, relayer sdk.AccAddress, validators []stakingtypes.Validator, claimTimestamp uint64) (bool, error) {
 	var validatorIndex int64 = -1
@@ -123,6 +148,20 @@ func (k Keeper) IsRelayerValid(ctx sdk.Context, relayer sdk.AccAddress, validato
 
 // CheckClaim checks the bls signature
 func (k Keeper) CheckClaim(ctx sdk.Context, claim *types.MsgClaim) (sdk.AccAddress, []sdk.AccAddress, error) {
+	relayer, signedValidators, err := k.checkClaim(ctx, claim)
+	if err != nil {
+		return sdk.AccAddress{}, nil, err
+	}
+
+	signedRelayers := make([]sdk.AccAddress, 0, len(signedValidators))
+	for _, val := range signedValidators {
+		signedRelayers = append(signedRelayers, sdk.MustAccAddressFromHex(val.RelayerAddress))
+	}
+	return relayer, signedRelayers, nil
+}
+
+// checkClaim checks the bls signature and returns the relayer and the validators signed the claim
+func (k Keeper) checkClaim(ctx sdk.Context, claim *types.MsgClaim) (sdk.AccAddress, []stakingtypes.Validator, error) {
 	relayer, err := sdk.AccAddressFromHexUnsafe(claim.FromAddress)
 	if err != nil {
 		return sdk.AccAddress{}, nil, sdkerrors.Wrapf(types.ErrInvalidAddress, "from address (%s) is invalid", claim.FromAddress)
@@ -148,14 +187,20 @@ func (k Keeper) CheckClaim(ctx sdk.Context, claim *types.MsgClaim) (sdk.AccAddre
 		return sdk.AccAddress{}, nil, sdkerrors.Wrapf(types.ErrValidatorSet, "number of validator set is larger than validators")
 	}
 
-	signedRelayers := make([]sdk.AccAddress, 0, validatorsBitSet.Count())
+	powerReduction := k.StakingKeeper.PowerReduction(ctx)
+	var totalPower, votedPower int64
+
+	signedValidators := make([]stakingtypes.Validator, 0, validatorsBitSet.Count())
 	votedPubKeys := make([]bls.PublicKey, 0, validatorsBitSet.Count())
 	for index, val := range validators {
+		power := val.GetConsensusPower(powerReduction)
+		totalPower += power
 		if !validatorsBitSet.Test(uint(index)) {
 			continue
 		}
+		votedPower += power
 
-		signedRelayers = append(signedRelayers, sdk.MustAccAddressFromHex(val.RelayerAddress))
+		signedValidators = append(signedValidators, val)
 
 		votePubKey, err := bls.PublicKeyFromBytes(val.BlsKey)
 		if err != nil {
@@ -164,9 +209,19 @@ func (k Keeper) CheckClaim(ctx sdk.Context, claim *types.MsgClaim) (sdk.AccAddre
 		votedPubKeys = append(votedPubKeys, votePubKey)
 	}
 
-	// The valid voted validators should be no less than 2/3 validators.
-	if len(votedPubKeys) <= len(validators)*2/3 {
-		return sdk.AccAddress{}, nil, sdkerrors.Wrapf(types.ErrBlsVotesNotEnough, "not enough validators voted, need: %d, voted: %d", len(validators)*2/3, len(votedPubKeys))
+	switch quorumType := k.GetQuorumType(ctx); quorumType {
+	case types.QuorumValidatorCount:
+		// The valid voted validators should be no less than 2/3 validators.
+		if len(votedPubKeys) <= len(validators)*2/3 {
+			return sdk.AccAddress{}, nil, sdkerrors.Wrapf(types.ErrBlsVotesNotEnough, "not enough validators voted, need: %d, voted: %d", len(validators)*2/3, len(votedPubKeys))
+		}
+	case types.QuorumVotingPower:
+		// The voting power of the voted validators should be more than 2/3 of the total voting power.
+		if votedPower*3 <= totalPower*2 {
+			return sdk.AccAddress{}, nil, sdkerrors.Wrapf(types.ErrBlsVotesNotEnough, "not enough voting power voted, need more than 2/3 of: %d, voted: %d", totalPower, votedPower)
+		}
+	default:
+		return sdk.AccAddress{}, nil, sdkerrors.Wrapf(types.ErrBlsVotesNotEnough, "unknown quorum type %s", quorumType)
 	}
 
 	// Verify the aggregated signature.
@@ -179,7 +234,7 @@ func (k Keeper) CheckClaim(ctx sdk.Context, claim *types.MsgClaim) (sdk.AccAddre
 		return sdk.AccAddress{}, nil, sdkerrors.Wrapf(types.ErrInvalidBlsSignature, "signature verify failed")
 	}
 
-	return relayer, signedRelayers, nil
+	return relayer, signedValidators, nil
 }
 
 // GetParams returns the current params
@@ -189,32 +244,77 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 }
 
 func (k Keeper) getInturnRelayer(ctx sdk.Context, relayerInterval uint64) ([]byte, *types.RelayInterval, error) {
+	inturnValidator, interval, err := k.getInturnValidator(ctx, relayerInterval)
+	if err != nil {
+		return nil, nil, err
+	}
+	return inturnValidator.BlsKey, interval, nil
+}
+
+// getInturnValidator returns the validator whose relayer is in turn and the relay interval of it
+func (k Keeper) getInturnValidator(ctx sdk.Context, relayerInterval uint64) (stakingtypes.Validator, *types.RelayInterval, error) {
 	historicalInfo, ok := k.StakingKeeper.GetHistoricalInfo(ctx, ctx.BlockHeight())
 	if !ok {
-		return nil, nil, sdkerrors.Wrapf(types.ErrValidatorSet, "get historical validators failed")
+		return stakingtypes.Validator{}, nil, sdkerrors.Wrapf(types.ErrValidatorSet, "get historical validators failed")
 	}
 	validators := historicalInfo.Valset
 
-	validatorsSize := len(validators)
+	inTurnRelayerIndex, start := getInturnRelayerIndex(uint64(ctx.BlockTime().Unix()), relayerInterval, len(validators))
+	end := start + relayerInterval
+
+	inturnValidator := validators[inTurnRelayerIndex]
 
+	return inturnValidator, &types.RelayInterval{
+		Start: start,
+		End:   end,
+	}, nil
+}
+
+// getInturnRelayerIndex returns the index of the in-turn relayer in the validator set and the start time of its
+// relay interval at the given timestamp
+func getInturnRelayerIndex(curTimeStamp uint64, relayerInterval uint64, validatorsSize int) (uint64, uint64) {
 	// totalIntervals is sum of intervals from all relayers
 	totalIntervals := relayerInterval * uint64(validatorsSize)
 
-	curTimeStamp := uint64(ctx.BlockTime().Unix())
-
 	// remainder is used to locate inturn relayer.
 	remainder := curTimeStamp % totalIntervals
 	inTurnRelayerIndex := remainder / relayerInterval
 
 	start := curTimeStamp - (remainder - inTurnRelayerIndex*relayerInterval)
-	end := start + relayerInterval
+	return inTurnRelayerIndex, start
+}
 
-	inturnRelayer := validators[inTurnRelayerIndex]
+// GetRelayerSchedule returns the in-turn relayers of the current and the following relay intervals, the number
+// of the intervals is specified by count
+func (k Keeper) GetRelayerSchedule(ctx sdk.Context, relayerInterval uint64, count uint64) ([]types.ScheduledRelayer, error) {
+	historicalInfo, ok := k.StakingKeeper.GetHistoricalInfo(ctx, ctx.BlockHeight())
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrValidatorSet, "get historical validators failed")
+	}
+	validators := historicalInfo.Valset
+	if len(validators) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrValidatorSet, "historical validator set is empty")
+	}
 
-	return inturnRelayer.BlsKey, &types.RelayInterval{
-		Start: start,
-		End:   end,
-	}, nil
+	index, start := getInturnRelayerIndex(uint64(ctx.BlockTime().Unix()), relayerInterval, len(validators))
+
+	schedule := make([]types.ScheduledRelayer, 0, count)
+	for i := uint64(0); i < count; i++ {
+		validator := validators[index]
+		schedule = append(schedule, types.ScheduledRelayer{
+			ValidatorAddress: validator.OperatorAddress,
+			RelayerAddress:   validator.RelayerAddress,
+			BlsPubKey:        hex.EncodeToString(validator.BlsKey),
+			RelayInterval: types.RelayInterval{
+				Start: start,
+				End:   start + relayerInterval,
+			},
+		})
+
+		index = (index + 1) % uint64(len(validators))
+		start += relayerInterval
+	}
+	return schedule, nil
 }
 
 func (k Keeper) GetInturnRelayer(ctx sdk.Context, relayerInterval uint64) (*types.QueryInturnRelayerResponse, error) {
@@ -51,12 +51,13 @@ func (s *TestSuite) SetupTest() {
 	s.ctx = ctx
 
 	s.app.CrossChainKeeper.SetSrcChainID(sdk.ChainID(1))
-	s.app.CrossChainKeeper.SetDestChainID(sdk.ChainID(56))
+	err := s.app.CrossChainKeeper.RegisterDestChain(sdk.ChainID(56))
+	s.NoError(err)
 
 	s.app.OracleKeeper.SetParams(s.ctx, types.DefaultParams())
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100000)))
-	err := s.app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins)
+	err = s.app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins)
 	s.NoError(err)
 	err = app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, crosschaintypes.ModuleName, coins)
 	s.NoError(err)
@@ -75,9 +76,11 @@ func TestTestSuite(t *testing.T) {
 
 func (s *TestSuite) TestProcessClaim() {
 	s.app.OracleKeeper.SetParams(s.ctx, types.Params{
-		RelayerTimeout:     5,
-		RelayerRewardShare: 50,
-		RelayerInterval:    600,
+		RelayerTimeout:       5,
+		RelayerRewardShare:   50,
+		RelayerInterval:      600,
+		RelayerMissedWindow:  types.DefaultRelayerMissedWindow,
+		RelayerSlashFraction: types.DefaultRelayerSlashFraction,
 	})
 
 	_, _, newValidators, blsKeys := createValidators(s.T(), s.ctx, s.app, []int64{9, 8, 7})
@@ -115,8 +118,13 @@ func (s *TestSuite) TestProcessClaim() {
 	msgClaim.AggSignature = blsSig
 
 	s.ctx = s.ctx.WithBlockTime(time.Unix(int64(msgClaim.Timestamp), 0))
-	_, _, err := s.app.OracleKeeper.CheckClaim(s.ctx, &msgClaim)
+	relayer, signedRelayers, err := s.app.OracleKeeper.CheckClaim(s.ctx, &msgClaim)
 	s.Require().Nil(err, "error should be nil")
+	s.Require().Equal(newValidators[0].RelayerAddress, relayer.String())
+	s.Require().Len(signedRelayers, len(newValidators))
+	for idx, signedRelayer := range signedRelayers {
+		s.Require().Equal(newValidators[idx].RelayerAddress, signedRelayer.String())
+	}
 
 	// wrong validator set
 	wrongValBitSet := bitset.New(256)
@@ -151,9 +159,11 @@ func (s *TestSuite) TestProcessClaim() {
 
 func (s *TestSuite) TestKeeper_IsRelayerValid() {
 	s.app.OracleKeeper.SetParams(s.ctx, types.Params{
-		RelayerTimeout:     5,
-		RelayerRewardShare: 50,
-		RelayerInterval:    600,
+		RelayerTimeout:       5,
+		RelayerRewardShare:   50,
+		RelayerInterval:      600,
+		RelayerMissedWindow:  types.DefaultRelayerMissedWindow,
+		RelayerSlashFraction: types.DefaultRelayerSlashFraction,
 	})
 
 	vals := make([]stakingtypes.Validator, 5)
@@ -260,6 +270,31 @@ func (s *TestSuite) TestKeeper_IsRelayerValid() {
 	}
 }
 
+func (s *TestSuite) TestExportGenesis() {
+	relayer := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	s.app.OracleKeeper.SetRelayerStats(s.ctx, types.RelayerStats{
+		Relayer:       relayer.String(),
+		ClaimCount:    2,
+		PackageCount:  5,
+		FeesEarned:    sdk.NewInt(100),
+		LastClaimTime: time.Unix(1992, 0).UTC(),
+	})
+
+	genesis := s.app.OracleKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(types.ValidateGenesis(*genesis))
+	s.Require().Equal(s.app.OracleKeeper.GetParams(s.ctx), genesis.Params)
+	s.Require().Len(genesis.RelayerStats, 1)
+
+	app := simapp.Setup(s.T(), false, true)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.OracleKeeper.InitGenesis(ctx, genesis)
+
+	stats, found := app.OracleKeeper.GetRelayerStats(ctx, relayer)
+	s.Require().True(found)
+	s.Require().Equal(genesis.RelayerStats[0], stats)
+	s.Require().Equal(genesis, app.OracleKeeper.ExportGenesis(ctx))
+}
+
 // Creates a new validators and asserts the error check.
 func newValidator(t *testing.T, operator sdk.AccAddress, pubKey cryptotypes.PubKey) stakingtypes.Validator {
 	v, err := stakingtypes.NewSimpleValidator(operator, pubKey, stakingtypes.Description{})
@@ -12,8 +12,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/bsc/rlp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type msgServer struct {
@@ -45,12 +48,17 @@ func (k msgServer) Claim(goCtx context.Context, req *types.MsgClaim) (*types.Msg
 		return nil, sdkerrors.Wrapf(types.ErrInvalidSrcChainId, "src chain id(%d) is not supported", req.SrcChainId)
 	}
 
-	sequence := k.CrossChainKeeper.GetReceiveSequence(ctx, types.RelayPackagesChannelId)
+	sequence := k.CrossChainKeeper.GetReceiveSequence(ctx, sdk.ChainID(req.SrcChainId), types.RelayPackagesChannelId)
 	if sequence != req.Sequence {
 		return nil, sdkerrors.Wrapf(types.ErrInvalidReceiveSequence, "current sequence of channel %d is %d", types.RelayPackagesChannelId, sequence)
 	}
 
-	relayer, signedRelayers, err := k.CheckClaim(ctx, req)
+	relayer, signedValidators, err := k.checkClaim(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.handleInturnRelayerLiveness(ctx, relayer)
 	if err != nil {
 		return nil, err
 	}
@@ -78,15 +86,17 @@ func (k msgServer) Claim(goCtx context.Context, req *types.MsgClaim) (*types.Msg
 		totalRelayerFee = totalRelayerFee.Add(relayerFee)
 
 		// increase channel sequence
-		k.CrossChainKeeper.IncrReceiveSequence(ctx, pack.ChannelId)
+		k.CrossChainKeeper.IncrReceiveSequence(ctx, sdk.ChainID(req.SrcChainId), pack.ChannelId)
 	}
 
-	err = k.distributeReward(ctx, relayer, signedRelayers, totalRelayerFee)
+	err = k.distributeReward(ctx, relayer, signedValidators, totalRelayerFee)
 	if err != nil {
 		return nil, err
 	}
 
-	k.CrossChainKeeper.IncrReceiveSequence(ctx, types.RelayPackagesChannelId)
+	k.recordClaim(ctx, relayer, len(packages))
+
+	k.CrossChainKeeper.IncrReceiveSequence(ctx, sdk.ChainID(req.SrcChainId), types.RelayPackagesChannelId)
 
 	err = ctx.EventManager().EmitTypedEvents(events...)
 	if err != nil {
@@ -96,37 +106,48 @@ func (k msgServer) Claim(goCtx context.Context, req *types.MsgClaim) (*types.Msg
 	return &types.MsgClaimResponse{}, nil
 }
 
-// distributeReward will distribute reward to relayers
-func (k Keeper) distributeReward(ctx sdk.Context, relayer sdk.AccAddress, signedRelayers []sdk.AccAddress, relayerFee sdkmath.Int) error {
+// distributeReward will distribute reward to relayers, the treasury cut is taken first, then the submitter of the
+// claim gets its share, and the other relayers signed the bls message split the rest.
+func (k Keeper) distributeReward(ctx sdk.Context, relayer sdk.AccAddress, signedValidators []stakingtypes.Validator, relayerFee sdkmath.Int) error {
 	if !relayerFee.IsPositive() {
 		k.Logger(ctx).Info("total relayer fee is zero")
 		return nil
 	}
 
-	otherRelayers := make([]sdk.AccAddress, 0, len(signedRelayers))
-	for _, signedRelayer := range signedRelayers {
-		if !signedRelayer.Equals(relayer) {
-			otherRelayers = append(otherRelayers, relayer)
+	params := k.GetParams(ctx)
+	bondDenom := k.StakingKeeper.BondDenom(ctx)
+
+	treasuryReward := relayerFee.Mul(sdkmath.NewInt(int64(params.TreasuryShare))).Quo(sdkmath.NewInt(100))
+	if treasuryReward.IsPositive() {
+		err := k.sendTreasuryReward(ctx, params.TreasuryDestination, sdk.Coins{sdk.Coin{Denom: bondDenom, Amount: treasuryReward}})
+		if err != nil {
+			return err
 		}
 	}
+	relayerFee = relayerFee.Sub(treasuryReward)
 
-	totalDistributed, otherRelayerReward := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	otherValidators := make([]stakingtypes.Validator, 0, len(signedValidators))
+	for _, signedValidator := range signedValidators {
+		if signedValidator.RelayerAddress != relayer.String() {
+			otherValidators = append(otherValidators, signedValidator)
+		}
+	}
 
-	relayerRewardShare := k.GetRelayerRewardShare(ctx)
+	totalDistributed := sdkmath.ZeroInt()
 
-	// calculate the reward to distribute to each other relayer
-	if len(otherRelayers) > 0 {
-		otherRelayerReward = relayerFee.Mul(sdkmath.NewInt(100 - int64(relayerRewardShare))).Quo(sdkmath.NewInt(100)).Quo(sdkmath.NewInt(int64(len(otherRelayers))))
-	}
+	// calculate the reward to distribute to the other relayers
+	if len(otherValidators) > 0 {
+		otherRelayersReward := relayerFee.Mul(sdkmath.NewInt(100 - int64(params.RelayerRewardShare))).Quo(sdkmath.NewInt(100))
+		otherRelayerRewards := k.splitSignerReward(ctx, params.RewardSplitType, otherValidators, otherRelayersReward)
 
-	bondDenom := k.StakingKeeper.BondDenom(ctx)
-	if otherRelayerReward.IsPositive() {
-		for _, signedRelayer := range otherRelayers {
-			err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx,
-				crosschaintypes.ModuleName,
-				signedRelayer,
-				sdk.Coins{sdk.Coin{Denom: bondDenom, Amount: otherRelayerReward}},
-			)
+		for idx, otherValidator := range otherValidators {
+			otherRelayerReward := otherRelayerRewards[idx]
+			if !otherRelayerReward.IsPositive() {
+				continue
+			}
+
+			err := k.sendRelayerReward(ctx, sdk.MustAccAddressFromHex(otherValidator.RelayerAddress),
+				types.RewardRecipientSigner, sdk.Coins{sdk.Coin{Denom: bondDenom, Amount: otherRelayerReward}})
 			if err != nil {
 				return err
 			}
@@ -136,11 +157,7 @@ func (k Keeper) distributeReward(ctx sdk.Context, relayer sdk.AccAddress, signed
 
 	remainingReward := relayerFee.Sub(totalDistributed)
 	if remainingReward.IsPositive() {
-		err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx,
-			crosschaintypes.ModuleName,
-			relayer,
-			sdk.Coins{sdk.Coin{Denom: bondDenom, Amount: remainingReward}},
-		)
+		err := k.sendRelayerReward(ctx, relayer, types.RewardRecipientRelayer, sdk.Coins{sdk.Coin{Denom: bondDenom, Amount: remainingReward}})
 		if err != nil {
 			return err
 		}
@@ -149,6 +166,84 @@ func (k Keeper) distributeReward(ctx sdk.Context, relayer sdk.AccAddress, signed
 	return nil
 }
 
+// splitSignerReward splits the reward among the validators signed the bls message, the rewards are returned in the
+// same order as the validators
+func (k Keeper) splitSignerReward(ctx sdk.Context, splitType types.RewardSplitType, validators []stakingtypes.Validator, reward sdkmath.Int) []sdkmath.Int {
+	rewards := make([]sdkmath.Int, len(validators))
+
+	if splitType == types.RewardSplitStakeWeighted {
+		powerReduction := k.StakingKeeper.PowerReduction(ctx)
+
+		powers := make([]int64, len(validators))
+		totalPower := sdkmath.ZeroInt()
+		for idx, validator := range validators {
+			powers[idx] = validator.GetConsensusPower(powerReduction)
+			totalPower = totalPower.Add(sdkmath.NewInt(powers[idx]))
+		}
+
+		// fall back to the even split if none of the validators has voting power
+		if totalPower.IsPositive() {
+			for idx := range validators {
+				rewards[idx] = reward.Mul(sdkmath.NewInt(powers[idx])).Quo(totalPower)
+			}
+			return rewards
+		}
+	}
+
+	evenReward := reward.Quo(sdkmath.NewInt(int64(len(validators))))
+	for idx := range validators {
+		rewards[idx] = evenReward
+	}
+	return rewards
+}
+
+// sendRelayerReward sends the reward to a relayer and records the fees earned by it
+func (k Keeper) sendRelayerReward(ctx sdk.Context, recipient sdk.AccAddress, recipientType string, reward sdk.Coins) error {
+	err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, crosschaintypes.ModuleName, recipient, reward)
+	if err != nil {
+		return err
+	}
+
+	for _, coin := range reward {
+		k.recordFeesEarned(ctx, recipient, coin.Amount)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventDistributeReward{
+		Recipient:     recipient.String(),
+		RecipientType: recipientType,
+		Amount:        reward.String(),
+	})
+}
+
+// sendTreasuryReward sends the treasury cut of the relayer fees to the fee collector or the community pool
+func (k Keeper) sendTreasuryReward(ctx sdk.Context, destination types.TreasuryDestination, reward sdk.Coins) error {
+	var recipient sdk.AccAddress
+	var recipientType string
+
+	switch destination {
+	case types.TreasuryFeeCollector:
+		err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, crosschaintypes.ModuleName, k.feeCollectorName, reward)
+		if err != nil {
+			return err
+		}
+		recipient, recipientType = authtypes.NewModuleAddress(k.feeCollectorName), types.RewardRecipientFeeCollector
+	case types.TreasuryCommunityPool:
+		err := k.DistrKeeper.FundCommunityPool(ctx, reward, authtypes.NewModuleAddress(crosschaintypes.ModuleName))
+		if err != nil {
+			return err
+		}
+		recipient, recipientType = authtypes.NewModuleAddress(distrtypes.ModuleName), types.RewardRecipientCommunityPool
+	default:
+		return sdkerrors.Wrapf(types.ErrInvalidTreasuryDestination, "treasury destination %s is invalid", destination)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventDistributeReward{
+		Recipient:     recipient.String(),
+		RecipientType: recipientType,
+		Amount:        reward.String(),
+	})
+}
+
 func (k Keeper) handlePackage(
 	ctx sdk.Context,
 	pack *types.Package,
@@ -158,12 +253,12 @@ func (k Keeper) handlePackage(
 ) (sdkmath.Int, *types.EventPackageClaim, error) {
 	logger := k.Logger(ctx)
 
-	crossChainApp := k.CrossChainKeeper.GetCrossChainApp(pack.ChannelId)
+	crossChainApp := k.CrossChainKeeper.GetCrossChainApp(sdk.ChainID(srcChainId), pack.ChannelId)
 	if crossChainApp == nil {
 		return sdkmath.ZeroInt(), nil, sdkerrors.Wrapf(types.ErrChannelNotRegistered, "channel %d not registered", pack.ChannelId)
 	}
 
-	sequence := k.CrossChainKeeper.GetReceiveSequence(ctx, pack.ChannelId)
+	sequence := k.CrossChainKeeper.GetReceiveSequence(ctx, sdk.ChainID(srcChainId), pack.ChannelId)
 	if sequence != pack.Sequence {
 		return sdkmath.ZeroInt(), nil, sdkerrors.Wrapf(types.ErrInvalidReceiveSequence,
 			"current sequence of channel %d is %d", pack.ChannelId, sequence)
@@ -184,32 +279,50 @@ func (k Keeper) handlePackage(
 			"package type %d is invalid", packageHeader.PackageType)
 	}
 
-	cacheCtx, write := ctx.CacheContext()
-	crash, result := executeClaim(cacheCtx, crossChainApp, sequence, pack.Payload, &packageHeader)
+	var (
+		crash  bool
+		result sdk.ExecuteResult
+	)
+	if packageHeader.PackageType == sdk.SynCrossChainPackageType {
+		// syn packages which are timed out are rejected, the source chain has executed or will execute the timeout
+		// callbacks of them
+		if packageHeader.TimeoutTimestamp != 0 && uint64(ctx.BlockTime().Unix()) >= packageHeader.TimeoutTimestamp {
+			result.Err = sdkerrors.Wrapf(crosschaintypes.ErrPackageTimedOut, "package is timed out at %d", packageHeader.TimeoutTimestamp)
+		}
+	} else {
+		// ack and fail ack packages of the syn packages which can not be acknowledged, e.g. the timed out ones, are
+		// not executed
+		result.Err = k.CrossChainKeeper.AcknowledgePackage(ctx, sdk.ChainID(srcChainId), pack.ChannelId, packageHeader.SynSequence)
+	}
 	if result.IsOk() {
-		write()
+		cacheCtx, write := ctx.CacheContext()
+		crash, result = executeClaim(cacheCtx, crossChainApp, sequence, pack.Payload, &packageHeader)
+		if result.IsOk() {
+			write()
+		}
 	}
 
 	// write ack package
 	var sendSequence int64 = -1
 	if packageHeader.PackageType == sdk.SynCrossChainPackageType {
-		if crash {
+		// syn packages which can not be decoded by the app or are timed out are treated the same as crashed ones
+		if crash || sdkerrors.IsOf(result.Err, crosschaintypes.ErrDecodePackage, crosschaintypes.ErrPackageTimedOut) {
 			if len(pack.Payload) < sdk.SynPackageHeaderLength {
 				logger.Error("found payload without header",
 					"channelID", pack.ChannelId, "sequence", pack.Sequence, "payload", hex.EncodeToString(pack.Payload))
 				return sdkmath.ZeroInt(), nil, sdkerrors.Wrapf(types.ErrInvalidPackage, "payload without header")
 			}
 
-			sendSeq, ibcErr := k.CrossChainKeeper.CreateRawIBCPackageWithFee(ctx, pack.ChannelId,
-				sdk.FailAckCrossChainPackageType, pack.Payload[sdk.SynPackageHeaderLength:], packageHeader.AckRelayerFee, sdk.NilAckRelayerFee)
+			sendSeq, ibcErr := k.CrossChainKeeper.CreateRawAckPackage(ctx, sdk.ChainID(srcChainId), pack.ChannelId,
+				sdk.FailAckCrossChainPackageType, pack.Sequence, pack.Payload[sdk.SynPackageHeaderLength:], packageHeader.AckRelayerFee)
 			if ibcErr != nil {
 				logger.Error("failed to write FailAckCrossChainPackage", "err", err)
 				return sdkmath.ZeroInt(), nil, ibcErr
 			}
 			sendSequence = int64(sendSeq)
 		} else if len(result.Payload) != 0 {
-			sendSeq, err := k.CrossChainKeeper.CreateRawIBCPackageWithFee(ctx, pack.ChannelId,
-				sdk.AckCrossChainPackageType, result.Payload, packageHeader.AckRelayerFee, sdk.NilAckRelayerFee)
+			sendSeq, err := k.CrossChainKeeper.CreateRawAckPackage(ctx, sdk.ChainID(srcChainId), pack.ChannelId,
+				sdk.AckCrossChainPackageType, pack.Sequence, result.Payload, packageHeader.AckRelayerFee)
 			if err != nil {
 				logger.Error("failed to write AckCrossChainPackage", "err", err)
 				return sdkmath.ZeroInt(), nil, err
@@ -4,10 +4,14 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/crypto/bls"
 	"github.com/willf/bitset"
 
 	"github.com/cosmos/cosmos-sdk/bsc/rlp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
 	"github.com/cosmos/cosmos-sdk/x/oracle/testutil"
 	"github.com/cosmos/cosmos-sdk/x/oracle/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -27,13 +31,19 @@ func (ta *DummyCrossChainApp) ExecuteFailAckPackage(ctx sdk.Context, header *sdk
 	return sdk.ExecuteResult{}
 }
 
+func (ta *DummyCrossChainApp) ExecuteTimeoutPackage(ctx sdk.Context, header *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	return sdk.ExecuteResult{}
+}
+
 func (s *TestSuite) TestClaim() {
 	s.app.CrossChainKeeper.RegisterChannel("test", sdk.ChannelID(1), &DummyCrossChainApp{})
 
 	s.app.OracleKeeper.SetParams(s.ctx, types.Params{
-		RelayerTimeout:     5,
-		RelayerRewardShare: 50,
-		RelayerInterval:    600,
+		RelayerTimeout:       5,
+		RelayerRewardShare:   50,
+		RelayerInterval:      600,
+		RelayerMissedWindow:  types.DefaultRelayerMissedWindow,
+		RelayerSlashFraction: types.DefaultRelayerSlashFraction,
 	})
 
 	_, _, newValidators, blsKeys := createValidators(s.T(), s.ctx, s.app, []int64{9, 8, 7})
@@ -89,15 +99,110 @@ func (s *TestSuite) TestClaim() {
 	s.ctx = s.ctx.WithBlockTime(time.Unix(int64(msgClaim.Timestamp), 0))
 	_, err = s.msgServer.Claim(s.ctx, &msgClaim)
 	s.Require().Nil(err, "process claim msg error")
+
+	stats, found := s.app.OracleKeeper.GetRelayerStats(s.ctx, sdk.MustAccAddressFromHex(newValidators[0].RelayerAddress))
+	s.Require().True(found)
+	s.Require().Equal(uint64(1), stats.ClaimCount)
+	s.Require().Equal(uint64(1), stats.PackageCount)
+	s.Require().Equal(sdk.NewInt(1), stats.FeesEarned)
+	s.Require().True(s.ctx.BlockTime().Equal(stats.LastClaimTime))
+}
+
+type ackRecordingApp struct {
+	DummyCrossChainApp
+
+	synSequences []uint64
+}
+
+func (ta *ackRecordingApp) ExecuteAckPackage(ctx sdk.Context, header *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	ta.synSequences = append(ta.synSequences, header.Header.SynSequence)
+	return sdk.ExecuteResult{}
+}
+
+func (s *TestSuite) TestClaimAckPackage() {
+	app := &ackRecordingApp{}
+	s.app.CrossChainKeeper.RegisterChannel("test", sdk.ChannelID(1), app)
+	s.app.CrossChainKeeper.SetParams(s.ctx, crosschaintypes.DefaultParams())
+	s.app.CrossChainKeeper.SetChannelSendPermission(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), sdk.ChannelAllow)
+
+	_, err := s.app.CrossChainKeeper.CreateRawIBCPackageWithFee(s.ctx, sdk.ChainID(56), sdk.ChannelID(1),
+		sdk.SynCrossChainPackageType, []byte("test payload"), big.NewInt(1), big.NewInt(1))
+	s.Require().NoError(err)
+
+	_, _, newValidators, blsKeys := createValidators(s.T(), s.ctx, s.app, []int64{9, 8, 7})
+	s.app.StakingKeeper.SetHistoricalInfo(s.ctx, s.ctx.BlockHeight(), &stakingtypes.HistoricalInfo{
+		Header: s.ctx.BlockHeader(),
+		Valset: newValidators,
+	})
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1992, 0))
+
+	msgClaim := s.buildClaimWithHeader(newValidators, blsKeys, newValidators[0].RelayerAddress, 0, sdk.PackageHeader{
+		PackageType: sdk.AckCrossChainPackageType,
+		Timestamp:   1992,
+		RelayerFee:  big.NewInt(1),
+		SynSequence: 0,
+	})
+	_, err = s.msgServer.Claim(s.ctx, msgClaim)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{0}, app.synSequences)
+	s.Require().EqualValues(1, s.app.CrossChainKeeper.GetAckSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(1)))
+
+	// the ack package of a syn package which is never sent is not executed
+	msgClaim = s.buildClaimWithHeader(newValidators, blsKeys, newValidators[0].RelayerAddress, 1, sdk.PackageHeader{
+		PackageType: sdk.AckCrossChainPackageType,
+		Timestamp:   1992,
+		RelayerFee:  big.NewInt(1),
+		SynSequence: 5,
+	})
+	_, err = s.msgServer.Claim(s.ctx, msgClaim)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{0}, app.synSequences)
+	s.Require().EqualValues(1, s.app.CrossChainKeeper.GetAckSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(1)))
+	s.Require().EqualValues(2, s.app.CrossChainKeeper.GetReceiveSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(1)))
+}
+
+func (s *TestSuite) TestClaimTimedOutPackage() {
+	s.app.CrossChainKeeper.RegisterChannel("test", sdk.ChannelID(1), &DummyCrossChainApp{})
+	s.app.CrossChainKeeper.SetParams(s.ctx, crosschaintypes.DefaultParams())
+
+	_, _, newValidators, blsKeys := createValidators(s.T(), s.ctx, s.app, []int64{9, 8, 7})
+	s.app.StakingKeeper.SetHistoricalInfo(s.ctx, s.ctx.BlockHeight(), &stakingtypes.HistoricalInfo{
+		Header: s.ctx.BlockHeader(),
+		Valset: newValidators,
+	})
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1992, 0))
+
+	msgClaim := s.buildClaimWithHeader(newValidators, blsKeys, newValidators[0].RelayerAddress, 0, sdk.PackageHeader{
+		PackageType:      sdk.SynCrossChainPackageType,
+		Timestamp:        1992,
+		RelayerFee:       big.NewInt(1),
+		AckRelayerFee:    big.NewInt(2),
+		TimeoutTimestamp: 1992,
+	})
+	_, err := s.msgServer.Claim(s.ctx, msgClaim)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, s.app.CrossChainKeeper.GetReceiveSequence(s.ctx, sdk.ChainID(56), sdk.ChannelID(1)))
+
+	// the timed out package is rejected with a fail ack package
+	pack, err := s.app.CrossChainKeeper.GetCrossChainPackage(s.ctx, sdk.ChainID(56), sdk.ChannelID(1), 0)
+	s.Require().NoError(err)
+	header, err := sdk.DecodePackageHeader(pack)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.FailAckCrossChainPackageType, header.PackageType)
+	s.Require().EqualValues(0, header.SynSequence)
+	s.Require().EqualValues(2, header.RelayerFee.Int64())
+	s.Require().Equal([]byte("test payload"), pack[sdk.AckPackageHeaderLength:])
 }
 
 func (s *TestSuite) TestInvalidClaim() {
 	s.app.CrossChainKeeper.RegisterChannel("test", sdk.ChannelID(1), &DummyCrossChainApp{})
 
 	s.app.OracleKeeper.SetParams(s.ctx, types.Params{
-		RelayerTimeout:     5,
-		RelayerRewardShare: 50,
-		RelayerInterval:    600,
+		RelayerTimeout:       5,
+		RelayerRewardShare:   50,
+		RelayerInterval:      600,
+		RelayerMissedWindow:  types.DefaultRelayerMissedWindow,
+		RelayerSlashFraction: types.DefaultRelayerSlashFraction,
 	})
 
 	_, _, newValidators, blsKeys := createValidators(s.T(), s.ctx, s.app, []int64{9, 8, 7})
@@ -140,7 +245,8 @@ func (s *TestSuite) TestInvalidClaim() {
 	s.Require().NotNil(err, "process claim should return error")
 	s.Require().Contains(err.Error(), "src chain id is invalid")
 
-	s.app.CrossChainKeeper.SetDestChainID(sdk.ChainID(65))
+	err = s.app.CrossChainKeeper.RegisterDestChain(sdk.ChainID(65))
+	s.Require().NoError(err)
 
 	// invalid payload
 	s.ctx = s.ctx.WithBlockTime(time.Unix(int64(msgClaim.Timestamp), 0))
@@ -174,3 +280,136 @@ func (s *TestSuite) TestInvalidClaim() {
 	s.Require().NotNil(err, "process claim should return error")
 	s.Require().Contains(err.Error(), "is not the same in payload header")
 }
+
+func (s *TestSuite) TestClaimQuorumType() {
+	s.app.CrossChainKeeper.RegisterChannel("test", sdk.ChannelID(1), &DummyCrossChainApp{})
+
+	_, _, newValidators, blsKeys := createValidators(s.T(), s.ctx, s.app, []int64{9, 8, 7})
+	// the first validator holds more than 2/3 of the total voting power
+	for idx, power := range []int64{10, 1, 1} {
+		newValidators[idx].Status = stakingtypes.Bonded
+		newValidators[idx].Tokens = s.app.StakingKeeper.TokensFromConsensusPower(s.ctx, power)
+	}
+
+	s.app.StakingKeeper.SetHistoricalInfo(s.ctx, s.ctx.BlockHeight(), &stakingtypes.HistoricalInfo{
+		Header: s.ctx.BlockHeader(),
+		Valset: newValidators,
+	})
+
+	// the claim is only signed by the first validator
+	msgClaim := s.buildClaim(newValidators, blsKeys, newValidators[0].RelayerAddress, 0, 1992)
+	valBitSet := bitset.New(256)
+	valBitSet.Set(0)
+	msgClaim.VoteAddressSet = valBitSet.Bytes()
+	blsSignBytes := msgClaim.GetBlsSignBytes()
+	msgClaim.AggSignature = blsKeys[0].Sign(blsSignBytes[:]).Marshal()
+
+	s.ctx = s.ctx.WithBlockTime(time.Unix(int64(msgClaim.Timestamp), 0))
+
+	params := types.DefaultParams()
+	params.QuorumType = types.QuorumValidatorCount
+	s.app.OracleKeeper.SetParams(s.ctx, params)
+
+	_, err := s.msgServer.Claim(s.ctx, msgClaim)
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "not enough validators voted")
+
+	params.QuorumType = types.QuorumVotingPower
+	s.app.OracleKeeper.SetParams(s.ctx, params)
+
+	_, err = s.msgServer.Claim(s.ctx, msgClaim)
+	s.Require().NoError(err)
+
+	// the claim signed by the validators with less than 2/3 of the total voting power is rejected
+	msgClaim = s.buildClaim(newValidators, blsKeys, newValidators[0].RelayerAddress, 1, 1992)
+	valBitSet = bitset.New(256)
+	valBitSet.Set(1)
+	valBitSet.Set(2)
+	msgClaim.VoteAddressSet = valBitSet.Bytes()
+	blsSignBytes = msgClaim.GetBlsSignBytes()
+	aggSig := bls.AggregateSignatures([]bls.Signature{blsKeys[1].Sign(blsSignBytes[:]), blsKeys[2].Sign(blsSignBytes[:])})
+	msgClaim.AggSignature = aggSig.Marshal()
+
+	_, err = s.msgServer.Claim(s.ctx, msgClaim)
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "not enough voting power voted")
+}
+
+func (s *TestSuite) TestDistributeReward() {
+	s.app.CrossChainKeeper.RegisterChannel("test", sdk.ChannelID(1), &DummyCrossChainApp{})
+
+	_, _, newValidators, blsKeys := createValidators(s.T(), s.ctx, s.app, []int64{9, 8, 7})
+	for idx, power := range []int64{10, 2, 6} {
+		newValidators[idx].Status = stakingtypes.Bonded
+		newValidators[idx].Tokens = s.app.StakingKeeper.TokensFromConsensusPower(s.ctx, power)
+	}
+
+	s.app.StakingKeeper.SetHistoricalInfo(s.ctx, s.ctx.BlockHeight(), &stakingtypes.HistoricalInfo{
+		Header: s.ctx.BlockHeader(),
+		Valset: newValidators,
+	})
+
+	params := types.DefaultParams()
+	params.RelayerRewardShare = 50
+	params.RewardSplitType = types.RewardSplitStakeWeighted
+	params.TreasuryShare = 10
+	params.TreasuryDestination = types.TreasuryFeeCollector
+	s.app.OracleKeeper.SetParams(s.ctx, params)
+
+	bondDenom := s.app.StakingKeeper.BondDenom(s.ctx)
+	getBalance := func(addr sdk.AccAddress) sdk.Int {
+		return s.app.BankKeeper.GetBalance(s.ctx, addr, bondDenom).Amount
+	}
+
+	relayers := make([]sdk.AccAddress, 0, len(newValidators))
+	balances := make([]sdk.Int, 0, len(newValidators))
+	for _, validator := range newValidators {
+		relayer := sdk.MustAccAddressFromHex(validator.RelayerAddress)
+		relayers = append(relayers, relayer)
+		balances = append(balances, getBalance(relayer))
+	}
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalance := getBalance(feeCollector)
+
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1992, 0)).WithEventManager(sdk.NewEventManager())
+	msgClaim := s.buildClaimWithFee(newValidators, blsKeys, newValidators[0].RelayerAddress, 0, 1992, 1000)
+	_, err := s.msgServer.Claim(s.ctx, msgClaim)
+	s.Require().NoError(err)
+
+	// 100 is cut for the treasury, the other relayers split 450 by voting power and the submitter gets the rest
+	s.Require().Equal(feeCollectorBalance.AddRaw(100), getBalance(feeCollector))
+	s.Require().Equal(balances[1].AddRaw(112), getBalance(relayers[1]))
+	s.Require().Equal(balances[2].AddRaw(337), getBalance(relayers[2]))
+	s.Require().Equal(balances[0].AddRaw(451), getBalance(relayers[0]))
+
+	stats, found := s.app.OracleKeeper.GetRelayerStats(s.ctx, relayers[2])
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(337), stats.FeesEarned)
+
+	rewardEvents := 0
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type == proto.MessageName(&types.EventDistributeReward{}) {
+			rewardEvents++
+		}
+	}
+	s.Require().Equal(4, rewardEvents)
+
+	// the treasury cut goes to the community pool, and the other relayers split evenly
+	params.RewardSplitType = types.RewardSplitEven
+	params.TreasuryDestination = types.TreasuryCommunityPool
+	s.app.OracleKeeper.SetParams(s.ctx, params)
+
+	communityPool := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx).AmountOf(bondDenom)
+	for idx, relayer := range relayers {
+		balances[idx] = getBalance(relayer)
+	}
+
+	msgClaim = s.buildClaimWithFee(newValidators, blsKeys, newValidators[0].RelayerAddress, 1, 1992, 1000)
+	_, err = s.msgServer.Claim(s.ctx, msgClaim)
+	s.Require().NoError(err)
+
+	s.Require().Equal(communityPool.Add(sdk.NewDec(100)), s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx).AmountOf(bondDenom))
+	s.Require().Equal(balances[1].AddRaw(225), getBalance(relayers[1]))
+	s.Require().Equal(balances[2].AddRaw(225), getBalance(relayers[2]))
+	s.Require().Equal(balances[0].AddRaw(450), getBalance(relayers[0]))
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+// SetMissedInturnInterval records an in-turn relay interval missed by a validator
+func (k Keeper) SetMissedInturnInterval(ctx sdk.Context, interval types.MissedInturnInterval) {
+	validator := sdk.MustAccAddressFromHex(interval.ValidatorAddress)
+	ctx.KVStore(k.storeKey).Set(types.GetMissedInturnIntervalKey(validator, interval.Start), k.cdc.MustMarshal(&interval))
+}
+
+// HasMissedInturnInterval returns true if the validator has missed the in-turn relay interval starting at start
+func (k Keeper) HasMissedInturnInterval(ctx sdk.Context, validator sdk.AccAddress, start uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetMissedInturnIntervalKey(validator, start))
+}
+
+// GetMissedInturnIntervals returns the in-turn relay intervals missed by a validator in ascending order of start time
+func (k Keeper) GetMissedInturnIntervals(ctx sdk.Context, validator sdk.AccAddress) []types.MissedInturnInterval {
+	return k.getMissedInturnIntervals(ctx, types.GetMissedInturnIntervalPrefix(validator))
+}
+
+// GetAllMissedInturnIntervals returns the in-turn relay intervals missed by all the validators
+func (k Keeper) GetAllMissedInturnIntervals(ctx sdk.Context) []types.MissedInturnInterval {
+	return k.getMissedInturnIntervals(ctx, types.MissedInturnIntervalKeyPrefix)
+}
+
+func (k Keeper) getMissedInturnIntervals(ctx sdk.Context, keyPrefix []byte) []types.MissedInturnInterval {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	intervals := make([]types.MissedInturnInterval, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var interval types.MissedInturnInterval
+		k.cdc.MustUnmarshal(iterator.Value(), &interval)
+		intervals = append(intervals, interval)
+	}
+	return intervals
+}
+
+// pruneMissedInturnIntervals deletes the intervals missed by the validator which started before the cutoff time,
+// and returns the number of the remaining ones
+func (k Keeper) pruneMissedInturnIntervals(ctx sdk.Context, validator sdk.AccAddress, cutoff uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	var missedCount uint64
+	for _, interval := range k.GetMissedInturnIntervals(ctx, validator) {
+		if interval.Start < cutoff {
+			store.Delete(types.GetMissedInturnIntervalKey(validator, interval.Start))
+			continue
+		}
+		missedCount++
+	}
+	return missedCount
+}
+
+// clearMissedInturnIntervals deletes all the intervals missed by the validator
+func (k Keeper) clearMissedInturnIntervals(ctx sdk.Context, validator sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	for _, interval := range k.GetMissedInturnIntervals(ctx, validator) {
+		store.Delete(types.GetMissedInturnIntervalKey(validator, interval.Start))
+	}
+}
+
+// handleInturnRelayerLiveness records the current relay interval as missed by the in-turn validator if the claim is
+// submitted by another relayer, and slashes and jails the validator if it has missed too many intervals in the
+// sliding window.
+func (k Keeper) handleInturnRelayerLiveness(ctx sdk.Context, relayer sdk.AccAddress) error {
+	_, relayerInterval := k.GetRelayerParams(ctx)
+	inturnValidator, interval, err := k.getInturnValidator(ctx, relayerInterval)
+	if err != nil {
+		return err
+	}
+
+	if inturnValidator.RelayerAddress == relayer.String() {
+		return nil
+	}
+
+	validatorAddr := inturnValidator.GetOperator()
+	if k.HasMissedInturnInterval(ctx, validatorAddr, interval.Start) {
+		return nil
+	}
+
+	k.SetMissedInturnInterval(ctx, types.MissedInturnInterval{
+		ValidatorAddress: validatorAddr.String(),
+		Start:            interval.Start,
+		End:              interval.End,
+	})
+
+	params := k.GetParams(ctx)
+
+	var cutoff uint64
+	if curTime := uint64(ctx.BlockTime().Unix()); curTime > params.RelayerMissedWindow {
+		cutoff = curTime - params.RelayerMissedWindow
+	}
+	missedCount := k.pruneMissedInturnIntervals(ctx, validatorAddr, cutoff)
+
+	k.Logger(ctx).Info("in-turn relayer missed its relay interval", "validator", validatorAddr.String(),
+		"start", interval.Start, "end", interval.End, "missed", missedCount)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventInturnRelayerMissed{
+		ValidatorAddress: validatorAddr.String(),
+		RelayerAddress:   inturnValidator.RelayerAddress,
+		IntervalStart:    interval.Start,
+		IntervalEnd:      interval.End,
+		MissedCount:      missedCount,
+	})
+	if err != nil {
+		return err
+	}
+
+	if params.RelayerMissedThreshold == 0 || missedCount < params.RelayerMissedThreshold {
+		return nil
+	}
+
+	// the validator set of the relay intervals is the historical one, so the current state of the validator is used,
+	// unbonded validators should not be slashed
+	validator, found := k.StakingKeeper.GetValidator(ctx, validatorAddr)
+	if !found || validator.IsJailed() || validator.IsUnbonded() {
+		return nil
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	// the distribution height is calculated the same as the downtime infractions of the slashing module
+	power := validator.ConsensusPower(k.StakingKeeper.PowerReduction(ctx))
+	distributionHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+
+	k.SlashingKeeper.Slash(ctx, consAddr, params.RelayerSlashFraction, power, distributionHeight)
+	k.SlashingKeeper.Jail(ctx, consAddr)
+	k.clearMissedInturnIntervals(ctx, validatorAddr)
+
+	k.Logger(ctx).Info("slashed and jailed validator for missing too many in-turn relay intervals",
+		"validator", validatorAddr.String(), "missed", missedCount, "slash_fraction", params.RelayerSlashFraction.String())
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventInturnRelayerSlashed{
+		ValidatorAddress: validatorAddr.String(),
+		MissedCount:      missedCount,
+		SlashFraction:    params.RelayerSlashFraction.String(),
+		Power:            power,
+	})
+}
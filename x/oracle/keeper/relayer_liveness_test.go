@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/willf/bitset"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/testutil"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *TestSuite) TestInturnRelayerLiveness() {
+	s.app.CrossChainKeeper.RegisterChannel("test", sdk.ChannelID(1), &DummyCrossChainApp{})
+
+	params := types.DefaultParams()
+	params.RelayerTimeout = 5
+	params.RelayerInterval = 600
+	params.RelayerMissedThreshold = 2
+	params.RelayerSlashFraction = sdk.NewDecWithPrec(1, 2)
+	s.app.OracleKeeper.SetParams(s.ctx, params)
+
+	_, _, newValidators, blsKeys := createValidators(s.T(), s.ctx, s.app, []int64{9, 8, 7})
+	for idx := range newValidators {
+		newValidators[idx].Status = stakingtypes.Bonded
+		s.app.StakingKeeper.SetValidator(s.ctx, newValidators[idx])
+		s.Require().NoError(s.app.StakingKeeper.SetValidatorByConsAddr(s.ctx, newValidators[idx]))
+	}
+
+	s.app.StakingKeeper.SetHistoricalInfo(s.ctx, s.ctx.BlockHeight(), &stakingtypes.HistoricalInfo{
+		Header: s.ctx.BlockHeader(),
+		Valset: newValidators,
+	})
+
+	// the relay interval [1800, 2400) belongs to the first validator, the claim is submitted by the second one
+	// after the in-turn relayer timed out
+	msgClaim := s.buildClaim(newValidators, blsKeys, newValidators[1].RelayerAddress, 0, 1992)
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1992+5, 0))
+	_, err := s.msgServer.Claim(s.ctx, msgClaim)
+	s.Require().NoError(err)
+
+	inturnValidator := newValidators[0].GetOperator()
+	intervals := s.app.OracleKeeper.GetMissedInturnIntervals(s.ctx, inturnValidator)
+	s.Require().Len(intervals, 1)
+	s.Require().Equal(uint64(1800), intervals[0].Start)
+	s.Require().Equal(uint64(2400), intervals[0].End)
+
+	// the claims of the in-turn relayer are not counted as missed
+	msgClaim = s.buildClaim(newValidators, blsKeys, newValidators[0].RelayerAddress, 1, 1993)
+	s.ctx = s.ctx.WithBlockTime(time.Unix(1993, 0))
+	_, err = s.msgServer.Claim(s.ctx, msgClaim)
+	s.Require().NoError(err)
+	s.Require().Len(s.app.OracleKeeper.GetMissedInturnIntervals(s.ctx, inturnValidator), 1)
+
+	// the next relay interval of the first validator is [3600, 4200), the validator is slashed and jailed when
+	// reaching the missed threshold
+	msgClaim = s.buildClaim(newValidators, blsKeys, newValidators[2].RelayerAddress, 2, 3700)
+	s.ctx = s.ctx.WithBlockTime(time.Unix(3700+5, 0))
+	_, err = s.msgServer.Claim(s.ctx, msgClaim)
+	s.Require().NoError(err)
+
+	s.Require().Len(s.app.OracleKeeper.GetMissedInturnIntervals(s.ctx, inturnValidator), 0)
+	validator, found := s.app.StakingKeeper.GetValidator(s.ctx, inturnValidator)
+	s.Require().True(found)
+	s.Require().True(validator.IsJailed())
+
+	// the missed intervals out of the sliding window are pruned
+	params.RelayerMissedWindow = 1000
+	s.app.OracleKeeper.SetParams(s.ctx, params)
+
+	inturnValidator = newValidators[1].GetOperator()
+	msgClaim = s.buildClaim(newValidators, blsKeys, newValidators[0].RelayerAddress, 3, 4200)
+	s.ctx = s.ctx.WithBlockTime(time.Unix(4200+5, 0))
+	_, err = s.msgServer.Claim(s.ctx, msgClaim)
+	s.Require().NoError(err)
+	s.Require().Len(s.app.OracleKeeper.GetMissedInturnIntervals(s.ctx, inturnValidator), 1)
+
+	msgClaim = s.buildClaim(newValidators, blsKeys, newValidators[0].RelayerAddress, 4, 6000)
+	s.ctx = s.ctx.WithBlockTime(time.Unix(6000+5, 0))
+	_, err = s.msgServer.Claim(s.ctx, msgClaim)
+	s.Require().NoError(err)
+
+	intervals = s.app.OracleKeeper.GetMissedInturnIntervals(s.ctx, inturnValidator)
+	s.Require().Len(intervals, 1)
+	s.Require().Equal(uint64(6000), intervals[0].Start)
+	validator, found = s.app.StakingKeeper.GetValidator(s.ctx, inturnValidator)
+	s.Require().True(found)
+	s.Require().False(validator.IsJailed())
+}
+
+// buildClaim returns a claim of a single package with the relayer fee of 1 signed by all the validators
+func (s *TestSuite) buildClaim(validators []stakingtypes.Validator, blsKeys []bls.SecretKey, from string, sequence, timestamp uint64) *types.MsgClaim {
+	return s.buildClaimWithFee(validators, blsKeys, from, sequence, timestamp, 1)
+}
+
+// buildClaimWithFee returns a claim of a single package with the relayer fee signed by all the validators
+func (s *TestSuite) buildClaimWithFee(validators []stakingtypes.Validator, blsKeys []bls.SecretKey, from string, sequence, timestamp uint64, relayerFee int64) *types.MsgClaim {
+	return s.buildClaimWithHeader(validators, blsKeys, from, sequence, sdk.PackageHeader{
+		PackageType:   sdk.SynCrossChainPackageType,
+		Timestamp:     timestamp,
+		RelayerFee:    big.NewInt(relayerFee),
+		AckRelayerFee: big.NewInt(1),
+	})
+}
+
+// buildClaimWithHeader returns a claim of a single package with the package header signed by all the validators
+func (s *TestSuite) buildClaimWithHeader(validators []stakingtypes.Validator, blsKeys []bls.SecretKey, from string, sequence uint64, header sdk.PackageHeader) *types.MsgClaim {
+	timestamp := header.Timestamp
+	payloadHeader := sdk.EncodePackageHeader(header)
+
+	packageBytes, err := rlp.EncodeToBytes([]types.Package{{
+		ChannelId: 1,
+		Sequence:  sequence,
+		Payload:   append(payloadHeader, []byte("test payload")...),
+	}})
+	s.Require().NoError(err)
+
+	valBitSet := bitset.New(256)
+	for idx := range validators {
+		valBitSet.Set(uint(idx))
+	}
+
+	msgClaim := &types.MsgClaim{
+		FromAddress:    from,
+		SrcChainId:     56,
+		DestChainId:    1,
+		Sequence:       sequence,
+		Timestamp:      timestamp,
+		Payload:        packageBytes,
+		VoteAddressSet: valBitSet.Bytes(),
+	}
+	blsSignBytes := msgClaim.GetBlsSignBytes()
+	msgClaim.AggSignature = testutil.GenerateBlsSig(blsKeys, blsSignBytes[:])
+	return msgClaim
+}
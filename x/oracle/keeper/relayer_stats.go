@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+)
+
+// GetRelayerStats returns the claim statistics of a relayer
+func (k Keeper) GetRelayerStats(ctx sdk.Context, relayer sdk.AccAddress) (types.RelayerStats, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetRelayerStatsKey(relayer))
+	if bz == nil {
+		return types.RelayerStats{}, false
+	}
+
+	var stats types.RelayerStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// SetRelayerStats sets the claim statistics of a relayer
+func (k Keeper) SetRelayerStats(ctx sdk.Context, stats types.RelayerStats) {
+	relayer := sdk.MustAccAddressFromHex(stats.Relayer)
+	ctx.KVStore(k.storeKey).Set(types.GetRelayerStatsKey(relayer), k.cdc.MustMarshal(&stats))
+}
+
+// IterateRelayerStats iterates over the claim statistics of all the relayers,
+// the iteration stops if the callback returns true
+func (k Keeper) IterateRelayerStats(ctx sdk.Context, cb func(stats types.RelayerStats) (stop bool)) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.RelayerStatsKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.RelayerStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// GetAllRelayerStats returns the claim statistics of all the relayers
+func (k Keeper) GetAllRelayerStats(ctx sdk.Context) []types.RelayerStats {
+	allStats := make([]types.RelayerStats, 0)
+	k.IterateRelayerStats(ctx, func(stats types.RelayerStats) bool {
+		allStats = append(allStats, stats)
+		return false
+	})
+	return allStats
+}
+
+// getOrNewRelayerStats returns the claim statistics of a relayer, empty statistics are returned if the relayer
+// has not been recorded yet
+func (k Keeper) getOrNewRelayerStats(ctx sdk.Context, relayer sdk.AccAddress) types.RelayerStats {
+	stats, found := k.GetRelayerStats(ctx, relayer)
+	if !found {
+		stats = types.RelayerStats{
+			Relayer:    relayer.String(),
+			FeesEarned: sdkmath.ZeroInt(),
+		}
+	}
+	return stats
+}
+
+// recordClaim records a claim with the given number of packages submitted by the relayer
+func (k Keeper) recordClaim(ctx sdk.Context, relayer sdk.AccAddress, packageCount int) {
+	stats := k.getOrNewRelayerStats(ctx, relayer)
+	stats.ClaimCount++
+	stats.PackageCount += uint64(packageCount)
+	stats.LastClaimTime = ctx.BlockTime().UTC()
+	k.SetRelayerStats(ctx, stats)
+}
+
+// recordFeesEarned adds the relayer fees distributed to the relayer to its statistics
+func (k Keeper) recordFeesEarned(ctx sdk.Context, relayer sdk.AccAddress, fees sdkmath.Int) {
+	stats := k.getOrNewRelayerStats(ctx, relayer)
+	stats.FeesEarned = stats.FeesEarned.Add(fees)
+	k.SetRelayerStats(ctx, stats)
+}
@@ -0,0 +1,28 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 1 to 2.
+// The migration includes:
+//
+// - Setting the relayer liveness params (RelayerMissedWindow, RelayerMissedThreshold, RelayerSlashFraction)
+// - Setting the QuorumType param
+// - Setting the relayer reward params (RewardSplitType, TreasuryShare, TreasuryDestination)
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+	paramstore.Set(ctx, types.KeyParamRelayerMissedWindow, types.DefaultRelayerMissedWindow)
+	paramstore.Set(ctx, types.KeyParamRelayerMissedThreshold, types.DefaultRelayerMissedThreshold)
+	paramstore.Set(ctx, types.KeyParamRelayerSlashFraction, types.DefaultRelayerSlashFraction)
+	paramstore.Set(ctx, types.KeyParamQuorumType, types.DefaultQuorumType)
+	paramstore.Set(ctx, types.KeyParamRewardSplitType, types.DefaultRewardSplitType)
+	paramstore.Set(ctx, types.KeyParamTreasuryShare, types.DefaultTreasuryShare)
+	paramstore.Set(ctx, types.KeyParamTreasuryDestination, types.DefaultTreasuryDestination)
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v2 "github.com/cosmos/cosmos-sdk/x/oracle/migrations/v2"
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	oracleKey := sdk.NewKVStoreKey(types.StoreKey)
+	tOracleKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(oracleKey, tOracleKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, oracleKey, tOracleKey, types.ModuleName)
+
+	newParams := [][]byte{
+		types.KeyParamRelayerMissedWindow,
+		types.KeyParamRelayerMissedThreshold,
+		types.KeyParamRelayerSlashFraction,
+		types.KeyParamQuorumType,
+		types.KeyParamRewardSplitType,
+		types.KeyParamTreasuryShare,
+		types.KeyParamTreasuryDestination,
+	}
+
+	// Check no params
+	for _, key := range newParams {
+		require.False(t, paramstore.Has(ctx, key))
+	}
+
+	// Run migrations.
+	err := v2.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	for _, key := range newParams {
+		require.True(t, paramstore.Has(ctx, key))
+	}
+	var slashFraction sdk.Dec
+	paramstore.Get(ctx, types.KeyParamRelayerSlashFraction, &slashFraction)
+	require.Equal(t, types.DefaultRelayerSlashFraction, slashFraction)
+	var quorumType types.QuorumType
+	paramstore.Get(ctx, types.KeyParamQuorumType, &quorumType)
+	require.Equal(t, types.DefaultQuorumType, quorumType)
+}
@@ -18,4 +18,6 @@ var (
 	ErrInvalidDestChainId     = sdkerrors.Register(ModuleName, 14, "dest chain id is invalid")
 	ErrInvalidSrcChainId      = sdkerrors.Register(ModuleName, 15, "src chain id is invalid")
 	ErrInvalidAddress         = sdkerrors.Register(ModuleName, 16, "address is invalid")
+
+	ErrInvalidTreasuryDestination = sdkerrors.Register(ModuleName, 17, "treasury destination is invalid")
 )
@@ -149,37 +149,272 @@ func (m *EventPackageClaim) GetAckRelayerFee() string {
 	return ""
 }
 
+// EventInturnRelayerMissed is emitted when a validator misses its in-turn relay interval
+type EventInturnRelayerMissed struct {
+	// Operator address of the validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Address of the in-turn relayer of the validator
+	RelayerAddress string `protobuf:"bytes,2,opt,name=relayer_address,json=relayerAddress,proto3" json:"relayer_address,omitempty"`
+	// Start time of the missed interval in seconds
+	IntervalStart uint64 `protobuf:"varint,3,opt,name=interval_start,json=intervalStart,proto3" json:"interval_start,omitempty"`
+	// End time of the missed interval in seconds
+	IntervalEnd uint64 `protobuf:"varint,4,opt,name=interval_end,json=intervalEnd,proto3" json:"interval_end,omitempty"`
+	// Number of the intervals missed by the validator in the sliding window, including this one
+	MissedCount uint64 `protobuf:"varint,5,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty"`
+}
+
+func (m *EventInturnRelayerMissed) Reset()         { *m = EventInturnRelayerMissed{} }
+func (m *EventInturnRelayerMissed) String() string { return proto.CompactTextString(m) }
+func (*EventInturnRelayerMissed) ProtoMessage()    {}
+func (*EventInturnRelayerMissed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e254cedc4112fb0, []int{1}
+}
+func (m *EventInturnRelayerMissed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventInturnRelayerMissed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventInturnRelayerMissed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventInturnRelayerMissed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventInturnRelayerMissed.Merge(m, src)
+}
+func (m *EventInturnRelayerMissed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventInturnRelayerMissed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventInturnRelayerMissed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventInturnRelayerMissed proto.InternalMessageInfo
+
+func (m *EventInturnRelayerMissed) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EventInturnRelayerMissed) GetRelayerAddress() string {
+	if m != nil {
+		return m.RelayerAddress
+	}
+	return ""
+}
+
+func (m *EventInturnRelayerMissed) GetIntervalStart() uint64 {
+	if m != nil {
+		return m.IntervalStart
+	}
+	return 0
+}
+
+func (m *EventInturnRelayerMissed) GetIntervalEnd() uint64 {
+	if m != nil {
+		return m.IntervalEnd
+	}
+	return 0
+}
+
+func (m *EventInturnRelayerMissed) GetMissedCount() uint64 {
+	if m != nil {
+		return m.MissedCount
+	}
+	return 0
+}
+
+// EventInturnRelayerSlashed is emitted when a validator is slashed and jailed for missing too many in-turn relay intervals
+type EventInturnRelayerSlashed struct {
+	// Operator address of the validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// Number of the intervals missed by the validator in the sliding window
+	MissedCount uint64 `protobuf:"varint,2,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty"`
+	// Fraction of the stake slashed
+	SlashFraction string `protobuf:"bytes,3,opt,name=slash_fraction,json=slashFraction,proto3" json:"slash_fraction,omitempty"`
+	// Consensus power of the validator when slashed
+	Power int64 `protobuf:"varint,4,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *EventInturnRelayerSlashed) Reset()         { *m = EventInturnRelayerSlashed{} }
+func (m *EventInturnRelayerSlashed) String() string { return proto.CompactTextString(m) }
+func (*EventInturnRelayerSlashed) ProtoMessage()    {}
+func (*EventInturnRelayerSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e254cedc4112fb0, []int{2}
+}
+func (m *EventInturnRelayerSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventInturnRelayerSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventInturnRelayerSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventInturnRelayerSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventInturnRelayerSlashed.Merge(m, src)
+}
+func (m *EventInturnRelayerSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventInturnRelayerSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventInturnRelayerSlashed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventInturnRelayerSlashed proto.InternalMessageInfo
+
+func (m *EventInturnRelayerSlashed) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *EventInturnRelayerSlashed) GetMissedCount() uint64 {
+	if m != nil {
+		return m.MissedCount
+	}
+	return 0
+}
+
+func (m *EventInturnRelayerSlashed) GetSlashFraction() string {
+	if m != nil {
+		return m.SlashFraction
+	}
+	return ""
+}
+
+func (m *EventInturnRelayerSlashed) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// EventDistributeReward is emitted when the relayer fees of a claim are distributed to a recipient
+type EventDistributeReward struct {
+	// Address of the recipient
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// Type of the recipient, like relayer, signer, fee_collector and community_pool
+	RecipientType string `protobuf:"bytes,2,opt,name=recipient_type,json=recipientType,proto3" json:"recipient_type,omitempty"`
+	// Amount of the reward
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventDistributeReward) Reset()         { *m = EventDistributeReward{} }
+func (m *EventDistributeReward) String() string { return proto.CompactTextString(m) }
+func (*EventDistributeReward) ProtoMessage()    {}
+func (*EventDistributeReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3e254cedc4112fb0, []int{3}
+}
+func (m *EventDistributeReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDistributeReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDistributeReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDistributeReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDistributeReward.Merge(m, src)
+}
+func (m *EventDistributeReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDistributeReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDistributeReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDistributeReward proto.InternalMessageInfo
+
+func (m *EventDistributeReward) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventDistributeReward) GetRecipientType() string {
+	if m != nil {
+		return m.RecipientType
+	}
+	return ""
+}
+
+func (m *EventDistributeReward) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventPackageClaim)(nil), "cosmos.oracle.v1.EventPackageClaim")
+	proto.RegisterType((*EventInturnRelayerMissed)(nil), "cosmos.oracle.v1.EventInturnRelayerMissed")
+	proto.RegisterType((*EventInturnRelayerSlashed)(nil), "cosmos.oracle.v1.EventInturnRelayerSlashed")
+	proto.RegisterType((*EventDistributeReward)(nil), "cosmos.oracle.v1.EventDistributeReward")
 }
 
 func init() { proto.RegisterFile("cosmos/oracle/v1/event.proto", fileDescriptor_3e254cedc4112fb0) }
 
 var fileDescriptor_3e254cedc4112fb0 = []byte{
-	// 357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0xd1, 0xcb, 0x6a, 0xdb, 0x40,
-	0x14, 0x80, 0x61, 0x8f, 0x6f, 0xb5, 0x8e, 0x2d, 0xec, 0x0e, 0x5d, 0x08, 0xda, 0xaa, 0xaa, 0x0b,
-	0x45, 0xa5, 0x54, 0xc2, 0xf4, 0x0d, 0x6a, 0x5c, 0xf0, 0xa2, 0x50, 0x94, 0xac, 0xb2, 0x11, 0xe3,
-	0xd1, 0x89, 0x24, 0x74, 0xcd, 0x8c, 0x2c, 0xe2, 0xb7, 0xc8, 0x63, 0x65, 0xe9, 0x65, 0x96, 0xc1,
-	0x5e, 0xe4, 0x35, 0x82, 0x2e, 0xb6, 0xb3, 0x12, 0xfa, 0xcf, 0xc7, 0x0c, 0xcc, 0x81, 0x4f, 0x3c,
-	0x93, 0x49, 0x26, 0xed, 0x4c, 0x30, 0x1e, 0xa3, 0x5d, 0x2e, 0x6c, 0x2c, 0x31, 0x2d, 0xac, 0x5c,
-	0x64, 0x45, 0x46, 0x67, 0xcd, 0xd4, 0x6a, 0xa6, 0x56, 0xb9, 0x98, 0xbf, 0x74, 0xe1, 0xfd, 0xaa,
-	0x12, 0xff, 0x19, 0x8f, 0x98, 0x8f, 0xcb, 0x98, 0x85, 0x09, 0x35, 0x60, 0x22, 0x05, 0x77, 0x79,
-	0xc0, 0xc2, 0xd4, 0x0d, 0x3d, 0x8d, 0x18, 0xc4, 0x54, 0x1d, 0x90, 0x82, 0x2f, 0xab, 0xb4, 0xf6,
-	0xe8, 0x1c, 0x54, 0x0f, 0x65, 0x71, 0x21, 0xdd, 0x9a, 0x8c, 0xab, 0x78, 0x32, 0x9f, 0x01, 0x78,
-	0xc0, 0xd2, 0x14, 0xe3, 0x0a, 0xf4, 0x6a, 0xa0, 0xb4, 0x65, 0xed, 0xd1, 0xaf, 0x30, 0xc9, 0x9b,
-	0x4b, 0xdd, 0x62, 0x97, 0xa3, 0xd6, 0x6f, 0x4e, 0x68, 0xdb, 0xf5, 0x2e, 0x47, 0xfa, 0x03, 0x66,
-	0x02, 0x39, 0x86, 0x25, 0xba, 0x12, 0xef, 0xb6, 0x98, 0x72, 0xd4, 0x06, 0x06, 0x31, 0xfb, 0xce,
-	0xb4, 0xed, 0x57, 0x6d, 0xa6, 0xdf, 0x40, 0x95, 0x98, 0x7a, 0x17, 0x37, 0x34, 0x88, 0xd9, 0x73,
-	0x26, 0x55, 0x3c, 0xa3, 0x0f, 0x30, 0xe0, 0x82, 0xc9, 0x40, 0x7b, 0x67, 0x10, 0x73, 0xe4, 0x34,
-	0x3f, 0xf4, 0x23, 0x28, 0x28, 0x44, 0x26, 0xdc, 0x44, 0xfa, 0xda, 0xc8, 0x20, 0xa6, 0xe2, 0x8c,
-	0xea, 0xf0, 0x4f, 0xfa, 0xf4, 0x0b, 0x8c, 0x05, 0xc6, 0x6c, 0x87, 0xc2, 0xbd, 0x45, 0xd4, 0x94,
-	0x7a, 0x0c, 0x6d, 0xfa, 0x8b, 0x48, 0xbf, 0xc3, 0x94, 0xf1, 0xc8, 0x7d, 0x8b, 0xa0, 0x46, 0x2a,
-	0xe3, 0x91, 0x73, 0x76, 0x7f, 0x56, 0x8f, 0x07, 0x9d, 0xec, 0x0f, 0x3a, 0x79, 0x3e, 0xe8, 0xe4,
-	0xe1, 0xa8, 0x77, 0xf6, 0x47, 0xbd, 0xf3, 0x74, 0xd4, 0x3b, 0x37, 0x3f, 0xfd, 0xb0, 0x08, 0xb6,
-	0x1b, 0x8b, 0x67, 0x89, 0xdd, 0xae, 0xaf, 0xf9, 0xfc, 0x92, 0x5e, 0x64, 0xdf, 0x9f, 0x76, 0x59,
-	0x3d, 0x92, 0xdc, 0x0c, 0xeb, 0x4d, 0xfe, 0x7e, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x9f, 0x98,
-	0x8f, 0xe9, 0x01, 0x00, 0x00,
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x6e, 0x13, 0x3f,
+	0x10, 0xc0, 0xbb, 0x69, 0xda, 0x7f, 0x77, 0xd2, 0xed, 0x87, 0xf5, 0x07, 0x2d, 0xa2, 0x84, 0x10,
+	0x04, 0x04, 0x55, 0x24, 0xaa, 0x78, 0x02, 0x28, 0xad, 0xd4, 0x43, 0x25, 0xe4, 0x72, 0xe2, 0xb2,
+	0x72, 0xed, 0x69, 0x62, 0x65, 0xe3, 0x0d, 0xb6, 0x93, 0xd2, 0xb7, 0xe0, 0x31, 0x78, 0x14, 0x8e,
+	0x3d, 0x72, 0x41, 0x42, 0xed, 0x81, 0xd7, 0x40, 0xfe, 0xd8, 0x04, 0xd1, 0x13, 0xa7, 0xd5, 0xfc,
+	0xe6, 0xa7, 0x9d, 0x19, 0x8f, 0x0d, 0x7b, 0xbc, 0x32, 0x93, 0xca, 0x0c, 0x2a, 0xcd, 0x78, 0x89,
+	0x83, 0xf9, 0xc1, 0x00, 0xe7, 0xa8, 0x6c, 0x7f, 0xaa, 0x2b, 0x5b, 0x91, 0x9d, 0x90, 0xed, 0x87,
+	0x6c, 0x7f, 0x7e, 0xd0, 0xfd, 0xd5, 0x80, 0xdd, 0x23, 0x67, 0xbc, 0x67, 0x7c, 0xcc, 0x86, 0x78,
+	0x58, 0x32, 0x39, 0x21, 0x1d, 0xd8, 0x34, 0x9a, 0x17, 0x7c, 0xc4, 0xa4, 0x2a, 0xa4, 0xc8, 0x93,
+	0x4e, 0xd2, 0xcb, 0x28, 0x18, 0xcd, 0x0f, 0x1d, 0x3a, 0x11, 0xa4, 0x0b, 0x99, 0x40, 0x63, 0x97,
+	0x4a, 0xc3, 0x2b, 0x2d, 0x07, 0x6b, 0xe7, 0x11, 0x00, 0x1f, 0x31, 0xa5, 0xb0, 0x74, 0xc2, 0xaa,
+	0x17, 0xd2, 0x48, 0x4e, 0x04, 0x79, 0x02, 0x9b, 0xd3, 0x50, 0xb4, 0xb0, 0x57, 0x53, 0xcc, 0x9b,
+	0xe1, 0x0f, 0x91, 0x7d, 0xb8, 0x9a, 0x22, 0x79, 0x09, 0x3b, 0x1a, 0x39, 0xca, 0x39, 0x16, 0x06,
+	0x3f, 0xcd, 0x50, 0x71, 0xcc, 0xd7, 0x3a, 0x49, 0xaf, 0x49, 0xb7, 0x23, 0x3f, 0x8b, 0x98, 0x3c,
+	0x85, 0xcc, 0xa0, 0x12, 0x4b, 0x6f, 0xbd, 0x93, 0xf4, 0x56, 0xe9, 0xa6, 0x83, 0x0b, 0xe9, 0x7f,
+	0x58, 0xe3, 0x9a, 0x99, 0x51, 0xfe, 0x5f, 0x27, 0xe9, 0x6d, 0xd0, 0x10, 0x90, 0x87, 0x90, 0xa2,
+	0xd6, 0x95, 0x2e, 0x26, 0x66, 0x98, 0x6f, 0x74, 0x92, 0x5e, 0x4a, 0x37, 0x3c, 0x38, 0x35, 0x43,
+	0xf2, 0x18, 0x5a, 0x1a, 0x4b, 0x76, 0x85, 0xba, 0xb8, 0x40, 0xcc, 0x53, 0x9f, 0x86, 0x88, 0x8e,
+	0x11, 0xc9, 0x73, 0xd8, 0x66, 0x7c, 0x5c, 0xfc, 0x29, 0x81, 0x97, 0x32, 0xc6, 0xc7, 0x74, 0xe1,
+	0x75, 0x7f, 0x24, 0x90, 0xfb, 0x93, 0x3e, 0x51, 0x76, 0xa6, 0x55, 0xcc, 0x9c, 0x4a, 0x63, 0x50,
+	0x90, 0x7d, 0xd8, 0x9d, 0xb3, 0x52, 0x0a, 0x66, 0x2b, 0x5d, 0x30, 0x21, 0x34, 0x1a, 0xe3, 0x4f,
+	0x3d, 0xa5, 0x3b, 0x8b, 0xc4, 0x9b, 0xc0, 0xc9, 0x0b, 0xd8, 0xae, 0xab, 0xd5, 0x6a, 0xc3, 0xab,
+	0x5b, 0x11, 0xd7, 0xe2, 0x33, 0xd8, 0x92, 0xca, 0xa2, 0x9e, 0xb3, 0xb2, 0x30, 0x96, 0x69, 0xeb,
+	0x97, 0xd0, 0xa4, 0x59, 0x4d, 0xcf, 0x1c, 0x74, 0x8b, 0x58, 0x68, 0xa8, 0x84, 0x5f, 0x44, 0x93,
+	0xb6, 0x6a, 0x76, 0xa4, 0xfc, 0xae, 0x26, 0xbe, 0xd3, 0x82, 0x57, 0x33, 0x65, 0xe3, 0x12, 0x5a,
+	0x81, 0x1d, 0x3a, 0xd4, 0xfd, 0x9a, 0xc0, 0x83, 0xbb, 0xf3, 0x9d, 0x95, 0xcc, 0x8c, 0xfe, 0x75,
+	0xc0, 0xbf, 0xab, 0x35, 0xee, 0x54, 0x73, 0xa3, 0x19, 0xf7, 0xeb, 0xe2, 0x42, 0x33, 0x6e, 0x65,
+	0xa5, 0xfc, 0x68, 0x29, 0xcd, 0x3c, 0x3d, 0x8e, 0xd0, 0x2d, 0x7c, 0x5a, 0x5d, 0xa2, 0xf6, 0x33,
+	0xad, 0xd2, 0x10, 0x74, 0x2d, 0xdc, 0xf3, 0x9d, 0xbe, 0x93, 0xc6, 0x6a, 0x79, 0x3e, 0xb3, 0x48,
+	0xf1, 0x92, 0x69, 0x41, 0xf6, 0x20, 0xd5, 0xc8, 0xe5, 0x54, 0xa2, 0xb2, 0xb1, 0xbb, 0x25, 0x70,
+	0x35, 0x17, 0x41, 0xb8, 0xb2, 0xe1, 0xd8, 0xb3, 0x05, 0xf5, 0x97, 0xf6, 0x3e, 0xac, 0xb3, 0x89,
+	0xef, 0x3b, 0xb4, 0x14, 0xa3, 0xb7, 0x47, 0xdf, 0x6e, 0xda, 0xc9, 0xf5, 0x4d, 0x3b, 0xf9, 0x79,
+	0xd3, 0x4e, 0xbe, 0xdc, 0xb6, 0x57, 0xae, 0x6f, 0xdb, 0x2b, 0xdf, 0x6f, 0xdb, 0x2b, 0x1f, 0xf7,
+	0x87, 0xd2, 0x8e, 0x66, 0xe7, 0x7d, 0x5e, 0x4d, 0x06, 0xf1, 0xfd, 0x86, 0xcf, 0x2b, 0x23, 0xc6,
+	0x83, 0xcf, 0xf5, 0x63, 0x76, 0x25, 0xcd, 0xf9, 0xba, 0x7f, 0xca, 0xaf, 0x7f, 0x07, 0x00, 0x00,
+	0xff, 0xff, 0x59, 0xef, 0x67, 0x87, 0xea, 0x03, 0x00, 0x00,
 }
 
 func (m *EventPackageClaim) Marshal() (dAtA []byte, err error) {
@@ -266,6 +501,149 @@ func (m *EventPackageClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventInturnRelayerMissed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventInturnRelayerMissed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventInturnRelayerMissed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedCount != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MissedCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IntervalEnd != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.IntervalEnd))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IntervalStart != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.IntervalStart))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RelayerAddress) > 0 {
+		i -= len(m.RelayerAddress)
+		copy(dAtA[i:], m.RelayerAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.RelayerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventInturnRelayerSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventInturnRelayerSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventInturnRelayerSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SlashFraction) > 0 {
+		i -= len(m.SlashFraction)
+		copy(dAtA[i:], m.SlashFraction)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.SlashFraction)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MissedCount != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MissedCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDistributeReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDistributeReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDistributeReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RecipientType) > 0 {
+		i -= len(m.RecipientType)
+		copy(dAtA[i:], m.RecipientType)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.RecipientType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -316,16 +694,366 @@ func (m *EventPackageClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventInturnRelayerMissed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.RelayerAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.IntervalStart != 0 {
+		n += 1 + sovEvent(uint64(m.IntervalStart))
+	}
+	if m.IntervalEnd != 0 {
+		n += 1 + sovEvent(uint64(m.IntervalEnd))
+	}
+	if m.MissedCount != 0 {
+		n += 1 + sovEvent(uint64(m.MissedCount))
+	}
+	return n
+}
+
+func (m *EventInturnRelayerSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.MissedCount != 0 {
+		n += 1 + sovEvent(uint64(m.MissedCount))
+	}
+	l = len(m.SlashFraction)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovEvent(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *EventDistributeReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.RecipientType)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventPackageClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPackageClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPackageClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcChainId", wireType)
+			}
+			m.SrcChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			m.ChannelId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChannelId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackageType", wireType)
+			}
+			m.PackageType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PackageType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveSequence", wireType)
+			}
+			m.ReceiveSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiveSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendSequence", wireType)
+			}
+			m.SendSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SendSequence |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Crash", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Crash = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMsg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMsg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckRelayerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckRelayerFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventPackageClaim) Unmarshal(dAtA []byte) error {
+func (m *EventInturnRelayerMissed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -348,17 +1076,17 @@ func (m *EventPackageClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPackageClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventInturnRelayerMissed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPackageClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventInturnRelayerMissed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.SrcChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -368,16 +1096,29 @@ func (m *EventPackageClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SrcChainId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
 			}
-			m.DestChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -387,16 +1128,29 @@ func (m *EventPackageClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestChainId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalStart", wireType)
 			}
-			m.ChannelId = 0
+			m.IntervalStart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -406,16 +1160,16 @@ func (m *EventPackageClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChannelId |= uint32(b&0x7F) << shift
+				m.IntervalStart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PackageType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalEnd", wireType)
 			}
-			m.PackageType = 0
+			m.IntervalEnd = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -425,16 +1179,16 @@ func (m *EventPackageClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PackageType |= uint32(b&0x7F) << shift
+				m.IntervalEnd |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCount", wireType)
 			}
-			m.ReceiveSequence = 0
+			m.MissedCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -444,16 +1198,98 @@ func (m *EventPackageClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReceiveSequence |= uint64(b&0x7F) << shift
+				m.MissedCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventInturnRelayerSlashed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventInturnRelayerSlashed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventInturnRelayerSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedCount", wireType)
 			}
-			m.SendSequence = 0
+			m.MissedCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -463,16 +1299,48 @@ func (m *EventPackageClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SendSequence |= int64(b&0x7F) << shift
+				m.MissedCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Crash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			var v int
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -482,15 +1350,64 @@ func (m *EventPackageClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Crash = bool(v != 0)
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDistributeReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDistributeReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDistributeReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -518,11 +1435,11 @@ func (m *EventPackageClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrorMsg = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -550,11 +1467,11 @@ func (m *EventPackageClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RelayerFee = string(dAtA[iNdEx:postIndex])
+			m.RecipientType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckRelayerFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -582,7 +1499,7 @@ func (m *EventPackageClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AckRelayerFee = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11,19 +11,35 @@ type StakingKeeper interface {
 	GetLastValidators(ctx sdk.Context) (validators []types.Validator)
 	GetHistoricalInfo(ctx sdk.Context, height int64) (types.HistoricalInfo, bool)
 	BondDenom(ctx sdk.Context) (res string)
+	GetValidator(ctx sdk.Context, addr sdk.AccAddress) (validator types.Validator, found bool)
+	PowerReduction(ctx sdk.Context) (res sdk.Int)
+}
+
+type SlashingKeeper interface {
+	Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64)
+	Jail(ctx sdk.Context, consAddr sdk.ConsAddress)
 }
 
 type CrossChainKeeper interface {
-	CreateRawIBCPackageWithFee(ctx sdk.Context, channelID sdk.ChannelID,
+	CreateRawIBCPackageWithFee(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID,
 		packageType sdk.CrossChainPackageType, packageLoad []byte, relayerFee *big.Int, ackRelayerFee *big.Int,
 	) (uint64, error)
-	GetCrossChainApp(channelID sdk.ChannelID) sdk.CrossChainApplication
+	CreateRawAckPackage(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID,
+		packageType sdk.CrossChainPackageType, synSequence uint64, packageLoad []byte, relayerFee *big.Int,
+	) (uint64, error)
+	AcknowledgePackage(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID, sequence uint64) error
+	GetCrossChainApp(destChainID sdk.ChainID, channelID sdk.ChannelID) sdk.CrossChainApplication
 	GetSrcChainID() sdk.ChainID
 	IsDestChainSupported(chainID sdk.ChainID) bool
-	GetReceiveSequence(ctx sdk.Context, channelID sdk.ChannelID) uint64
-	IncrReceiveSequence(ctx sdk.Context, channelID sdk.ChannelID)
+	GetReceiveSequence(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID) uint64
+	IncrReceiveSequence(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID)
 }
 
 type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
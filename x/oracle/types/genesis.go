@@ -2,21 +2,29 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
 	params Params,
+	relayerStats []RelayerStats,
+	missedInturnIntervals []MissedInturnInterval,
 ) *GenesisState {
 	return &GenesisState{
-		Params: params,
+		Params:                params,
+		RelayerStats:          relayerStats,
+		MissedInturnIntervals: missedInturnIntervals,
 	}
 }
 
 // DefaultGenesisState - default GenesisState
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:                DefaultParams(),
+		RelayerStats:          []RelayerStats{},
+		MissedInturnIntervals: []MissedInturnInterval{},
 	}
 }
 
@@ -37,5 +45,62 @@ func ValidateGenesis(data GenesisState) error {
 	if data.Params.RelayerInterval <= 0 {
 		return fmt.Errorf("the relayer interval should be positive, is %d", data.Params.RelayerInterval)
 	}
+
+	if err := validateRelayerMissedWindow(data.Params.RelayerMissedWindow); err != nil {
+		return err
+	}
+
+	if err := validateRelayerSlashFraction(data.Params.RelayerSlashFraction); err != nil {
+		return err
+	}
+
+	if err := validateQuorumType(data.Params.QuorumType); err != nil {
+		return err
+	}
+
+	if err := validateRewardSplitType(data.Params.RewardSplitType); err != nil {
+		return err
+	}
+
+	if err := validateTreasuryShare(data.Params.TreasuryShare); err != nil {
+		return err
+	}
+
+	if err := validateTreasuryDestination(data.Params.TreasuryDestination); err != nil {
+		return err
+	}
+
+	relayers := make(map[string]bool, len(data.RelayerStats))
+	for _, stats := range data.RelayerStats {
+		relayer, err := sdk.AccAddressFromHexUnsafe(stats.Relayer)
+		if err != nil {
+			return fmt.Errorf("invalid relayer address %s in relayer stats: %w", stats.Relayer, err)
+		}
+		if relayers[relayer.String()] {
+			return fmt.Errorf("duplicate relayer stats for relayer %s", stats.Relayer)
+		}
+		relayers[relayer.String()] = true
+
+		if stats.FeesEarned.IsNil() || stats.FeesEarned.IsNegative() {
+			return fmt.Errorf("the fees earned of relayer %s should not be negative", stats.Relayer)
+		}
+	}
+
+	missedIntervals := make(map[string]bool, len(data.MissedInturnIntervals))
+	for _, interval := range data.MissedInturnIntervals {
+		validator, err := sdk.AccAddressFromHexUnsafe(interval.ValidatorAddress)
+		if err != nil {
+			return fmt.Errorf("invalid validator address %s in missed in-turn intervals: %w", interval.ValidatorAddress, err)
+		}
+		if interval.End <= interval.Start {
+			return fmt.Errorf("the end(%d) of the missed in-turn interval should be larger than the start(%d)", interval.End, interval.Start)
+		}
+
+		key := fmt.Sprintf("%s/%d", validator.String(), interval.Start)
+		if missedIntervals[key] {
+			return fmt.Errorf("duplicate missed in-turn interval %d for validator %s", interval.Start, interval.ValidatorAddress)
+		}
+		missedIntervals[key] = true
+	}
 	return nil
 }
@@ -27,6 +27,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of related to oracle module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// relayer_stats defines the claim statistics of all the relayers
+	RelayerStats []RelayerStats `protobuf:"bytes,2,rep,name=relayer_stats,json=relayerStats,proto3" json:"relayer_stats"`
+	// missed_inturn_intervals defines the in-turn relay intervals missed by the validators in the sliding window
+	MissedInturnIntervals []MissedInturnInterval `protobuf:"bytes,3,rep,name=missed_inturn_intervals,json=missedInturnIntervals,proto3" json:"missed_inturn_intervals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +73,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetRelayerStats() []RelayerStats {
+	if m != nil {
+		return m.RelayerStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissedInturnIntervals() []MissedInturnInterval {
+	if m != nil {
+		return m.MissedInturnIntervals
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.oracle.v1.GenesisState")
 }
@@ -76,20 +94,25 @@ func init() {
 func init() { proto.RegisterFile("cosmos/oracle/v1/genesis.proto", fileDescriptor_46043ca9c8436fa3) }
 
 var fileDescriptor_46043ca9c8436fa3 = []byte{
-	// 196 bytes of a gzipped FileDescriptorProto
+	// 284 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
 	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc8, 0xeb, 0x41,
 	0xe4, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44,
-	0x9d, 0x94, 0x2c, 0x86, 0x39, 0x50, 0x1d, 0x60, 0x69, 0x25, 0x37, 0x2e, 0x1e, 0x77, 0x88, 0xb9,
-	0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x66, 0x5c, 0x6c, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x12, 0x7a, 0xe8, 0xf6, 0xe8, 0x05, 0x80, 0xe5, 0x9d, 0x58,
-	0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xaa, 0x76, 0x72, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23,
-	0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6,
-	0x63, 0x39, 0x86, 0x28, 0xed, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d,
-	0xa8, 0x5b, 0x20, 0x94, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x05, 0xcc, 0x61, 0x25, 0x95, 0x05, 0xa9,
-	0xc5, 0x49, 0x6c, 0x60, 0x57, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xee, 0x58, 0x23, 0xac,
-	0xfe, 0x00, 0x00, 0x00,
+	0x9d, 0x94, 0x2c, 0x86, 0x39, 0x50, 0x1d, 0x60, 0x69, 0xa5, 0xef, 0x8c, 0x5c, 0x3c, 0xee, 0x10,
+	0x83, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0xcc, 0xb8, 0xd8, 0x0a, 0x12, 0x8b, 0x12, 0x73, 0x8b,
+	0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x24, 0xf4, 0xd0, 0x2d, 0xd2, 0x0b, 0x00, 0xcb, 0x3b,
+	0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0x55, 0x2d, 0xe4, 0xc9, 0xc5, 0x5b, 0x94, 0x9a, 0x93,
+	0x58, 0x99, 0x5a, 0x14, 0x5f, 0x5c, 0x92, 0x58, 0x52, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d,
+	0x24, 0x87, 0xa9, 0x3d, 0x08, 0xa2, 0x0c, 0x64, 0x1d, 0xcc, 0x10, 0x9e, 0x22, 0x24, 0x31, 0xa1,
+	0x14, 0x2e, 0xf1, 0xdc, 0xcc, 0xe2, 0xe2, 0xd4, 0x94, 0xf8, 0xcc, 0xbc, 0x92, 0xd2, 0xa2, 0x3c,
+	0x10, 0x95, 0x5a, 0x54, 0x96, 0x98, 0x53, 0x2c, 0xc1, 0x0c, 0x36, 0x54, 0x0d, 0xd3, 0x50, 0x5f,
+	0xb0, 0x06, 0x4f, 0xb0, 0x7a, 0x4f, 0xa8, 0x72, 0xa8, 0xe1, 0xa2, 0xb9, 0x58, 0xe4, 0x8a, 0x9d,
+	0x5c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f,
+	0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3b, 0x3d, 0xb3, 0x24,
+	0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x1a, 0x7a, 0x10, 0x4a, 0xb7, 0x38, 0x25, 0x5b,
+	0xbf, 0x02, 0x16, 0x94, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x70, 0x34, 0x06, 0x04,
+	0x00, 0x00, 0xff, 0xff, 0x1f, 0x51, 0x87, 0x44, 0xb0, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +135,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MissedInturnIntervals) > 0 {
+		for iNdEx := len(m.MissedInturnIntervals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedInturnIntervals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RelayerStats) > 0 {
+		for iNdEx := len(m.RelayerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -144,6 +195,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RelayerStats) > 0 {
+		for _, e := range m.RelayerStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedInturnIntervals) > 0 {
+		for _, e := range m.MissedInturnIntervals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -215,6 +278,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerStats = append(m.RelayerStats, RelayerStats{})
+			if err := m.RelayerStats[len(m.RelayerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedInturnIntervals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedInturnIntervals = append(m.MissedInturnIntervals, MissedInturnInterval{})
+			if err := m.MissedInturnIntervals[len(m.MissedInturnIntervals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	ModuleName   = "oracle"
@@ -10,4 +13,27 @@ const (
 	// RelayPackagesChannelId is not a communication channel actually, we just use it to record sequence.
 	RelayPackagesChannelName               = "relayPackages"
 	RelayPackagesChannelId   sdk.ChannelID = 0x00
+
+	// MaxRelayerScheduleCount is the max number of the relay intervals returned by the relayer schedule query
+	MaxRelayerScheduleCount = 1000
+)
+
+var (
+	RelayerStatsKeyPrefix         = []byte{0x01}
+	MissedInturnIntervalKeyPrefix = []byte{0x02}
 )
+
+// GetRelayerStatsKey returns the key of the claim statistics of a relayer
+func GetRelayerStatsKey(relayer sdk.AccAddress) []byte {
+	return append(RelayerStatsKeyPrefix, address.MustLengthPrefix(relayer)...)
+}
+
+// GetMissedInturnIntervalPrefix returns the key prefix of the in-turn intervals missed by a validator
+func GetMissedInturnIntervalPrefix(validator sdk.AccAddress) []byte {
+	return append(MissedInturnIntervalKeyPrefix, address.MustLengthPrefix(validator)...)
+}
+
+// GetMissedInturnIntervalKey returns the key of an in-turn interval missed by a validator
+func GetMissedInturnIntervalKey(validator sdk.AccAddress, start uint64) []byte {
+	return append(GetMissedInturnIntervalPrefix(validator), sdk.Uint64ToBigEndian(start)...)
+}
@@ -5,16 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -22,6 +29,90 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RewardSplitType defines how the reward of the relayers signed the bls message is split among them.
+type RewardSplitType int32
+
+const (
+	// EVEN splits the reward evenly.
+	RewardSplitEven RewardSplitType = 0
+	// STAKE_WEIGHTED splits the reward in proportion to the voting power of the validators.
+	RewardSplitStakeWeighted RewardSplitType = 1
+)
+
+var RewardSplitType_name = map[int32]string{
+	0: "REWARD_SPLIT_TYPE_EVEN",
+	1: "REWARD_SPLIT_TYPE_STAKE_WEIGHTED",
+}
+
+var RewardSplitType_value = map[string]int32{
+	"REWARD_SPLIT_TYPE_EVEN":           0,
+	"REWARD_SPLIT_TYPE_STAKE_WEIGHTED": 1,
+}
+
+func (x RewardSplitType) String() string {
+	return proto.EnumName(RewardSplitType_name, int32(x))
+}
+
+func (RewardSplitType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3dec273964b5043c, []int{0}
+}
+
+// TreasuryDestination defines where the treasury cut of the relayer fees goes.
+type TreasuryDestination int32
+
+const (
+	// FEE_COLLECTOR sends the cut to the fee collector, so it is distributed the same as the transaction fees.
+	TreasuryFeeCollector TreasuryDestination = 0
+	// COMMUNITY_POOL sends the cut to the community pool.
+	TreasuryCommunityPool TreasuryDestination = 1
+)
+
+var TreasuryDestination_name = map[int32]string{
+	0: "TREASURY_DESTINATION_FEE_COLLECTOR",
+	1: "TREASURY_DESTINATION_COMMUNITY_POOL",
+}
+
+var TreasuryDestination_value = map[string]int32{
+	"TREASURY_DESTINATION_FEE_COLLECTOR":  0,
+	"TREASURY_DESTINATION_COMMUNITY_POOL": 1,
+}
+
+func (x TreasuryDestination) String() string {
+	return proto.EnumName(TreasuryDestination_name, int32(x))
+}
+
+func (TreasuryDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3dec273964b5043c, []int{1}
+}
+
+// QuorumType defines how the quorum of the bls votes of a claim is counted.
+type QuorumType int32
+
+const (
+	// VALIDATOR_COUNT requires more than 2/3 of the validators to vote.
+	QuorumValidatorCount QuorumType = 0
+	// VOTING_POWER requires the validators with more than 2/3 of the total voting power to vote.
+	QuorumVotingPower QuorumType = 1
+)
+
+var QuorumType_name = map[int32]string{
+	0: "QUORUM_TYPE_VALIDATOR_COUNT",
+	1: "QUORUM_TYPE_VOTING_POWER",
+}
+
+var QuorumType_value = map[string]int32{
+	"QUORUM_TYPE_VALIDATOR_COUNT": 0,
+	"QUORUM_TYPE_VOTING_POWER":    1,
+}
+
+func (x QuorumType) String() string {
+	return proto.EnumName(QuorumType_name, int32(x))
+}
+
+func (QuorumType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3dec273964b5043c, []int{2}
+}
+
 // Params holds parameters for the oracle module.
 type Params struct {
 	// Timeout for the in turn relayer in seconds
@@ -31,6 +122,21 @@ type Params struct {
 	// Reward share for the relayer sends the claim message,
 	// the other relayers signed the bls message will share the reward evenly.
 	RelayerRewardShare uint32 `protobuf:"varint,3,opt,name=relayer_reward_share,json=relayerRewardShare,proto3" json:"relayer_reward_share,omitempty"`
+	// Length of the sliding window in seconds in which the missed in-turn relay intervals of a validator are counted
+	RelayerMissedWindow uint64 `protobuf:"varint,4,opt,name=relayer_missed_window,json=relayerMissedWindow,proto3" json:"relayer_missed_window,omitempty"`
+	// Number of missed in-turn relay intervals within the sliding window at which the validator is slashed and jailed,
+	// 0 means the validators are never punished for missing their in-turn relay intervals.
+	RelayerMissedThreshold uint64 `protobuf:"varint,5,opt,name=relayer_missed_threshold,json=relayerMissedThreshold,proto3" json:"relayer_missed_threshold,omitempty"`
+	// Fraction of the stake slashed when the validator reaches the relayer missed threshold
+	RelayerSlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=relayer_slash_fraction,json=relayerSlashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"relayer_slash_fraction"`
+	// Quorum type of the bls votes of the claims
+	QuorumType QuorumType `protobuf:"varint,7,opt,name=quorum_type,json=quorumType,proto3,enum=cosmos.oracle.v1.QuorumType" json:"quorum_type,omitempty"`
+	// How the reward of the other relayers signed the bls message is split among them
+	RewardSplitType RewardSplitType `protobuf:"varint,8,opt,name=reward_split_type,json=rewardSplitType,proto3,enum=cosmos.oracle.v1.RewardSplitType" json:"reward_split_type,omitempty"`
+	// Share of the relayer fees cut for the treasury before the fees are distributed to the relayers
+	TreasuryShare uint32 `protobuf:"varint,9,opt,name=treasury_share,json=treasuryShare,proto3" json:"treasury_share,omitempty"`
+	// Destination of the treasury cut of the relayer fees
+	TreasuryDestination TreasuryDestination `protobuf:"varint,10,opt,name=treasury_destination,json=treasuryDestination,proto3,enum=cosmos.oracle.v1.TreasuryDestination" json:"treasury_destination,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +193,48 @@ func (m *Params) GetRelayerRewardShare() uint32 {
 	return 0
 }
 
+func (m *Params) GetRelayerMissedWindow() uint64 {
+	if m != nil {
+		return m.RelayerMissedWindow
+	}
+	return 0
+}
+
+func (m *Params) GetRelayerMissedThreshold() uint64 {
+	if m != nil {
+		return m.RelayerMissedThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetQuorumType() QuorumType {
+	if m != nil {
+		return m.QuorumType
+	}
+	return QuorumValidatorCount
+}
+
+func (m *Params) GetRewardSplitType() RewardSplitType {
+	if m != nil {
+		return m.RewardSplitType
+	}
+	return RewardSplitEven
+}
+
+func (m *Params) GetTreasuryShare() uint32 {
+	if m != nil {
+		return m.TreasuryShare
+	}
+	return 0
+}
+
+func (m *Params) GetTreasuryDestination() TreasuryDestination {
+	if m != nil {
+		return m.TreasuryDestination
+	}
+	return TreasuryFeeCollector
+}
+
 // RelayInterval holds start and end(exclusive) time of in-turn relayer, [start, end)
 type RelayInterval struct {
 	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
@@ -140,31 +288,301 @@ func (m *RelayInterval) GetEnd() uint64 {
 	return 0
 }
 
+// ScheduledRelayer holds the in-turn relayer of a relay interval
+type ScheduledRelayer struct {
+	// operator address of the validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// address of the relayer of the validator
+	RelayerAddress string `protobuf:"bytes,2,opt,name=relayer_address,json=relayerAddress,proto3" json:"relayer_address,omitempty"`
+	// bls pub key of the validator in hex
+	BlsPubKey string `protobuf:"bytes,3,opt,name=bls_pub_key,json=blsPubKey,proto3" json:"bls_pub_key,omitempty"`
+	// the relay interval in which the relayer is in turn
+	RelayInterval RelayInterval `protobuf:"bytes,4,opt,name=relay_interval,json=relayInterval,proto3" json:"relay_interval"`
+}
+
+func (m *ScheduledRelayer) Reset()         { *m = ScheduledRelayer{} }
+func (m *ScheduledRelayer) String() string { return proto.CompactTextString(m) }
+func (*ScheduledRelayer) ProtoMessage()    {}
+func (*ScheduledRelayer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3dec273964b5043c, []int{2}
+}
+func (m *ScheduledRelayer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledRelayer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledRelayer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledRelayer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledRelayer.Merge(m, src)
+}
+func (m *ScheduledRelayer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledRelayer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledRelayer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledRelayer proto.InternalMessageInfo
+
+func (m *ScheduledRelayer) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ScheduledRelayer) GetRelayerAddress() string {
+	if m != nil {
+		return m.RelayerAddress
+	}
+	return ""
+}
+
+func (m *ScheduledRelayer) GetBlsPubKey() string {
+	if m != nil {
+		return m.BlsPubKey
+	}
+	return ""
+}
+
+func (m *ScheduledRelayer) GetRelayInterval() RelayInterval {
+	if m != nil {
+		return m.RelayInterval
+	}
+	return RelayInterval{}
+}
+
+// RelayerStats holds the claim statistics of a relayer
+type RelayerStats struct {
+	// address of the relayer
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// number of the claims submitted by the relayer
+	ClaimCount uint64 `protobuf:"varint,2,opt,name=claim_count,json=claimCount,proto3" json:"claim_count,omitempty"`
+	// number of the packages relayed in the claims submitted by the relayer
+	PackageCount uint64 `protobuf:"varint,3,opt,name=package_count,json=packageCount,proto3" json:"package_count,omitempty"`
+	// total relayer fees earned by the relayer, both as the claim submitter and as a signer of other claims
+	FeesEarned github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=fees_earned,json=feesEarned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"fees_earned"`
+	// block time of the last claim submitted by the relayer
+	LastClaimTime time.Time `protobuf:"bytes,5,opt,name=last_claim_time,json=lastClaimTime,proto3,stdtime" json:"last_claim_time"`
+}
+
+func (m *RelayerStats) Reset()         { *m = RelayerStats{} }
+func (m *RelayerStats) String() string { return proto.CompactTextString(m) }
+func (*RelayerStats) ProtoMessage()    {}
+func (*RelayerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3dec273964b5043c, []int{3}
+}
+func (m *RelayerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerStats.Merge(m, src)
+}
+func (m *RelayerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerStats proto.InternalMessageInfo
+
+func (m *RelayerStats) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *RelayerStats) GetClaimCount() uint64 {
+	if m != nil {
+		return m.ClaimCount
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetPackageCount() uint64 {
+	if m != nil {
+		return m.PackageCount
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetLastClaimTime() time.Time {
+	if m != nil {
+		return m.LastClaimTime
+	}
+	return time.Time{}
+}
+
+// MissedInturnInterval holds an in-turn relay interval missed by a validator, an interval is missed if the claims
+// in it are submitted by the other relayers after the in-turn relayer timed out.
+type MissedInturnInterval struct {
+	// operator address of the validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// start time of the missed interval in seconds, inclusive
+	Start uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	// end time of the missed interval in seconds, exclusive
+	End uint64 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *MissedInturnInterval) Reset()         { *m = MissedInturnInterval{} }
+func (m *MissedInturnInterval) String() string { return proto.CompactTextString(m) }
+func (*MissedInturnInterval) ProtoMessage()    {}
+func (*MissedInturnInterval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3dec273964b5043c, []int{4}
+}
+func (m *MissedInturnInterval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MissedInturnInterval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MissedInturnInterval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MissedInturnInterval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissedInturnInterval.Merge(m, src)
+}
+func (m *MissedInturnInterval) XXX_Size() int {
+	return m.Size()
+}
+func (m *MissedInturnInterval) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissedInturnInterval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissedInturnInterval proto.InternalMessageInfo
+
+func (m *MissedInturnInterval) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MissedInturnInterval) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *MissedInturnInterval) GetEnd() uint64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.oracle.v1.RewardSplitType", RewardSplitType_name, RewardSplitType_value)
+	proto.RegisterEnum("cosmos.oracle.v1.TreasuryDestination", TreasuryDestination_name, TreasuryDestination_value)
+	proto.RegisterEnum("cosmos.oracle.v1.QuorumType", QuorumType_name, QuorumType_value)
 	proto.RegisterType((*Params)(nil), "cosmos.oracle.v1.Params")
 	proto.RegisterType((*RelayInterval)(nil), "cosmos.oracle.v1.RelayInterval")
+	proto.RegisterType((*ScheduledRelayer)(nil), "cosmos.oracle.v1.ScheduledRelayer")
+	proto.RegisterType((*RelayerStats)(nil), "cosmos.oracle.v1.RelayerStats")
+	proto.RegisterType((*MissedInturnInterval)(nil), "cosmos.oracle.v1.MissedInturnInterval")
 }
 
 func init() { proto.RegisterFile("cosmos/oracle/v1/oracle.proto", fileDescriptor_3dec273964b5043c) }
 
 var fileDescriptor_3dec273964b5043c = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0x2f, 0x4a, 0x4c, 0xce, 0x49, 0xd5, 0x2f, 0x33, 0x84, 0xb2, 0xf4, 0x0a,
-	0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x04, 0x20, 0xd2, 0x7a, 0x50, 0xc1, 0x32, 0x43, 0xa5, 0x3e, 0x46,
-	0x2e, 0xb6, 0x80, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x21, 0x75, 0x2e, 0xfe, 0xa2, 0xd4, 0x9c, 0xc4,
-	0xca, 0xd4, 0xa2, 0xf8, 0x92, 0xcc, 0xdc, 0xd4, 0xfc, 0xd2, 0x12, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x96, 0x20, 0x3e, 0xa8, 0x70, 0x08, 0x44, 0x54, 0x48, 0x93, 0x4b, 0x00, 0xa6, 0x30, 0x33, 0xaf,
-	0x24, 0xb5, 0xa8, 0x2c, 0x31, 0x47, 0x82, 0x09, 0xac, 0x12, 0x66, 0x80, 0x27, 0x54, 0x58, 0xc8,
-	0x80, 0x4b, 0x04, 0xa6, 0xb4, 0x28, 0xb5, 0x3c, 0xb1, 0x28, 0x25, 0xbe, 0x38, 0x23, 0xb1, 0x28,
-	0x55, 0x82, 0x59, 0x81, 0x51, 0x83, 0x37, 0x48, 0x08, 0x2a, 0x17, 0x04, 0x96, 0x0a, 0x06, 0xc9,
-	0x28, 0x99, 0x73, 0xf1, 0x06, 0x81, 0x44, 0xe1, 0x46, 0x88, 0x70, 0xb1, 0x16, 0x97, 0x24, 0x16,
-	0xc1, 0x1c, 0x03, 0xe1, 0x08, 0x09, 0x70, 0x31, 0xa7, 0xe6, 0xa5, 0x40, 0xad, 0x05, 0x31, 0x9d,
-	0x5c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f,
-	0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3b, 0x3d, 0xb3, 0x24,
-	0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x1a, 0x3e, 0x10, 0x4a, 0xb7, 0x38, 0x25, 0x5b,
-	0xbf, 0x02, 0x16, 0x58, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x90, 0x32, 0x06, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x59, 0x7c, 0xef, 0x48, 0x4a, 0x01, 0x00, 0x00,
+	// 1058 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdf, 0x6e, 0x1b, 0xc5,
+	0x17, 0xf6, 0x26, 0x69, 0xda, 0x8c, 0xeb, 0xc4, 0x99, 0x38, 0xd5, 0xd6, 0xbf, 0xfe, 0x6c, 0xe3,
+	0xaa, 0x10, 0x8a, 0x62, 0xd3, 0xf4, 0x02, 0x90, 0x40, 0xc2, 0x7f, 0x36, 0xc5, 0xaa, 0x63, 0xbb,
+	0xeb, 0x4d, 0x42, 0x90, 0xd0, 0x68, 0xbc, 0x3b, 0xb1, 0x57, 0x59, 0xef, 0xb8, 0x33, 0xb3, 0x09,
+	0xbe, 0x45, 0x42, 0x42, 0x16, 0x17, 0x7d, 0x81, 0x5c, 0x20, 0x5e, 0xa1, 0xbc, 0x43, 0x2f, 0xab,
+	0x5e, 0x21, 0x2e, 0x0a, 0x4a, 0x1e, 0x04, 0xb4, 0xb3, 0xb3, 0xce, 0x5f, 0x51, 0x21, 0x71, 0xe5,
+	0xd9, 0xef, 0x9c, 0xef, 0x9b, 0x99, 0x73, 0xbe, 0x33, 0x06, 0xff, 0xb7, 0x29, 0x1f, 0x52, 0x5e,
+	0xa6, 0x0c, 0xdb, 0x1e, 0x29, 0x1f, 0x3e, 0x52, 0xab, 0xd2, 0x88, 0x51, 0x41, 0x61, 0x3a, 0x0a,
+	0x97, 0x14, 0x78, 0xf8, 0x28, 0x7b, 0x37, 0x42, 0x90, 0x8c, 0x97, 0x55, 0x58, 0x7e, 0x64, 0x33,
+	0x7d, 0xda, 0xa7, 0x11, 0x1e, 0xae, 0x14, 0x9a, 0xef, 0x53, 0xda, 0xf7, 0x48, 0x59, 0x7e, 0xf5,
+	0x82, 0xfd, 0xb2, 0x70, 0x87, 0x84, 0x0b, 0x3c, 0x1c, 0x45, 0x09, 0xc5, 0xbf, 0xe6, 0xc0, 0x7c,
+	0x07, 0x33, 0x3c, 0xe4, 0xf0, 0x03, 0xb0, 0xc4, 0x88, 0x87, 0xc7, 0x84, 0xa1, 0x30, 0x8b, 0x06,
+	0x42, 0xd7, 0x0a, 0xda, 0xda, 0x9c, 0xb9, 0xa8, 0x60, 0x2b, 0x42, 0xe1, 0x87, 0x20, 0x1d, 0x27,
+	0xba, 0xbe, 0x20, 0xec, 0x10, 0x7b, 0xfa, 0x8c, 0xcc, 0x8c, 0x05, 0x1a, 0x0a, 0x86, 0x1f, 0x83,
+	0x4c, 0x9c, 0xca, 0xc8, 0x11, 0x66, 0x0e, 0xe2, 0x03, 0xcc, 0x88, 0x3e, 0x5b, 0xd0, 0xd6, 0x52,
+	0x26, 0x54, 0x31, 0x53, 0x86, 0xba, 0x61, 0x04, 0x6e, 0x80, 0xd5, 0x98, 0x31, 0x74, 0x39, 0x27,
+	0x0e, 0x3a, 0x72, 0x7d, 0x87, 0x1e, 0xe9, 0x73, 0x72, 0x87, 0x15, 0x15, 0xdc, 0x92, 0xb1, 0x5d,
+	0x19, 0x82, 0x9f, 0x02, 0xfd, 0x12, 0x47, 0x0c, 0x18, 0xe1, 0x03, 0xea, 0x39, 0xfa, 0x0d, 0x49,
+	0xbb, 0x73, 0x81, 0x66, 0xc5, 0x51, 0xc8, 0x40, 0x1c, 0x41, 0xdc, 0xc3, 0x7c, 0x80, 0xf6, 0x19,
+	0xb6, 0x85, 0x4b, 0x7d, 0x7d, 0xbe, 0xa0, 0xad, 0x2d, 0x54, 0x3f, 0x7f, 0xf5, 0x36, 0x9f, 0xf8,
+	0xfd, 0x6d, 0xfe, 0xfd, 0xbe, 0x2b, 0x06, 0x41, 0xaf, 0x64, 0xd3, 0xa1, 0x2a, 0xbb, 0xfa, 0x59,
+	0xe7, 0xce, 0x41, 0x59, 0x8c, 0x47, 0x84, 0x97, 0xea, 0xc4, 0x7e, 0xf3, 0x72, 0x1d, 0xa8, 0xae,
+	0xd4, 0x89, 0x6d, 0xc6, 0x77, 0xef, 0x86, 0xd2, 0x9b, 0x4a, 0x19, 0x7e, 0x01, 0x92, 0xcf, 0x03,
+	0xca, 0x82, 0x21, 0x0a, 0x79, 0xfa, 0xcd, 0x82, 0xb6, 0xb6, 0xb8, 0x71, 0xaf, 0x74, 0xb9, 0xd9,
+	0xa5, 0x67, 0x32, 0xc9, 0x1a, 0x8f, 0x88, 0x09, 0x9e, 0x4f, 0xd7, 0x70, 0x0b, 0x2c, 0xc7, 0xa5,
+	0x1c, 0x79, 0xae, 0x88, 0x44, 0x6e, 0x49, 0x91, 0xf7, 0xae, 0x8a, 0xa8, 0xd2, 0x86, 0x99, 0x52,
+	0x69, 0x89, 0x5d, 0x04, 0xe0, 0x03, 0xb0, 0x28, 0x18, 0xc1, 0x3c, 0x60, 0x63, 0xd5, 0x9b, 0x05,
+	0xd9, 0x9b, 0x54, 0x8c, 0x46, 0x6d, 0xf9, 0x1a, 0x64, 0xa6, 0x69, 0x0e, 0xe1, 0xc2, 0xf5, 0xb1,
+	0x2c, 0x13, 0x90, 0x1b, 0x3f, 0xb8, 0xba, 0xb1, 0xa5, 0xb2, 0xeb, 0x67, 0xc9, 0xe6, 0x8a, 0xb8,
+	0x0a, 0x16, 0x3f, 0x01, 0x29, 0x33, 0x2c, 0xd3, 0xd4, 0x33, 0x19, 0x70, 0x83, 0x0b, 0xcc, 0x62,
+	0xf7, 0x45, 0x1f, 0x30, 0x0d, 0x66, 0x89, 0xef, 0x28, 0x9f, 0x85, 0xcb, 0xe2, 0x64, 0x06, 0xa4,
+	0xbb, 0xf6, 0x80, 0x38, 0x81, 0x47, 0x1c, 0x33, 0xaa, 0x34, 0x34, 0xc0, 0xf2, 0x21, 0xf6, 0x5c,
+	0x07, 0x0b, 0xca, 0x10, 0x76, 0x1c, 0x46, 0x38, 0x97, 0x42, 0x0b, 0x55, 0xfd, 0xcd, 0xcb, 0xf5,
+	0x8c, 0x3a, 0x67, 0x25, 0x8a, 0x74, 0x05, 0x73, 0xfd, 0xbe, 0x99, 0x9e, 0x52, 0x14, 0x0e, 0x2b,
+	0x67, 0xb3, 0x10, 0x8b, 0xcc, 0xbc, 0x43, 0x24, 0x9e, 0x92, 0x58, 0x22, 0x07, 0x92, 0x3d, 0x8f,
+	0xa3, 0x51, 0xd0, 0x43, 0x07, 0x64, 0x2c, 0x1d, 0xbf, 0x60, 0x2e, 0xf4, 0x3c, 0xde, 0x09, 0x7a,
+	0x4f, 0xc9, 0x18, 0x36, 0x41, 0xc4, 0x38, 0x9b, 0xa1, 0xd0, 0xe1, 0xc9, 0x8d, 0xfc, 0x75, 0x4d,
+	0x3c, 0x57, 0x9f, 0xea, 0x5c, 0xe8, 0x49, 0x33, 0xc5, 0xce, 0x83, 0xc5, 0x5f, 0x67, 0xc0, 0x6d,
+	0x55, 0x83, 0xae, 0xc0, 0x82, 0xc3, 0x0d, 0x70, 0x53, 0x1d, 0xe8, 0x9d, 0xd7, 0x8f, 0x13, 0x61,
+	0x1e, 0x24, 0x6d, 0x0f, 0xbb, 0x43, 0x64, 0xd3, 0xc0, 0x17, 0xaa, 0xd6, 0x40, 0x42, 0xb5, 0x10,
+	0x81, 0xf7, 0x41, 0x6a, 0x84, 0xed, 0x03, 0xdc, 0x27, 0x2a, 0x65, 0x56, 0xa6, 0xdc, 0x56, 0x60,
+	0x94, 0xf4, 0x2d, 0x48, 0xee, 0x13, 0xc2, 0x11, 0xc1, 0xcc, 0x27, 0x8e, 0xbc, 0xd5, 0xbf, 0x1b,
+	0xa4, 0x86, 0x2f, 0xce, 0x0d, 0x52, 0xc3, 0x17, 0x26, 0x08, 0x05, 0x0d, 0xa9, 0x07, 0x9b, 0x60,
+	0xc9, 0xc3, 0x5c, 0xa0, 0xe8, 0xa4, 0xe1, 0x4b, 0x25, 0x67, 0x3c, 0xb9, 0x91, 0x2d, 0x45, 0x8f,
+	0x5d, 0x29, 0x7e, 0xec, 0x4a, 0x56, 0xfc, 0xd8, 0x55, 0x6f, 0x85, 0xdb, 0xbf, 0xf8, 0x23, 0xaf,
+	0x99, 0xa9, 0x90, 0x5c, 0x0b, 0xb9, 0x61, 0xb4, 0xf8, 0x83, 0x06, 0x32, 0xd1, 0xa3, 0xd0, 0xf0,
+	0x45, 0xc0, 0xfc, 0xa9, 0x0b, 0xff, 0x23, 0x23, 0x4d, 0xcd, 0x3c, 0x73, 0x8d, 0x99, 0x67, 0xa7,
+	0x66, 0x7e, 0xf8, 0x93, 0x06, 0x96, 0x2e, 0xcd, 0x2a, 0x2c, 0x83, 0x3b, 0xa6, 0xb1, 0x5b, 0x31,
+	0xeb, 0xa8, 0xdb, 0x69, 0x36, 0x2c, 0x64, 0xed, 0x75, 0x0c, 0x64, 0xec, 0x18, 0xad, 0x74, 0x22,
+	0xbb, 0x32, 0x39, 0x2e, 0x9c, 0x27, 0x18, 0x87, 0xc4, 0x87, 0x55, 0x50, 0xb8, 0x4a, 0xe8, 0x5a,
+	0x95, 0xa7, 0x06, 0xda, 0x35, 0x1a, 0x4f, 0xbe, 0xb2, 0x8c, 0x7a, 0x5a, 0xcb, 0xde, 0x9b, 0x1c,
+	0x17, 0xf4, 0x73, 0xd4, 0xae, 0xc0, 0x07, 0x64, 0x97, 0xb8, 0xfd, 0x81, 0x20, 0x4e, 0x76, 0xee,
+	0xc7, 0x5f, 0x72, 0x89, 0x87, 0x3f, 0x6b, 0x60, 0xe5, 0x9a, 0x09, 0x86, 0x5f, 0x82, 0xa2, 0x65,
+	0x1a, 0x95, 0xee, 0xb6, 0xb9, 0x87, 0xea, 0x46, 0xd7, 0x6a, 0xb4, 0x2a, 0x56, 0xa3, 0xdd, 0x42,
+	0x9b, 0x86, 0x81, 0x6a, 0xed, 0x66, 0xd3, 0xa8, 0x59, 0x6d, 0x33, 0x9d, 0xc8, 0xea, 0x93, 0xe3,
+	0x42, 0x26, 0x16, 0xd8, 0x24, 0xa4, 0x46, 0x3d, 0x8f, 0xd8, 0x82, 0x32, 0x58, 0x05, 0xf7, 0xaf,
+	0x55, 0xa8, 0xb5, 0xb7, 0xb6, 0xb6, 0x5b, 0x0d, 0x6b, 0x0f, 0x75, 0xda, 0xed, 0x66, 0x5a, 0xcb,
+	0xde, 0x9d, 0x1c, 0x17, 0x56, 0x63, 0x89, 0x1a, 0x1d, 0x0e, 0x03, 0xdf, 0x15, 0xe3, 0x0e, 0xa5,
+	0x9e, 0x3a, 0xe3, 0xf7, 0x1a, 0x00, 0x67, 0x6f, 0x24, 0xfc, 0x0c, 0xfc, 0xef, 0xd9, 0x76, 0xdb,
+	0xdc, 0xde, 0x8a, 0xae, 0xbd, 0x53, 0x69, 0x36, 0xea, 0x15, 0xab, 0x6d, 0xa2, 0x5a, 0x7b, 0xbb,
+	0x65, 0xc5, 0x67, 0x8a, 0x08, 0x3b, 0x71, 0x9b, 0x22, 0xc7, 0x3e, 0x06, 0xfa, 0x05, 0x6a, 0xdb,
+	0x6a, 0xb4, 0x9e, 0xa0, 0x4e, 0x7b, 0xd7, 0x30, 0xd3, 0x5a, 0x76, 0x75, 0x72, 0x5c, 0x58, 0x56,
+	0x3c, 0x2a, 0x5c, 0xbf, 0xdf, 0xa1, 0x47, 0x84, 0x45, 0x87, 0xa8, 0x1a, 0xaf, 0x4e, 0x72, 0xda,
+	0xeb, 0x93, 0x9c, 0xf6, 0xe7, 0x49, 0x4e, 0x7b, 0x71, 0x9a, 0x4b, 0xbc, 0x3e, 0xcd, 0x25, 0x7e,
+	0x3b, 0xcd, 0x25, 0xbe, 0xf9, 0xe8, 0x1f, 0x9d, 0xfe, 0x5d, 0xfc, 0xa7, 0x2f, 0x2d, 0xdf, 0x9b,
+	0x97, 0x9e, 0x7d, 0xfc, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x74, 0x10, 0xc4, 0x28, 0x12, 0x08,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -187,6 +605,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TreasuryDestination != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TreasuryDestination))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TreasuryShare != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.TreasuryShare))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RewardSplitType != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RewardSplitType))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.QuorumType != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.QuorumType))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.RelayerSlashFraction.Size()
+		i -= size
+		if _, err := m.RelayerSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.RelayerMissedThreshold != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RelayerMissedThreshold))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RelayerMissedWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RelayerMissedWindow))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.RelayerRewardShare != 0 {
 		i = encodeVarintOracle(dAtA, i, uint64(m.RelayerRewardShare))
 		i--
@@ -238,52 +696,289 @@ func (m *RelayInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ScheduledRelayer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ScheduledRelayer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledRelayer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RelayerTimeout != 0 {
-		n += 1 + sovOracle(uint64(m.RelayerTimeout))
+	{
+		size, err := m.RelayInterval.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	if m.RelayerInterval != 0 {
-		n += 1 + sovOracle(uint64(m.RelayerInterval))
+	i--
+	dAtA[i] = 0x22
+	if len(m.BlsPubKey) > 0 {
+		i -= len(m.BlsPubKey)
+		copy(dAtA[i:], m.BlsPubKey)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.BlsPubKey)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.RelayerRewardShare != 0 {
-		n += 1 + sovOracle(uint64(m.RelayerRewardShare))
+	if len(m.RelayerAddress) > 0 {
+		i -= len(m.RelayerAddress)
+		copy(dAtA[i:], m.RelayerAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.RelayerAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RelayInterval) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RelayerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Start != 0 {
-		n += 1 + sovOracle(uint64(m.Start))
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastClaimTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastClaimTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	if m.End != 0 {
-		n += 1 + sovOracle(uint64(m.End))
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FeesEarned.Size()
+		i -= size
+		if _, err := m.FeesEarned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	i--
+	dAtA[i] = 0x22
+	if m.PackageCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PackageCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ClaimCount != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ClaimCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MissedInturnInterval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MissedInturnInterval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MissedInturnInterval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Start != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RelayerTimeout != 0 {
+		n += 1 + sovOracle(uint64(m.RelayerTimeout))
+	}
+	if m.RelayerInterval != 0 {
+		n += 1 + sovOracle(uint64(m.RelayerInterval))
+	}
+	if m.RelayerRewardShare != 0 {
+		n += 1 + sovOracle(uint64(m.RelayerRewardShare))
+	}
+	if m.RelayerMissedWindow != 0 {
+		n += 1 + sovOracle(uint64(m.RelayerMissedWindow))
+	}
+	if m.RelayerMissedThreshold != 0 {
+		n += 1 + sovOracle(uint64(m.RelayerMissedThreshold))
+	}
+	l = m.RelayerSlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.QuorumType != 0 {
+		n += 1 + sovOracle(uint64(m.QuorumType))
+	}
+	if m.RewardSplitType != 0 {
+		n += 1 + sovOracle(uint64(m.RewardSplitType))
+	}
+	if m.TreasuryShare != 0 {
+		n += 1 + sovOracle(uint64(m.TreasuryShare))
+	}
+	if m.TreasuryDestination != 0 {
+		n += 1 + sovOracle(uint64(m.TreasuryDestination))
+	}
+	return n
+}
+
+func (m *RelayInterval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovOracle(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovOracle(uint64(m.End))
+	}
+	return n
+}
+
+func (m *ScheduledRelayer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.RelayerAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.BlsPubKey)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.RelayInterval.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *RelayerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.ClaimCount != 0 {
+		n += 1 + sovOracle(uint64(m.ClaimCount))
+	}
+	if m.PackageCount != 0 {
+		n += 1 + sovOracle(uint64(m.PackageCount))
+	}
+	l = m.FeesEarned.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastClaimTime)
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *MissedInturnInterval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Start != 0 {
+		n += 1 + sovOracle(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovOracle(uint64(m.End))
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozOracle(x uint64) (n int) {
 	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -374,6 +1069,154 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerMissedWindow", wireType)
+			}
+			m.RelayerMissedWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayerMissedWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerMissedThreshold", wireType)
+			}
+			m.RelayerMissedThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayerMissedThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RelayerSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuorumType", wireType)
+			}
+			m.QuorumType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuorumType |= QuorumType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSplitType", wireType)
+			}
+			m.RewardSplitType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardSplitType |= RewardSplitType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryShare", wireType)
+			}
+			m.TreasuryShare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TreasuryShare |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryDestination", wireType)
+			}
+			m.TreasuryDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TreasuryDestination |= TreasuryDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -483,6 +1326,492 @@ func (m *RelayInterval) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScheduledRelayer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledRelayer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledRelayer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RelayInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayerStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimCount", wireType)
+			}
+			m.ClaimCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackageCount", wireType)
+			}
+			m.PackageCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PackageCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesEarned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeesEarned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastClaimTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastClaimTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MissedInturnInterval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MissedInturnInterval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MissedInturnInterval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipOracle(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
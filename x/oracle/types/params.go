@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -10,12 +11,33 @@ const (
 	DefaultRelayerTimeout     uint64 = 40  // in s
 	DefaultRelayerRewardShare uint32 = 50  // in s
 	DefaultRealyerInterval    uint64 = 600 // in s
+
+	DefaultRelayerMissedWindow    uint64 = 86400 // in s
+	DefaultRelayerMissedThreshold uint64 = 0     // validators are not punished by default
+
+	DefaultQuorumType = QuorumValidatorCount
+
+	DefaultRewardSplitType            = RewardSplitEven
+	DefaultTreasuryShare       uint32 = 0 // in percentage
+	DefaultTreasuryDestination        = TreasuryFeeCollector
 )
 
+var DefaultRelayerSlashFraction = sdk.NewDecWithPrec(1, 4) // 0.01%
+
 var (
 	KeyParamRelayerTimeout     = []byte("RelayerTimeout")
 	KeyParamRelayerRewardShare = []byte("RelayerRewardShare")
 	KeyParamRelayerInterval    = []byte("RelayerInterval")
+
+	KeyParamRelayerMissedWindow    = []byte("RelayerMissedWindow")
+	KeyParamRelayerMissedThreshold = []byte("RelayerMissedThreshold")
+	KeyParamRelayerSlashFraction   = []byte("RelayerSlashFraction")
+
+	KeyParamQuorumType = []byte("QuorumType")
+
+	KeyParamRewardSplitType     = []byte("RewardSplitType")
+	KeyParamTreasuryShare       = []byte("TreasuryShare")
+	KeyParamTreasuryDestination = []byte("TreasuryDestination")
 )
 
 func DefaultParams() Params {
@@ -23,6 +45,16 @@ func DefaultParams() Params {
 		RelayerTimeout:     DefaultRelayerTimeout,
 		RelayerRewardShare: DefaultRelayerRewardShare,
 		RelayerInterval:    DefaultRealyerInterval,
+
+		RelayerMissedWindow:    DefaultRelayerMissedWindow,
+		RelayerMissedThreshold: DefaultRelayerMissedThreshold,
+		RelayerSlashFraction:   DefaultRelayerSlashFraction,
+
+		QuorumType: DefaultQuorumType,
+
+		RewardSplitType:     DefaultRewardSplitType,
+		TreasuryShare:       DefaultTreasuryShare,
+		TreasuryDestination: DefaultTreasuryDestination,
 	}
 }
 
@@ -36,6 +68,13 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyParamRelayerTimeout, &p.RelayerTimeout, validateRelayerTimeout),
 		paramtypes.NewParamSetPair(KeyParamRelayerRewardShare, &p.RelayerRewardShare, validateRelayerRewardShare),
 		paramtypes.NewParamSetPair(KeyParamRelayerInterval, &p.RelayerInterval, validateRelayerInterval),
+		paramtypes.NewParamSetPair(KeyParamRelayerMissedWindow, &p.RelayerMissedWindow, validateRelayerMissedWindow),
+		paramtypes.NewParamSetPair(KeyParamRelayerMissedThreshold, &p.RelayerMissedThreshold, validateRelayerMissedThreshold),
+		paramtypes.NewParamSetPair(KeyParamRelayerSlashFraction, &p.RelayerSlashFraction, validateRelayerSlashFraction),
+		paramtypes.NewParamSetPair(KeyParamQuorumType, &p.QuorumType, validateQuorumType),
+		paramtypes.NewParamSetPair(KeyParamRewardSplitType, &p.RewardSplitType, validateRewardSplitType),
+		paramtypes.NewParamSetPair(KeyParamTreasuryShare, &p.TreasuryShare, validateTreasuryShare),
+		paramtypes.NewParamSetPair(KeyParamTreasuryDestination, &p.TreasuryDestination, validateTreasuryDestination),
 	}
 }
 
@@ -81,3 +120,98 @@ func validateRelayerInterval(i interface{}) error {
 
 	return nil
 }
+
+func validateRelayerMissedWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("the relayer missed window should be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateRelayerMissedThreshold(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRelayerSlashFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("the relayer slash fraction should not be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("the relayer slash fraction should not be negative: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("the relayer slash fraction should not be larger than 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateQuorumType(i interface{}) error {
+	v, ok := i.(QuorumType)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := QuorumType_name[int32(v)]; !ok {
+		return fmt.Errorf("the quorum type %d is invalid", v)
+	}
+
+	return nil
+}
+
+func validateRewardSplitType(i interface{}) error {
+	v, ok := i.(RewardSplitType)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := RewardSplitType_name[int32(v)]; !ok {
+		return fmt.Errorf("the reward split type %d is invalid", v)
+	}
+
+	return nil
+}
+
+func validateTreasuryShare(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > 100 {
+		return fmt.Errorf("the treasury share should not be larger than 100")
+	}
+
+	return nil
+}
+
+func validateTreasuryDestination(i interface{}) error {
+	v, ok := i.(TreasuryDestination)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := TreasuryDestination_name[int32(v)]; !ok {
+		return fmt.Errorf("the treasury destination %d is invalid", v)
+	}
+
+	return nil
+}
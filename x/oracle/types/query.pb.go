@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -202,42 +203,349 @@ func (m *QueryInturnRelayerResponse) GetRelayInterval() *RelayInterval {
 	return nil
 }
 
+// QueryRelayerScheduleRequest is the request type for the Query/RelayerSchedule RPC method.
+type QueryRelayerScheduleRequest struct {
+	// number of the relay intervals to return, starting from the current one.
+	// 0 means one round of all the validators.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *QueryRelayerScheduleRequest) Reset()         { *m = QueryRelayerScheduleRequest{} }
+func (m *QueryRelayerScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerScheduleRequest) ProtoMessage()    {}
+func (*QueryRelayerScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f804c4644f3aaef, []int{4}
+}
+func (m *QueryRelayerScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerScheduleRequest.Merge(m, src)
+}
+func (m *QueryRelayerScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryRelayerScheduleRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// QueryRelayerScheduleResponse is the response type for the Query/RelayerSchedule RPC method.
+type QueryRelayerScheduleResponse struct {
+	Schedule []ScheduledRelayer `protobuf:"bytes,1,rep,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *QueryRelayerScheduleResponse) Reset()         { *m = QueryRelayerScheduleResponse{} }
+func (m *QueryRelayerScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerScheduleResponse) ProtoMessage()    {}
+func (*QueryRelayerScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f804c4644f3aaef, []int{5}
+}
+func (m *QueryRelayerScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerScheduleResponse.Merge(m, src)
+}
+func (m *QueryRelayerScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryRelayerScheduleResponse) GetSchedule() []ScheduledRelayer {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+// QueryRelayerStatsRequest is the request type for the Query/RelayerStats RPC method.
+type QueryRelayerStatsRequest struct {
+	// address of the relayer
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+}
+
+func (m *QueryRelayerStatsRequest) Reset()         { *m = QueryRelayerStatsRequest{} }
+func (m *QueryRelayerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerStatsRequest) ProtoMessage()    {}
+func (*QueryRelayerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f804c4644f3aaef, []int{6}
+}
+func (m *QueryRelayerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerStatsRequest.Merge(m, src)
+}
+func (m *QueryRelayerStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerStatsRequest proto.InternalMessageInfo
+
+func (m *QueryRelayerStatsRequest) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+// QueryRelayerStatsResponse is the response type for the Query/RelayerStats RPC method.
+type QueryRelayerStatsResponse struct {
+	RelayerStats RelayerStats `protobuf:"bytes,1,opt,name=relayer_stats,json=relayerStats,proto3" json:"relayer_stats"`
+}
+
+func (m *QueryRelayerStatsResponse) Reset()         { *m = QueryRelayerStatsResponse{} }
+func (m *QueryRelayerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerStatsResponse) ProtoMessage()    {}
+func (*QueryRelayerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f804c4644f3aaef, []int{7}
+}
+func (m *QueryRelayerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerStatsResponse.Merge(m, src)
+}
+func (m *QueryRelayerStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerStatsResponse proto.InternalMessageInfo
+
+func (m *QueryRelayerStatsResponse) GetRelayerStats() RelayerStats {
+	if m != nil {
+		return m.RelayerStats
+	}
+	return RelayerStats{}
+}
+
+// QueryAllRelayerStatsRequest is the request type for the Query/AllRelayerStats RPC method.
+type QueryAllRelayerStatsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRelayerStatsRequest) Reset()         { *m = QueryAllRelayerStatsRequest{} }
+func (m *QueryAllRelayerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRelayerStatsRequest) ProtoMessage()    {}
+func (*QueryAllRelayerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f804c4644f3aaef, []int{8}
+}
+func (m *QueryAllRelayerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRelayerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRelayerStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRelayerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRelayerStatsRequest.Merge(m, src)
+}
+func (m *QueryAllRelayerStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRelayerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRelayerStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRelayerStatsRequest proto.InternalMessageInfo
+
+func (m *QueryAllRelayerStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllRelayerStatsResponse is the response type for the Query/AllRelayerStats RPC method.
+type QueryAllRelayerStatsResponse struct {
+	RelayerStats []RelayerStats `protobuf:"bytes,1,rep,name=relayer_stats,json=relayerStats,proto3" json:"relayer_stats"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRelayerStatsResponse) Reset()         { *m = QueryAllRelayerStatsResponse{} }
+func (m *QueryAllRelayerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRelayerStatsResponse) ProtoMessage()    {}
+func (*QueryAllRelayerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f804c4644f3aaef, []int{9}
+}
+func (m *QueryAllRelayerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRelayerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRelayerStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRelayerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRelayerStatsResponse.Merge(m, src)
+}
+func (m *QueryAllRelayerStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRelayerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRelayerStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRelayerStatsResponse proto.InternalMessageInfo
+
+func (m *QueryAllRelayerStatsResponse) GetRelayerStats() []RelayerStats {
+	if m != nil {
+		return m.RelayerStats
+	}
+	return nil
+}
+
+func (m *QueryAllRelayerStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.oracle.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.oracle.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryInturnRelayerRequest)(nil), "cosmos.oracle.v1.QueryInturnRelayerRequest")
 	proto.RegisterType((*QueryInturnRelayerResponse)(nil), "cosmos.oracle.v1.QueryInturnRelayerResponse")
+	proto.RegisterType((*QueryRelayerScheduleRequest)(nil), "cosmos.oracle.v1.QueryRelayerScheduleRequest")
+	proto.RegisterType((*QueryRelayerScheduleResponse)(nil), "cosmos.oracle.v1.QueryRelayerScheduleResponse")
+	proto.RegisterType((*QueryRelayerStatsRequest)(nil), "cosmos.oracle.v1.QueryRelayerStatsRequest")
+	proto.RegisterType((*QueryRelayerStatsResponse)(nil), "cosmos.oracle.v1.QueryRelayerStatsResponse")
+	proto.RegisterType((*QueryAllRelayerStatsRequest)(nil), "cosmos.oracle.v1.QueryAllRelayerStatsRequest")
+	proto.RegisterType((*QueryAllRelayerStatsResponse)(nil), "cosmos.oracle.v1.QueryAllRelayerStatsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/oracle/v1/query.proto", fileDescriptor_9f804c4644f3aaef) }
 
 var fileDescriptor_9f804c4644f3aaef = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x41, 0x6b, 0xe2, 0x40,
-	0x18, 0x4d, 0x64, 0x57, 0x70, 0xc4, 0x65, 0x99, 0xf5, 0x90, 0xcd, 0xba, 0x51, 0xc2, 0x2e, 0x08,
-	0xee, 0x66, 0xd0, 0x85, 0xfd, 0x01, 0x42, 0x0b, 0x52, 0x0a, 0x36, 0xc7, 0x5e, 0xc2, 0xc4, 0x0e,
-	0x69, 0x30, 0x66, 0xe2, 0xcc, 0xc4, 0x36, 0xd7, 0xd2, 0x1f, 0x50, 0x68, 0xa1, 0x7f, 0xc9, 0xa3,
-	0xd0, 0x4b, 0x4f, 0xa5, 0x68, 0x7f, 0x48, 0x71, 0x26, 0x42, 0x6d, 0x2c, 0xed, 0x29, 0xc9, 0xbc,
-	0xf7, 0xbd, 0xf7, 0xbe, 0x97, 0x01, 0x8d, 0x11, 0xe5, 0x13, 0xca, 0x11, 0x65, 0x78, 0x14, 0x11,
-	0x34, 0xeb, 0xa2, 0x69, 0x4a, 0x58, 0xe6, 0x24, 0x8c, 0x0a, 0x0a, 0xbf, 0x2a, 0xd4, 0x51, 0xa8,
-	0x33, 0xeb, 0x9a, 0xf5, 0x80, 0x06, 0x54, 0x82, 0x68, 0xfd, 0xa6, 0x78, 0x66, 0x23, 0xa0, 0x34,
-	0x88, 0x08, 0xc2, 0x49, 0x88, 0x70, 0x1c, 0x53, 0x81, 0x45, 0x48, 0x63, 0x9e, 0xa3, 0x3f, 0x0b,
-	0x1e, 0xb9, 0x9e, 0x84, 0xed, 0x3a, 0x80, 0x47, 0x6b, 0xcf, 0x21, 0x66, 0x78, 0xc2, 0x5d, 0x32,
-	0x4d, 0x09, 0x17, 0xf6, 0x21, 0xf8, 0xb6, 0x75, 0xca, 0x13, 0x1a, 0x73, 0x02, 0xff, 0x83, 0x72,
-	0x22, 0x4f, 0x0c, 0xbd, 0xa5, 0xb7, 0xab, 0x3d, 0xc3, 0x79, 0x1d, 0xd1, 0x51, 0x13, 0xfd, 0x4f,
-	0xf3, 0x87, 0xa6, 0xe6, 0xe6, 0x6c, 0xfb, 0x07, 0xf8, 0x2e, 0xe5, 0x06, 0xb1, 0x48, 0x59, 0xec,
-	0x92, 0x08, 0x67, 0x84, 0x6d, 0xbc, 0x2e, 0x75, 0x60, 0xee, 0x42, 0x73, 0x4f, 0x0b, 0x54, 0xfd,
-	0x88, 0x7b, 0x49, 0xea, 0x7b, 0x63, 0x92, 0x49, 0xe3, 0x8a, 0x5b, 0xf1, 0x23, 0x3e, 0x4c, 0xfd,
-	0x03, 0x92, 0xc1, 0x7d, 0xf0, 0x85, 0xad, 0x47, 0xbc, 0x30, 0x16, 0x84, 0xcd, 0x70, 0x64, 0x94,
-	0x64, 0xb6, 0x66, 0x31, 0x9b, 0x94, 0x1e, 0xe4, 0x34, 0xb7, 0xc6, 0x5e, 0x7e, 0xf6, 0x6e, 0x4b,
-	0xe0, 0xb3, 0x8c, 0x01, 0xcf, 0x40, 0x59, 0x6d, 0x01, 0x7f, 0x15, 0x35, 0x8a, 0x65, 0x99, 0xbf,
-	0xdf, 0x61, 0xa9, 0x45, 0xec, 0xd6, 0xc5, 0xdd, 0xd3, 0x75, 0xc9, 0x84, 0x06, 0x2a, 0xfc, 0x11,
-	0x55, 0x13, 0xbc, 0xd1, 0x41, 0x6d, 0xab, 0x04, 0xd8, 0x79, 0x43, 0x7a, 0x57, 0x91, 0xe6, 0x9f,
-	0x8f, 0x91, 0xf3, 0x38, 0x6d, 0x19, 0xc7, 0x86, 0xad, 0x62, 0x9c, 0x50, 0x0e, 0x78, 0x4c, 0x4d,
-	0xf4, 0xf7, 0xe6, 0x4b, 0x4b, 0x5f, 0x2c, 0x2d, 0xfd, 0x71, 0x69, 0xe9, 0x57, 0x2b, 0x4b, 0x5b,
-	0xac, 0x2c, 0xed, 0x7e, 0x65, 0x69, 0xc7, 0x9d, 0x20, 0x14, 0xa7, 0xa9, 0xef, 0x8c, 0xe8, 0x64,
-	0xa3, 0xa2, 0x1e, 0x7f, 0xf9, 0xc9, 0x18, 0x9d, 0x6f, 0x24, 0x45, 0x96, 0x10, 0xee, 0x97, 0xe5,
-	0x85, 0xfb, 0xf7, 0x1c, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x7d, 0x7d, 0x85, 0xf5, 0x02, 0x00, 0x00,
+	// 682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc7, 0xb7, 0xfc, 0xfb, 0xfd, 0x18, 0x40, 0xcc, 0xc8, 0xa1, 0x16, 0x2c, 0xeb, 0x04, 0x05,
+	0x59, 0x69, 0xb3, 0x60, 0xbc, 0x4b, 0x14, 0x43, 0x8c, 0x09, 0xd6, 0x9b, 0x97, 0xcd, 0x74, 0x77,
+	0x2c, 0x1b, 0x4a, 0xa7, 0x74, 0xa6, 0xab, 0x1b, 0xe3, 0xc5, 0xf8, 0x02, 0x4c, 0xf4, 0xa2, 0xf1,
+	0x55, 0xf8, 0x2a, 0x38, 0x92, 0x78, 0xf1, 0x64, 0x0c, 0x78, 0xf7, 0x2d, 0x98, 0x9d, 0x79, 0x8a,
+	0xbb, 0xb4, 0x75, 0x37, 0x9e, 0x60, 0xe6, 0xf9, 0xf3, 0xfd, 0xcc, 0xd3, 0xe7, 0x9b, 0x45, 0x4b,
+	0x4d, 0x2e, 0x0e, 0xb9, 0x70, 0x79, 0x42, 0x9b, 0x21, 0x73, 0x3b, 0x75, 0xf7, 0x28, 0x65, 0x49,
+	0xd7, 0x89, 0x13, 0x2e, 0x39, 0xbe, 0xac, 0xa3, 0x8e, 0x8e, 0x3a, 0x9d, 0xba, 0xb5, 0x10, 0xf0,
+	0x80, 0xab, 0xa0, 0xdb, 0xfb, 0x4f, 0xe7, 0x59, 0x4b, 0x01, 0xe7, 0x41, 0xc8, 0x5c, 0x1a, 0xb7,
+	0x5d, 0x1a, 0x45, 0x5c, 0x52, 0xd9, 0xe6, 0x91, 0x80, 0xe8, 0x3a, 0x68, 0xf8, 0x54, 0x30, 0xdd,
+	0xde, 0xed, 0xd4, 0x7d, 0x26, 0x69, 0xdd, 0x8d, 0x69, 0xd0, 0x8e, 0x54, 0x32, 0xe4, 0x5e, 0xcb,
+	0xf1, 0x80, 0xb6, 0x0a, 0x93, 0x05, 0x84, 0x9f, 0xf4, 0x1a, 0xec, 0xd1, 0x84, 0x1e, 0x0a, 0x8f,
+	0x1d, 0xa5, 0x4c, 0x48, 0xf2, 0x18, 0x5d, 0x19, 0xb8, 0x15, 0x31, 0x8f, 0x04, 0xc3, 0x77, 0xd1,
+	0x54, 0xac, 0x6e, 0x4c, 0xa3, 0x6a, 0xac, 0xcd, 0x6c, 0x9a, 0xce, 0xc5, 0xe7, 0x38, 0xba, 0x62,
+	0x7b, 0xe2, 0xf8, 0xfb, 0x72, 0xc5, 0x83, 0x6c, 0xb2, 0x88, 0xae, 0xaa, 0x76, 0xbb, 0x91, 0x4c,
+	0x93, 0xc8, 0x63, 0x21, 0xed, 0xb2, 0x24, 0xd3, 0x7a, 0x6b, 0x20, 0xab, 0x28, 0x0a, 0x9a, 0x36,
+	0x9a, 0xf1, 0x43, 0xd1, 0x88, 0x53, 0xbf, 0x71, 0xc0, 0xba, 0x4a, 0x78, 0xda, 0x9b, 0xf6, 0x43,
+	0xb1, 0x97, 0xfa, 0x8f, 0x58, 0x17, 0xef, 0xa0, 0x4b, 0x49, 0xaf, 0xa4, 0xd1, 0x8e, 0x24, 0x4b,
+	0x3a, 0x34, 0x34, 0xc7, 0x14, 0xdb, 0x72, 0x9e, 0x4d, 0xb5, 0xde, 0x85, 0x34, 0x6f, 0x2e, 0xe9,
+	0x3f, 0x92, 0x2d, 0xb4, 0xa8, 0x28, 0x40, 0xff, 0x69, 0x73, 0x9f, 0xb5, 0xd2, 0x90, 0x01, 0x25,
+	0x5e, 0x40, 0x93, 0x4d, 0x9e, 0x46, 0x52, 0x01, 0x4c, 0x78, 0xfa, 0x40, 0x5a, 0x68, 0xa9, 0xb8,
+	0x08, 0xe0, 0xef, 0xa3, 0xff, 0x05, 0xdc, 0x99, 0x46, 0x75, 0x7c, 0x6d, 0x66, 0x93, 0xe4, 0xb1,
+	0xb2, 0xaa, 0x16, 0x74, 0x81, 0xe1, 0x9d, 0x57, 0x92, 0x3b, 0xc8, 0x1c, 0x50, 0x91, 0x54, 0x66,
+	0x5f, 0x0a, 0x9b, 0xe8, 0xbf, 0x44, 0x5f, 0xc3, 0x68, 0xb2, 0x23, 0x79, 0x0e, 0x43, 0x1f, 0xac,
+	0x02, 0xb0, 0x5d, 0x34, 0x07, 0x79, 0x0d, 0xd1, 0x0b, 0xc0, 0x07, 0xb5, 0x4b, 0x86, 0x06, 0xe5,
+	0x40, 0x36, 0x9b, 0xf4, 0xdd, 0x11, 0x06, 0x83, 0xbb, 0x17, 0x86, 0x45, 0x80, 0x3b, 0x08, 0xfd,
+	0xd9, 0x49, 0x90, 0xb9, 0x99, 0xc9, 0xf4, 0x16, 0xd8, 0xd1, 0xfe, 0x80, 0x05, 0x76, 0xf6, 0x68,
+	0x90, 0x0d, 0xdd, 0xeb, 0xab, 0x24, 0x5f, 0x0c, 0x98, 0x75, 0x4e, 0xa7, 0xfc, 0x49, 0xe3, 0xff,
+	0xf6, 0x24, 0xfc, 0x70, 0x80, 0x59, 0xef, 0xd3, 0xea, 0x50, 0x66, 0xcd, 0xd1, 0x0f, 0xbd, 0xf9,
+	0x6b, 0x12, 0x4d, 0x2a, 0x68, 0xfc, 0x02, 0x4d, 0x69, 0x6b, 0xe0, 0x95, 0x3c, 0x50, 0xde, 0x81,
+	0xd6, 0x8d, 0x21, 0x59, 0x5a, 0x8c, 0x54, 0xdf, 0x7c, 0xfd, 0xf9, 0x7e, 0xcc, 0xc2, 0xa6, 0x9b,
+	0xb3, 0xb9, 0xf6, 0x1e, 0xfe, 0x60, 0xa0, 0xb9, 0x01, 0x67, 0xe1, 0x5a, 0x49, 0xeb, 0x22, 0x77,
+	0x5a, 0xb7, 0x47, 0x4b, 0x06, 0x9c, 0x35, 0x85, 0x43, 0x70, 0x35, 0x8f, 0xd3, 0x56, 0x05, 0x0d,
+	0x98, 0x33, 0xfe, 0x6c, 0xa0, 0xf9, 0x0b, 0xae, 0xc1, 0x1b, 0x25, 0x5a, 0xc5, 0x96, 0xb4, 0x9c,
+	0x51, 0xd3, 0x01, 0x6e, 0x5d, 0xc1, 0xad, 0x60, 0x92, 0x87, 0x3b, 0x5f, 0x9c, 0x0c, 0xe5, 0x93,
+	0x81, 0x66, 0xfb, 0xd7, 0x04, 0xaf, 0x0f, 0x11, 0xeb, 0x5b, 0x79, 0xab, 0x36, 0x52, 0x2e, 0x50,
+	0xd5, 0x15, 0x55, 0x0d, 0xdf, 0xfa, 0x0b, 0x55, 0xaf, 0xc0, 0x7d, 0x05, 0xc7, 0xd7, 0xf8, 0xa3,
+	0x81, 0xe6, 0x2f, 0xb8, 0xa0, 0x74, 0x76, 0xc5, 0xae, 0x2c, 0x9d, 0x5d, 0x89, 0xb9, 0xc8, 0xaa,
+	0xa2, 0xbc, 0x8e, 0x97, 0x87, 0x50, 0x6e, 0x3f, 0x38, 0x3e, 0xb5, 0x8d, 0x93, 0x53, 0xdb, 0xf8,
+	0x71, 0x6a, 0x1b, 0xef, 0xce, 0xec, 0xca, 0xc9, 0x99, 0x5d, 0xf9, 0x76, 0x66, 0x57, 0x9e, 0xd5,
+	0x82, 0xb6, 0xdc, 0x4f, 0x7d, 0xa7, 0xc9, 0x0f, 0xb3, 0x26, 0xfa, 0xcf, 0x86, 0x68, 0x1d, 0xb8,
+	0x2f, 0xb3, 0x8e, 0xb2, 0x1b, 0x33, 0xe1, 0x4f, 0xa9, 0x5f, 0xa7, 0xad, 0xdf, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0x6c, 0x65, 0x5c, 0x19, 0x4e, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -256,6 +564,12 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// InturnRelayer returns the inturn relayer bls pub key and its relay interval
 	InturnRelayer(ctx context.Context, in *QueryInturnRelayerRequest, opts ...grpc.CallOption) (*QueryInturnRelayerResponse, error)
+	// RelayerSchedule returns the in-turn relayers of the current and the upcoming relay intervals
+	RelayerSchedule(ctx context.Context, in *QueryRelayerScheduleRequest, opts ...grpc.CallOption) (*QueryRelayerScheduleResponse, error)
+	// RelayerStats returns the claim statistics of a relayer
+	RelayerStats(ctx context.Context, in *QueryRelayerStatsRequest, opts ...grpc.CallOption) (*QueryRelayerStatsResponse, error)
+	// AllRelayerStats returns the claim statistics of all the relayers
+	AllRelayerStats(ctx context.Context, in *QueryAllRelayerStatsRequest, opts ...grpc.CallOption) (*QueryAllRelayerStatsResponse, error)
 }
 
 type queryClient struct {
@@ -284,12 +598,45 @@ func (c *queryClient) InturnRelayer(ctx context.Context, in *QueryInturnRelayerR
 	return out, nil
 }
 
+func (c *queryClient) RelayerSchedule(ctx context.Context, in *QueryRelayerScheduleRequest, opts ...grpc.CallOption) (*QueryRelayerScheduleResponse, error) {
+	out := new(QueryRelayerScheduleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.oracle.v1.Query/RelayerSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RelayerStats(ctx context.Context, in *QueryRelayerStatsRequest, opts ...grpc.CallOption) (*QueryRelayerStatsResponse, error) {
+	out := new(QueryRelayerStatsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.oracle.v1.Query/RelayerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllRelayerStats(ctx context.Context, in *QueryAllRelayerStatsRequest, opts ...grpc.CallOption) (*QueryAllRelayerStatsResponse, error) {
+	out := new(QueryAllRelayerStatsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.oracle.v1.Query/AllRelayerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of cross chain parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// InturnRelayer returns the inturn relayer bls pub key and its relay interval
 	InturnRelayer(context.Context, *QueryInturnRelayerRequest) (*QueryInturnRelayerResponse, error)
+	// RelayerSchedule returns the in-turn relayers of the current and the upcoming relay intervals
+	RelayerSchedule(context.Context, *QueryRelayerScheduleRequest) (*QueryRelayerScheduleResponse, error)
+	// RelayerStats returns the claim statistics of a relayer
+	RelayerStats(context.Context, *QueryRelayerStatsRequest) (*QueryRelayerStatsResponse, error)
+	// AllRelayerStats returns the claim statistics of all the relayers
+	AllRelayerStats(context.Context, *QueryAllRelayerStatsRequest) (*QueryAllRelayerStatsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -302,6 +649,15 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) InturnRelayer(ctx context.Context, req *QueryInturnRelayerRequest) (*QueryInturnRelayerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InturnRelayer not implemented")
 }
+func (*UnimplementedQueryServer) RelayerSchedule(ctx context.Context, req *QueryRelayerScheduleRequest) (*QueryRelayerScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayerSchedule not implemented")
+}
+func (*UnimplementedQueryServer) RelayerStats(ctx context.Context, req *QueryRelayerStatsRequest) (*QueryRelayerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayerStats not implemented")
+}
+func (*UnimplementedQueryServer) AllRelayerStats(ctx context.Context, req *QueryAllRelayerStatsRequest) (*QueryAllRelayerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllRelayerStats not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -343,6 +699,60 @@ func _Query_InturnRelayer_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RelayerSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayerSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.oracle.v1.Query/RelayerSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayerSchedule(ctx, req.(*QueryRelayerScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.oracle.v1.Query/RelayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayerStats(ctx, req.(*QueryRelayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllRelayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRelayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllRelayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.oracle.v1.Query/AllRelayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllRelayerStats(ctx, req.(*QueryAllRelayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.oracle.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -355,6 +765,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InturnRelayer",
 			Handler:    _Query_InturnRelayer_Handler,
 		},
+		{
+			MethodName: "RelayerSchedule",
+			Handler:    _Query_RelayerSchedule_Handler,
+		},
+		{
+			MethodName: "RelayerStats",
+			Handler:    _Query_RelayerStats_Handler,
+		},
+		{
+			MethodName: "AllRelayerStats",
+			Handler:    _Query_AllRelayerStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/oracle/v1/query.proto",
@@ -463,88 +885,837 @@ func (m *QueryInturnRelayerResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 		{
 			size, err := m.RelayInterval.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
-				return 0, err
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlsPubKey) > 0 {
+		i -= len(m.BlsPubKey)
+		copy(dAtA[i:], m.BlsPubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlsPubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedule) > 0 {
+		for iNdEx := len(m.Schedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RelayerStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRelayerStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRelayerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRelayerStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRelayerStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRelayerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRelayerStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RelayerStats) > 0 {
+		for iNdEx := len(m.RelayerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInturnRelayerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInturnRelayerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlsPubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RelayInterval != nil {
+		l = m.RelayInterval.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryRelayerScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedule) > 0 {
+		for _, e := range m.Schedule {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRelayerStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RelayerStats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRelayerStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRelayerStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RelayerStats) > 0 {
+		for _, e := range m.RelayerStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInturnRelayerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInturnRelayerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInturnRelayerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInturnRelayerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInturnRelayerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInturnRelayerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlsPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelayInterval == nil {
+				m.RelayInterval = &RelayInterval{}
+			}
+			if err := m.RelayInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRelayerScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayerScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayerScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BlsPubKey) > 0 {
-		i -= len(m.BlsPubKey)
-		copy(dAtA[i:], m.BlsPubKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlsPubKey)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryInturnRelayerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRelayerScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayerScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayerScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = append(m.Schedule, ScheduledRelayer{})
+			if err := m.Schedule[len(m.Schedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryInturnRelayerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlsPubKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.RelayInterval != nil {
-		l = m.RelayInterval.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayerStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -567,12 +1738,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayerStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -594,7 +1797,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRelayerStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -617,15 +1820,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelayerStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelayerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -652,7 +1855,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RelayerStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -677,7 +1880,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInturnRelayerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRelayerStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -700,12 +1903,48 @@ func (m *QueryInturnRelayerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInturnRelayerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRelayerStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInturnRelayerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRelayerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -727,7 +1966,7 @@ func (m *QueryInturnRelayerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInturnRelayerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRelayerStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -750,17 +1989,17 @@ func (m *QueryInturnRelayerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInturnRelayerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRelayerStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInturnRelayerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRelayerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsPubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerStats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -770,27 +2009,29 @@ func (m *QueryInturnRelayerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlsPubKey = string(dAtA[iNdEx:postIndex])
+			m.RelayerStats = append(m.RelayerStats, RelayerStats{})
+			if err := m.RelayerStats[len(m.RelayerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -817,10 +2058,10 @@ func (m *QueryInturnRelayerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RelayInterval == nil {
-				m.RelayInterval = &RelayInterval{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.RelayInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1 +1,9 @@
 package types
+
+// Types of the recipients of the relayer fees
+const (
+	RewardRecipientRelayer       = "relayer"
+	RewardRecipientSigner        = "signer"
+	RewardRecipientFeeCollector  = "fee_collector"
+	RewardRecipientCommunityPool = "community_pool"
+)
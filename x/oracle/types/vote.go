@@ -0,0 +1,98 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/willf/bitset"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// BlsVote is the bls signature of a validator on the sign bytes of a claim, all the fields are hex encoded
+type BlsVote struct {
+	PubKey    string `json:"pub_key"`
+	Signature string `json:"signature"`
+	SignBytes string `json:"sign_bytes"`
+}
+
+// AggregatedBlsVotes is the aggregated bls signature of the votes on a claim along with the bit set of the
+// voted validators
+type AggregatedBlsVotes struct {
+	VoteAddressSet []uint64 `json:"vote_address_set"`
+	AggSignature   string   `json:"agg_signature"`
+}
+
+// AggregateBlsVotes verifies the votes on the same sign bytes and aggregates them, the vote address set is
+// computed against the given validator set, which should be the historical validator set of the claim height
+func AggregateBlsVotes(validators []stakingtypes.Validator, votes []BlsVote) (*AggregatedBlsVotes, error) {
+	if len(votes) == 0 {
+		return nil, fmt.Errorf("votes should not be empty")
+	}
+
+	signBytes, err := hex.DecodeString(votes[0].SignBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sign bytes: %w", err)
+	}
+
+	voteAddressSet := bitset.New(ValidatorBitSetLength * 64)
+	signatures := make([][]byte, 0, len(votes))
+	for _, vote := range votes {
+		if vote.SignBytes != votes[0].SignBytes {
+			return nil, fmt.Errorf("sign bytes of vote from %s is different from others", vote.PubKey)
+		}
+
+		pubKeyBytes, err := hex.DecodeString(vote.PubKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %s: %w", vote.PubKey, err)
+		}
+		pubKey, err := bls.PublicKeyFromBytes(pubKeyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key %s: %w", vote.PubKey, err)
+		}
+
+		signatureBytes, err := hex.DecodeString(vote.Signature)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature of vote from %s: %w", vote.PubKey, err)
+		}
+		signature, err := bls.SignatureFromBytes(signatureBytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature of vote from %s: %w", vote.PubKey, err)
+		}
+		if !signature.Verify(pubKey, signBytes) {
+			return nil, fmt.Errorf("failed to verify the signature of vote from %s", vote.PubKey)
+		}
+
+		index := -1
+		for i, validator := range validators {
+			if bytes.Equal(validator.BlsKey, pubKeyBytes) {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			return nil, fmt.Errorf("vote from %s is not from a validator", vote.PubKey)
+		}
+		if index >= ValidatorBitSetLength*64 {
+			return nil, fmt.Errorf("index %d of validator %s exceeds the vote address set", index, vote.PubKey)
+		}
+		if voteAddressSet.Test(uint(index)) {
+			return nil, fmt.Errorf("duplicated vote from %s", vote.PubKey)
+		}
+		voteAddressSet.Set(uint(index))
+
+		signatures = append(signatures, signatureBytes)
+	}
+
+	sigs, err := bls.MultipleSignaturesFromBytes(signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AggregatedBlsVotes{
+		VoteAddressSet: voteAddressSet.Bytes(),
+		AggSignature:   hex.EncodeToString(bls.AggregateSignatures(sigs).Marshal()),
+	}, nil
+}
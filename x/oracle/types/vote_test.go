@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/crypto/bls"
+	"github.com/stretchr/testify/require"
+	"github.com/willf/bitset"
+
+	"github.com/cosmos/cosmos-sdk/x/oracle/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestAggregateBlsVotes(t *testing.T) {
+	claim := types.NewMsgClaim("", 1, 2, 1, 1000, []byte("test payload"), nil, nil)
+	signBytes := claim.GetBlsSignBytes()
+
+	validators := make([]stakingtypes.Validator, 0, 3)
+	blsKeys := make([]bls.SecretKey, 0, 3)
+	votes := make([]types.BlsVote, 0, 3)
+	for i := 0; i < 3; i++ {
+		blsKey, err := bls.RandKey()
+		require.NoError(t, err)
+		blsKeys = append(blsKeys, blsKey)
+
+		validators = append(validators, stakingtypes.Validator{BlsKey: blsKey.PublicKey().Marshal()})
+		votes = append(votes, types.BlsVote{
+			PubKey:    hex.EncodeToString(blsKey.PublicKey().Marshal()),
+			Signature: hex.EncodeToString(blsKey.Sign(signBytes[:]).Marshal()),
+			SignBytes: hex.EncodeToString(signBytes[:]),
+		})
+	}
+
+	// votes from the first and the last validators
+	aggregated, err := types.AggregateBlsVotes(validators, []types.BlsVote{votes[2], votes[0]})
+	require.NoError(t, err)
+
+	expectedSet := bitset.New(256)
+	expectedSet.Set(0)
+	expectedSet.Set(2)
+	require.Equal(t, expectedSet.Bytes(), aggregated.VoteAddressSet)
+	aggSignature := bls.AggregateSignatures([]bls.Signature{blsKeys[0].Sign(signBytes[:]), blsKeys[2].Sign(signBytes[:])})
+	require.Equal(t, hex.EncodeToString(aggSignature.Marshal()), aggregated.AggSignature)
+
+	_, err = types.AggregateBlsVotes(validators, nil)
+	require.ErrorContains(t, err, "votes should not be empty")
+
+	_, err = types.AggregateBlsVotes(validators, []types.BlsVote{votes[0], votes[0]})
+	require.ErrorContains(t, err, "duplicated vote")
+
+	_, err = types.AggregateBlsVotes(validators[1:], []types.BlsVote{votes[0]})
+	require.ErrorContains(t, err, "is not from a validator")
+
+	invalidSignature := votes[1]
+	invalidSignature.Signature = votes[0].Signature
+	_, err = types.AggregateBlsVotes(validators, []types.BlsVote{invalidSignature})
+	require.ErrorContains(t, err, "failed to verify the signature")
+
+	otherSignBytes := votes[1]
+	otherSignBytes.SignBytes = hex.EncodeToString([]byte("other sign bytes"))
+	_, err = types.AggregateBlsVotes(validators, []types.BlsVote{votes[0], otherSignBytes})
+	require.ErrorContains(t, err, "is different from others")
+}
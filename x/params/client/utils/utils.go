@@ -33,6 +33,7 @@ type (
 		Deposit     string           `json:"deposit" yaml:"deposit"`
 		CrossChain  bool             `json:"cross_chain" yaml:"cross_chain"`
 		Addresses   Addresses        `json:"addresses" yaml:"addresses"`
+		DestChainId uint32           `json:"dest_chain_id" yaml:"dest_chain_id"`
 	}
 )
 
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	types "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+var _ sdk.CrossChainApplication = crossChainGovApp{}
+
+// crossChainGovApp applies the param change packages sent by the governance contract on the dest chain
+type crossChainGovApp struct {
+	keeper Keeper
+	// allowedParams is the whitelist of the changeable params, indexed by subspace and key
+	allowedParams map[string]map[string]bool
+}
+
+// RegisterCrossChainGovApp registers the cross chain gov app to the gov channel of the governing dest chain, only
+// the given params are allowed to be changed by the packages received from the channel. The packages of the gov
+// channel from the other dest chains are rejected, no param is allowed to be changed by them. The params module does
+// not register the app by itself, the host app must call it during app initialization after SetCrossChainKeeper and
+// the registration of the dest chain, before the cross chain keeper handles any package. No param is changeable from
+// the dest chains if it is never called.
+func (k Keeper) RegisterCrossChainGovApp(govChainID sdk.ChainID, allowedParams []types.CrossChainGovParam) error {
+	crossChainKeeper := *k.crossChainKeeper
+	if !crossChainKeeper.IsDestChainSupported(govChainID) {
+		return fmt.Errorf("gov dest chain %d is not supported", govChainID)
+	}
+
+	app := crossChainGovApp{
+		keeper:        k,
+		allowedParams: make(map[string]map[string]bool),
+	}
+	for _, param := range allowedParams {
+		if _, ok := app.allowedParams[param.Subspace]; !ok {
+			app.allowedParams[param.Subspace] = make(map[string]bool)
+		}
+		app.allowedParams[param.Subspace][param.Key] = true
+	}
+
+	// the app without allowed params handles the packages from the other dest chains
+	err := crossChainKeeper.RegisterChannel(types.CrossChainGovChannel, crosschaintypes.GovChannelId, crossChainGovApp{keeper: k})
+	if err != nil {
+		return err
+	}
+	return crossChainKeeper.RegisterDestChainChannel(govChainID, crosschaintypes.GovChannelId, app)
+}
+
+func (app crossChainGovApp) ExecuteSynPackage(ctx sdk.Context, appCtx *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	err := app.changeParam(ctx, payload)
+	if err != nil {
+		app.keeper.Logger(ctx).Error("failed to apply cross chain param change", "sequence", appCtx.Sequence, "err", err.Error())
+	}
+
+	ack := types.CrossChainParamChangeAckPackage{}
+	if err != nil {
+		ack.Codespace, ack.Code, ack.Message = sdkerrors.ABCIInfo(err, false)
+	}
+
+	ackPayload, encodeErr := rlp.EncodeToBytes(ack)
+	if encodeErr != nil {
+		return sdk.ExecuteResult{Err: encodeErr}
+	}
+
+	return sdk.ExecuteResult{
+		Err:     err,
+		Payload: ackPayload,
+	}
+}
+
+func (app crossChainGovApp) ExecuteAckPackage(ctx sdk.Context, header *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	app.keeper.Logger(ctx).Info("received cross chain gov ack package", "payload", hex.EncodeToString(payload))
+	return sdk.ExecuteResult{}
+}
+
+func (app crossChainGovApp) ExecuteFailAckPackage(ctx sdk.Context, header *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	app.keeper.Logger(ctx).Info("received cross chain gov fail ack package", "payload", hex.EncodeToString(payload))
+	return sdk.ExecuteResult{}
+}
+
+func (app crossChainGovApp) ExecuteTimeoutPackage(ctx sdk.Context, header *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	app.keeper.Logger(ctx).Error("received cross chain gov timeout package", "payload", hex.EncodeToString(payload))
+	return sdk.ExecuteResult{}
+}
+
+func (app crossChainGovApp) changeParam(ctx sdk.Context, payload []byte) error {
+	var pack types.CrossChainParamChangePackage
+	if err := rlp.DecodeBytes(payload, &pack); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidCrossChainGovPkg, "decode package error: %s", err.Error())
+	}
+
+	if !app.allowedParams[pack.Subspace][pack.Key] {
+		return sdkerrors.Wrapf(types.ErrParamNotAllowed, "subspace: %s, key: %s", pack.Subspace, pack.Key)
+	}
+
+	ss, ok := app.keeper.GetSubspace(pack.Subspace)
+	if !ok {
+		return sdkerrors.Wrap(types.ErrUnknownSubspace, pack.Subspace)
+	}
+
+	app.keeper.Logger(ctx).Info("attempt to set new parameter value from cross chain gov",
+		"subspace", pack.Subspace, "key", pack.Key, "value", string(pack.Value))
+
+	if err := ss.Update(ctx, []byte(pack.Key), pack.Value); err != nil {
+		return sdkerrors.Wrapf(types.ErrSettingParameter, "key: %s, value: %s, err: %s", pack.Key, pack.Value, err.Error())
+	}
+
+	return nil
+}
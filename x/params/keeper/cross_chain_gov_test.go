@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/bsc/rlp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	crosschaintypes "github.com/cosmos/cosmos-sdk/x/crosschain/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestCrossChainGovApp() {
+	suite.app.ParamsKeeper.SetCrossChainKeeper(suite.app.CrossChainKeeper)
+	allowedParams := []proposal.CrossChainGovParam{
+		{Subspace: stakingtypes.ModuleName, Key: string(stakingtypes.KeyMaxValidators)},
+	}
+
+	// unsupported gov dest chain
+	err := suite.app.ParamsKeeper.RegisterCrossChainGovApp(sdk.ChainID(56), allowedParams)
+	suite.Require().Error(err)
+
+	suite.Require().NoError(suite.app.CrossChainKeeper.RegisterDestChain(sdk.ChainID(56)))
+	suite.Require().NoError(suite.app.CrossChainKeeper.RegisterDestChain(sdk.ChainID(1001)))
+	err = suite.app.ParamsKeeper.RegisterCrossChainGovApp(sdk.ChainID(56), allowedParams)
+	suite.Require().NoError(err)
+
+	// the packages from the other dest chains can not change any param
+	otherApp := suite.app.CrossChainKeeper.GetCrossChainApp(sdk.ChainID(1001), crosschaintypes.GovChannelId)
+	suite.Require().NotNil(otherApp)
+	payload, err := rlp.EncodeToBytes(proposal.CrossChainParamChangePackage{
+		Subspace: stakingtypes.ModuleName,
+		Key:      string(stakingtypes.KeyMaxValidators),
+		Value:    []byte("10"),
+	})
+	suite.Require().NoError(err)
+	result := otherApp.ExecuteSynPackage(suite.ctx, &sdk.CrossChainAppContext{}, payload)
+	suite.Require().ErrorIs(result.Err, proposal.ErrParamNotAllowed)
+	suite.Require().NotEqual(uint32(10), suite.app.StakingKeeper.MaxValidators(suite.ctx))
+
+	app := suite.app.CrossChainKeeper.GetCrossChainApp(sdk.ChainID(56), crosschaintypes.GovChannelId)
+	suite.Require().NotNil(app)
+
+	execute := func(pack proposal.CrossChainParamChangePackage) (sdk.ExecuteResult, proposal.CrossChainParamChangeAckPackage) {
+		payload, err := rlp.EncodeToBytes(pack)
+		suite.Require().NoError(err)
+
+		result := app.ExecuteSynPackage(suite.ctx, &sdk.CrossChainAppContext{}, payload)
+
+		var ack proposal.CrossChainParamChangeAckPackage
+		suite.Require().NoError(rlp.DecodeBytes(result.Payload, &ack))
+		return result, ack
+	}
+
+	// invalid package
+	result = app.ExecuteSynPackage(suite.ctx, &sdk.CrossChainAppContext{}, []byte("invalid package"))
+	suite.Require().ErrorIs(result.Err, proposal.ErrInvalidCrossChainGovPkg)
+
+	// param not in the whitelist
+	result, ack := execute(proposal.CrossChainParamChangePackage{
+		Subspace: stakingtypes.ModuleName,
+		Key:      string(stakingtypes.KeyMaxEntries),
+		Value:    []byte("10"),
+	})
+	suite.Require().ErrorIs(result.Err, proposal.ErrParamNotAllowed)
+	suite.Require().Equal(proposal.ErrParamNotAllowed.ABCICode(), ack.Code)
+	suite.Require().Equal(proposal.ModuleName, ack.Codespace)
+
+	// invalid value
+	result, ack = execute(proposal.CrossChainParamChangePackage{
+		Subspace: stakingtypes.ModuleName,
+		Key:      string(stakingtypes.KeyMaxValidators),
+		Value:    []byte("invalid"),
+	})
+	suite.Require().ErrorIs(result.Err, proposal.ErrSettingParameter)
+	suite.Require().Equal(proposal.ErrSettingParameter.ABCICode(), ack.Code)
+
+	// success
+	result, ack = execute(proposal.CrossChainParamChangePackage{
+		Subspace: stakingtypes.ModuleName,
+		Key:      string(stakingtypes.KeyMaxValidators),
+		Value:    []byte("10"),
+	})
+	suite.Require().NoError(result.Err)
+	suite.Require().Equal(proposal.CrossChainParamChangeAckPackage{}, ack)
+	suite.Require().Equal(uint32(10), suite.app.StakingKeeper.MaxValidators(suite.ctx))
+}
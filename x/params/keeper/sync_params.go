@@ -20,6 +20,11 @@ func (k Keeper) SyncParams(ctx sdk.Context, p *types.ParameterChangeProposal) er
 		return err
 	}
 
+	destChainID := sdk.ChainID(p.DestChainId)
+	if !(*k.crossChainKeeper).IsDestChainSupported(destChainID) {
+		return sdkerrors.Wrapf(types.ErrInvalidDestChainId, "dest chain id(%d) is not supported", p.DestChainId)
+	}
+
 	values := make([]byte, 0)
 	addresses := make([]byte, 0)
 
@@ -57,6 +62,7 @@ func (k Keeper) SyncParams(ctx sdk.Context, p *types.ParameterChangeProposal) er
 	}
 	_, err = (*k.crossChainKeeper).CreateRawIBCPackageWithFee(
 		ctx,
+		destChainID,
 		types.SyncParamsChannelID,
 		sdk.SynCrossChainPackageType,
 		encodedPackage,
@@ -80,3 +86,8 @@ func (k Keeper) ExecuteFailAckPackage(ctx sdk.Context, header *sdk.CrossChainApp
 	k.Logger(ctx).Error("received sync params fail ack package", "payload", hex.EncodeToString(payload))
 	return sdk.ExecuteResult{}
 }
+
+func (k Keeper) ExecuteTimeoutPackage(ctx sdk.Context, header *sdk.CrossChainAppContext, payload []byte) sdk.ExecuteResult {
+	k.Logger(ctx).Error("received sync params timeout package", "payload", hex.EncodeToString(payload))
+	return sdk.ExecuteResult{}
+}
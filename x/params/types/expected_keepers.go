@@ -7,9 +7,13 @@ import (
 )
 
 type CrossChainKeeper interface {
-	CreateRawIBCPackageWithFee(ctx sdk.Context, channelID sdk.ChannelID, packageType sdk.CrossChainPackageType,
+	CreateRawIBCPackageWithFee(ctx sdk.Context, destChainID sdk.ChainID, channelID sdk.ChannelID, packageType sdk.CrossChainPackageType,
 		packageLoad []byte, relayerFee *big.Int, ackRelayerFee *big.Int,
 	) (uint64, error)
 
+	IsDestChainSupported(chainID sdk.ChainID) bool
+
 	RegisterChannel(name string, id sdk.ChannelID, app sdk.CrossChainApplication) error
+
+	RegisterDestChainChannel(destChainID sdk.ChainID, id sdk.ChannelID, app sdk.CrossChainApplication) error
 }
@@ -17,4 +17,7 @@ var (
 	ErrExceedParamsChangeLimit = sdkerrors.Register(ModuleName, 10, "exceed params change limit, limit=1")
 	ErrInvalidUpgradeProposal  = sdkerrors.Register(ModuleName, 11, "invalid sync params package")
 	ErrInvalidValue            = sdkerrors.Register(ModuleName, 12, "decode hex value failed")
+	ErrInvalidDestChainId      = sdkerrors.Register(ModuleName, 13, "dest chain id is not supported")
+	ErrInvalidCrossChainGovPkg = sdkerrors.Register(ModuleName, 14, "invalid cross chain gov package")
+	ErrParamNotAllowed         = sdkerrors.Register(ModuleName, 15, "parameter is not allowed to be changed by cross chain gov")
 )
@@ -33,6 +33,8 @@ type ParameterChangeProposal struct {
 	CrossChain bool `protobuf:"varint,4,opt,name=cross_chain,json=crossChain,proto3" json:"cross_chain,omitempty"`
 	// addresses is destination smart contract address(es), only used when it is a cross-chain proposal
 	Addresses []string `protobuf:"bytes,5,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// dest_chain_id is the destination chain id of the cross-chain proposal, only used when it is a cross-chain proposal
+	DestChainId uint32 `protobuf:"varint,6,opt,name=dest_chain_id,json=destChainId,proto3" json:"dest_chain_id,omitempty"`
 }
 
 func (m *ParameterChangeProposal) Reset()      { *m = ParameterChangeProposal{} }
@@ -138,31 +140,32 @@ func init() {
 }
 
 var fileDescriptor_53a944ecb0483e4c = []byte{
-	// 378 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0x31, 0x6e, 0xa3, 0x40,
-	0x14, 0x65, 0x16, 0x7b, 0xd7, 0x1e, 0x9a, 0xd5, 0xc8, 0xab, 0x65, 0xd1, 0x0a, 0x10, 0xc5, 0x8a,
-	0xc6, 0x20, 0xef, 0x6e, 0xe5, 0xd2, 0xbe, 0x80, 0x45, 0x13, 0x29, 0x8d, 0x35, 0xc0, 0x08, 0x23,
-	0xdb, 0x0c, 0xe2, 0x8f, 0xad, 0xf8, 0x06, 0x29, 0x53, 0xa6, 0x74, 0x99, 0x03, 0xe4, 0x10, 0x56,
-	0x2a, 0x97, 0xa9, 0xa2, 0x08, 0x5f, 0x23, 0x45, 0x04, 0x0c, 0x8e, 0x8b, 0x54, 0x33, 0xef, 0xcd,
-	0x9b, 0xff, 0xfe, 0xff, 0x0f, 0x3b, 0x11, 0x87, 0x35, 0x07, 0x3f, 0xa7, 0x05, 0x5d, 0x83, 0xbf,
-	0x1d, 0x85, 0x4c, 0xd0, 0x91, 0x84, 0x5e, 0x5e, 0x70, 0xc1, 0xc9, 0x8f, 0x46, 0xe3, 0x49, 0x52,
-	0x6a, 0x8c, 0x41, 0xc2, 0x13, 0x5e, 0x2b, 0xfc, 0xea, 0xd6, 0x88, 0x8d, 0x5f, 0x8d, 0x78, 0xde,
-	0x3c, 0xb4, 0x3f, 0x2b, 0xe0, 0xbc, 0x21, 0xfc, 0x73, 0x56, 0xd5, 0x60, 0x82, 0x15, 0xd3, 0x05,
-	0xcd, 0x12, 0x36, 0x2b, 0x78, 0xce, 0x81, 0xae, 0xc8, 0x00, 0x77, 0x45, 0x2a, 0x56, 0x4c, 0x47,
-	0x36, 0x72, 0xfb, 0x41, 0x03, 0x88, 0x8d, 0xb5, 0x98, 0x41, 0x54, 0xa4, 0xb9, 0x48, 0x79, 0xa6,
-	0x7f, 0xa9, 0xdf, 0x2e, 0x29, 0x32, 0xc1, 0xdf, 0xa2, 0xba, 0x12, 0xe8, 0xaa, 0xad, 0xba, 0xda,
-	0x5f, 0xc7, 0xfb, 0xb4, 0x5b, 0xaf, 0x36, 0x6e, 0x4c, 0x27, 0x9d, 0xc3, 0x8b, 0xa5, 0x04, 0xed,
-	0x47, 0x62, 0x61, 0x2d, 0x2a, 0x38, 0xc0, 0x3c, 0x5a, 0xd0, 0x34, 0xd3, 0x3b, 0x36, 0x72, 0x7b,
-	0x01, 0xae, 0xa9, 0x69, 0xc5, 0x90, 0xdf, 0xb8, 0x4f, 0xe3, 0xb8, 0x60, 0x00, 0x0c, 0xf4, 0xae,
-	0xad, 0xba, 0xfd, 0xe0, 0x83, 0x18, 0xff, 0xb9, 0xdd, 0x5b, 0xca, 0xfd, 0xde, 0x52, 0x9e, 0x1e,
-	0x87, 0x86, 0x34, 0x4f, 0xf8, 0xf6, 0xec, 0x3c, 0xe5, 0x99, 0x60, 0x99, 0x70, 0xae, 0xb0, 0x76,
-	0xd1, 0x04, 0x31, 0x70, 0x0f, 0x36, 0x21, 0xe4, 0x34, 0x6a, 0x87, 0x3e, 0x63, 0xf2, 0x1d, 0xab,
-	0x4b, 0xb6, 0x93, 0xf3, 0x56, 0xd7, 0x6a, 0x3f, 0x5b, 0xba, 0xda, 0x30, 0x5d, 0x6d, 0xf6, 0x53,
-	0x83, 0x71, 0xa7, 0xb2, 0x9d, 0x04, 0x0f, 0xa5, 0x89, 0x0e, 0xa5, 0x89, 0x8e, 0xa5, 0x89, 0x5e,
-	0x4b, 0x13, 0xdd, 0x9d, 0x4c, 0xe5, 0x78, 0x32, 0x95, 0xe7, 0x93, 0xa9, 0x5c, 0xff, 0x4f, 0x52,
-	0xb1, 0xd8, 0x84, 0x5e, 0xc4, 0xd7, 0x32, 0x0e, 0x79, 0x0c, 0x21, 0x5e, 0xfa, 0x37, 0x6d, 0xf2,
-	0x62, 0x97, 0x33, 0xf0, 0x73, 0x99, 0x47, 0xf8, 0xb5, 0x8e, 0xec, 0xdf, 0x7b, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x65, 0xa9, 0x4b, 0x8e, 0x20, 0x02, 0x00, 0x00,
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xb1, 0xae, 0xd3, 0x30,
+	0x14, 0x8d, 0x5f, 0xfa, 0x1e, 0xaf, 0x8e, 0x9e, 0x84, 0xac, 0x22, 0x42, 0x84, 0x92, 0x28, 0x03,
+	0xca, 0xd2, 0x44, 0x05, 0xa6, 0x8e, 0xed, 0xc4, 0x56, 0x65, 0x41, 0x62, 0xa9, 0x9c, 0xc4, 0x4a,
+	0xa3, 0xb6, 0x71, 0x94, 0xeb, 0x56, 0xf4, 0x0f, 0x18, 0x19, 0x19, 0x3b, 0xf2, 0x01, 0x7c, 0x44,
+	0xc5, 0xd4, 0x91, 0x09, 0xa1, 0xf4, 0x47, 0x50, 0x6c, 0xa7, 0x74, 0x60, 0x8a, 0xcf, 0xf1, 0x39,
+	0xbe, 0x47, 0xe7, 0x06, 0x07, 0x19, 0x87, 0x2d, 0x87, 0xb8, 0xa6, 0x0d, 0xdd, 0x42, 0xbc, 0x9f,
+	0xa4, 0x4c, 0xd0, 0x89, 0x86, 0x51, 0xdd, 0x70, 0xc1, 0xc9, 0x0b, 0xa5, 0x89, 0x34, 0xa9, 0x35,
+	0xce, 0xa8, 0xe0, 0x05, 0x97, 0x8a, 0xb8, 0x3b, 0x29, 0xb1, 0xf3, 0x4a, 0x89, 0x97, 0xea, 0xa2,
+	0x77, 0x76, 0x20, 0x38, 0xde, 0xe1, 0x97, 0x8b, 0xee, 0x0d, 0x26, 0x58, 0x33, 0x5f, 0xd1, 0xaa,
+	0x60, 0x8b, 0x86, 0xd7, 0x1c, 0xe8, 0x86, 0x8c, 0xf0, 0xbd, 0x28, 0xc5, 0x86, 0xd9, 0xc8, 0x47,
+	0xe1, 0x30, 0x51, 0x80, 0xf8, 0xd8, 0xca, 0x19, 0x64, 0x4d, 0x59, 0x8b, 0x92, 0x57, 0xf6, 0x9d,
+	0xbc, 0xbb, 0xa5, 0xc8, 0x0c, 0x3f, 0xcb, 0xe4, 0x4b, 0x60, 0x9b, 0xbe, 0x19, 0x5a, 0x6f, 0x83,
+	0xe8, 0xbf, 0x69, 0x23, 0x39, 0x58, 0x0d, 0x9d, 0x0d, 0x4e, 0xbf, 0x3d, 0x23, 0xe9, 0x8d, 0xc4,
+	0xc3, 0x56, 0xd6, 0x70, 0x80, 0x65, 0xb6, 0xa2, 0x65, 0x65, 0x0f, 0x7c, 0x14, 0x3e, 0x26, 0x58,
+	0x52, 0xf3, 0x8e, 0x21, 0xaf, 0xf1, 0x90, 0xe6, 0x79, 0xc3, 0x00, 0x18, 0xd8, 0xf7, 0xbe, 0x19,
+	0x0e, 0x93, 0x7f, 0x04, 0x09, 0xf0, 0x53, 0xce, 0x40, 0x28, 0xf7, 0xb2, 0xcc, 0xed, 0x07, 0x1f,
+	0x85, 0x4f, 0x32, 0xa6, 0x90, 0xfe, 0x0f, 0xf9, 0xf4, 0xcd, 0x97, 0xa3, 0x67, 0x7c, 0x3b, 0x7a,
+	0xc6, 0xcf, 0x1f, 0x63, 0x47, 0x07, 0x2c, 0xf8, 0xfe, 0x9a, 0x6e, 0xce, 0x2b, 0xc1, 0x2a, 0x11,
+	0x7c, 0xc4, 0xd6, 0x4d, 0x50, 0xe2, 0xe0, 0x47, 0xd8, 0xa5, 0x50, 0xd3, 0xac, 0x2f, 0xe6, 0x8a,
+	0xc9, 0x73, 0x6c, 0xae, 0xd9, 0x41, 0x77, 0xd2, 0x1d, 0xbb, 0x0e, 0xf7, 0x74, 0xb3, 0x63, 0xb6,
+	0xa9, 0x3a, 0x94, 0x60, 0x3a, 0xe8, 0xc6, 0xce, 0x92, 0xef, 0xad, 0x8b, 0x4e, 0xad, 0x8b, 0xce,
+	0xad, 0x8b, 0xfe, 0xb4, 0x2e, 0xfa, 0x7a, 0x71, 0x8d, 0xf3, 0xc5, 0x35, 0x7e, 0x5d, 0x5c, 0xe3,
+	0xd3, 0xfb, 0xa2, 0x14, 0xab, 0x5d, 0x1a, 0x65, 0x7c, 0xab, 0x57, 0xa6, 0x3f, 0x63, 0xc8, 0xd7,
+	0xf1, 0xe7, 0xfe, 0xef, 0x10, 0x87, 0x9a, 0x41, 0x5c, 0xeb, 0x9d, 0xa5, 0x0f, 0x72, 0xad, 0xef,
+	0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xb0, 0x70, 0xa8, 0x41, 0x44, 0x02, 0x00, 0x00,
 }
 
 func (this *ParameterChangeProposal) Equal(that interface{}) bool {
@@ -209,6 +212,9 @@ func (this *ParameterChangeProposal) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.DestChainId != that1.DestChainId {
+		return false
+	}
 	return true
 }
 func (this *ParamChange) Equal(that interface{}) bool {
@@ -261,6 +267,11 @@ func (m *ParameterChangeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.DestChainId != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DestChainId))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Addresses) > 0 {
 		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Addresses[iNdEx])
@@ -395,6 +406,9 @@ func (m *ParameterChangeProposal) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.DestChainId != 0 {
+		n += 1 + sovParams(uint64(m.DestChainId))
+	}
 	return n
 }
 
@@ -604,6 +618,25 @@ func (m *ParameterChangeProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestChainId", wireType)
+			}
+			m.DestChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestChainId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -25,8 +25,8 @@ func NewParameterChangeProposal(title, description string, changes []ParamChange
 }
 
 // NewCrossChainParameterChangeProposal creates a proposal for cross chain parameter change or smart contract upgrade
-func NewCrossChainParameterChangeProposal(title, description string, changes []ParamChange, addresses []string) *ParameterChangeProposal {
-	return &ParameterChangeProposal{Title: title, Description: description, Changes: changes, CrossChain: true, Addresses: addresses}
+func NewCrossChainParameterChangeProposal(title, description string, changes []ParamChange, addresses []string, destChainID uint32) *ParameterChangeProposal {
+	return &ParameterChangeProposal{Title: title, Description: description, Changes: changes, CrossChain: true, Addresses: addresses, DestChainId: destChainID}
 }
 
 // GetTitle returns the title of a parameter change proposal.
@@ -47,6 +47,9 @@ func (pcp *ParameterChangeProposal) ValidateBasic() error {
 	if err != nil {
 		return err
 	}
+	if pcp.CrossChain && pcp.DestChainId == 0 {
+		return ErrInvalidDestChainId
+	}
 	if pcp.CrossChain && len(pcp.Changes) != len(pcp.Addresses) {
 		return ErrAddressSizeNotMatch
 	}
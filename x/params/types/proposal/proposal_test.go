@@ -28,7 +28,7 @@ func TestParameterChangeProposal(t *testing.T) {
 
 func TestCrossChainParameterChangeProposal(t *testing.T) {
 	pc1 := NewParamChange("sub", "foo", "baz")
-	pcp := NewCrossChainParameterChangeProposal("test title", "test description", []ParamChange{pc1}, []string{"0x76d244CE05c3De4BbC6fDd7F56379B145709ade9"})
+	pcp := NewCrossChainParameterChangeProposal("test title", "test description", []ParamChange{pc1}, []string{"0x76d244CE05c3De4BbC6fDd7F56379B145709ade9"}, 56)
 
 	require.Equal(t, "test title", pcp.GetTitle())
 	require.Equal(t, "test description", pcp.GetDescription())
@@ -39,13 +39,17 @@ func TestCrossChainParameterChangeProposal(t *testing.T) {
 	// more than 1 parameter change is not allowed
 	pc2 := NewParamChange("sub", "bar", "cat")
 	pc3 := NewParamChange("", "bar", "cat")
-	pcp = NewCrossChainParameterChangeProposal("test title", "test description", []ParamChange{pc2, pc3}, []string{"0x76d244CE05c3De4BbC6fDd7F56379B145709ade9", "0x80C7Fa8FC825C5e622cdbcAEa0A22d188634BDd3"})
+	pcp = NewCrossChainParameterChangeProposal("test title", "test description", []ParamChange{pc2, pc3}, []string{"0x76d244CE05c3De4BbC6fDd7F56379B145709ade9", "0x80C7Fa8FC825C5e622cdbcAEa0A22d188634BDd3"}, 56)
 	require.Equal(t, pcp.ValidateBasic(), ErrExceedParamsChangeLimit)
+
+	// the dest chain id must be set
+	pcp = NewCrossChainParameterChangeProposal("test title", "test description", []ParamChange{pc1}, []string{"0x76d244CE05c3De4BbC6fDd7F56379B145709ade9"}, 0)
+	require.Equal(t, pcp.ValidateBasic(), ErrInvalidDestChainId)
 }
 
 func TestCrossChainUpgradeProposal(t *testing.T) {
 	pc1 := NewParamChange("sub", "upgrade", "0x76d244CE05c3De4BbC6fDd7F56379B145709ade9")
-	pcp := NewCrossChainParameterChangeProposal("test title", "test description", []ParamChange{pc1}, []string{"0x80C7Fa8FC825C5e622cdbcAEa0A22d188634BDd3"})
+	pcp := NewCrossChainParameterChangeProposal("test title", "test description", []ParamChange{pc1}, []string{"0x80C7Fa8FC825C5e622cdbcAEa0A22d188634BDd3"}, 56)
 
 	require.Equal(t, "test title", pcp.GetTitle())
 	require.Equal(t, "test description", pcp.GetDescription())
@@ -57,6 +61,6 @@ func TestCrossChainUpgradeProposal(t *testing.T) {
 	pc2 := NewParamChange("sub", "upgrade", "0x76d244CE05c3De4BbC6fDd7F56379B145709ade9")
 	pc3 := NewParamChange("sub", "not_upgrade", "0x2eDD53b48726a887c98aDAb97e0a8600f855570d")
 
-	pcp = NewCrossChainParameterChangeProposal("test title", "test description", []ParamChange{pc2, pc3}, []string{"0x80C7Fa8FC825C5e622cdbcAEa0A22d188634BDd3", "0xA4A2957E858529FFABBBb483D1D704378a9fca6b"})
+	pcp = NewCrossChainParameterChangeProposal("test title", "test description", []ParamChange{pc2, pc3}, []string{"0x80C7Fa8FC825C5e622cdbcAEa0A22d188634BDd3", "0xA4A2957E858529FFABBBb483D1D704378a9fca6b"}, 56)
 	require.Equal(t, pcp.ValidateBasic(), ErrInvalidUpgradeProposal)
 }
@@ -6,6 +6,8 @@ const (
 	SyncParamsChannel                 = "syncParametersChange"
 	SyncParamsChannelID sdk.ChannelID = 3
 	KeyUpgrade                        = "upgrade"
+
+	CrossChainGovChannel = "govChannel"
 )
 
 // SyncParamsPackage is the payload to be encoded for cross-chain IBC package
@@ -17,3 +19,29 @@ type SyncParamsPackage struct {
 	// Target is the smart contract address(es)
 	Target []byte
 }
+
+// CrossChainGovParam is a parameter that is allowed to be changed by the governance contract on the dest chain
+type CrossChainGovParam struct {
+	Subspace string
+	Key      string
+}
+
+// CrossChainParamChangePackage is the payload of the param change packages received from the gov channel
+type CrossChainParamChangePackage struct {
+	// Subspace is the subspace of the parameter
+	Subspace string
+	// Key is the parameter to be changed
+	Key string
+	// Value is the new parameter value in JSON format
+	Value []byte
+}
+
+// CrossChainParamChangeAckPackage is the payload of the ack package for a CrossChainParamChangePackage
+type CrossChainParamChangeAckPackage struct {
+	// Code is 0 if the parameter is changed, otherwise it is the ABCI code of the error
+	Code uint32
+	// Codespace is the codespace of the error, it is empty if the parameter is changed
+	Codespace string
+	// Message is the error message, it is empty if the parameter is changed
+	Message string
+}
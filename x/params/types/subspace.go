@@ -69,6 +69,19 @@ func (s Subspace) WithKeyTable(table KeyTable) Subspace {
 	return s
 }
 
+// WithUpdateValidator registers the update validator of a registered param, which is called by Update besides the
+// value validator. The KeyTable is shared by the copies of the Subspace, so the validator applies to all of them.
+func (s Subspace) WithUpdateValidator(key []byte, ufn UpdateValidatorFn) Subspace {
+	attr, ok := s.table.m[string(key)]
+	if !ok {
+		panic(fmt.Sprintf("parameter %s not registered", key))
+	}
+
+	attr.ufn = ufn
+	s.table.m[string(key)] = attr
+	return s
+}
+
 // Returns a KVStore identical with ctx.KVStore(s.key).Prefix()
 func (s Subspace) kvStore(ctx sdk.Context) sdk.KVStore {
 	// append here is safe, appends within a function won't cause
@@ -227,6 +240,14 @@ func (s Subspace) Update(ctx sdk.Context, key, value []byte) error {
 		return err
 	}
 
+	if attr.ufn != nil {
+		current := reflect.New(ty).Interface()
+		s.GetIfExists(ctx, key, current)
+		if err := attr.ufn(ctx, reflect.Indirect(reflect.ValueOf(current)).Interface(), destValue); err != nil {
+			return fmt.Errorf("invalid parameter update: %s", err)
+		}
+	}
+
 	s.Set(ctx, key, dest)
 	return nil
 }
@@ -178,6 +178,36 @@ func (suite *SubspaceTestSuite) TestUpdate() {
 	suite.Require().Equal(good, v)
 }
 
+func (suite *SubspaceTestSuite) TestUpdateValidator() {
+	suite.Require().Panics(func() {
+		suite.ss.WithUpdateValidator([]byte("invalid_key"), nil)
+	})
+
+	suite.ss.Set(suite.ctx, keyMaxValidators, uint16(100))
+
+	// the max validators can only be increased
+	ss := suite.ss.WithUpdateValidator(keyMaxValidators, func(_ sdk.Context, oldValue, newValue interface{}) error {
+		if newValue.(uint16) < oldValue.(uint16) {
+			return fmt.Errorf("max validators can not be decreased")
+		}
+		return nil
+	})
+
+	bz, err := suite.amino.MarshalJSON(uint16(99))
+	suite.Require().NoError(err)
+	suite.Require().Error(ss.Update(suite.ctx, keyMaxValidators, bz))
+	// the copies of the subspace share the validator
+	suite.Require().Error(suite.ss.Update(suite.ctx, keyMaxValidators, bz))
+
+	bz, err = suite.amino.MarshalJSON(uint16(101))
+	suite.Require().NoError(err)
+	suite.Require().NoError(ss.Update(suite.ctx, keyMaxValidators, bz))
+
+	var v uint16
+	ss.Get(suite.ctx, keyMaxValidators, &v)
+	suite.Require().Equal(uint16(101), v)
+}
+
 func (suite *SubspaceTestSuite) TestGetParamSet() {
 	a := params{
 		UnbondingTime: time.Hour * 48,
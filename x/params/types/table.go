@@ -6,9 +6,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// UpdateValidatorFn validates the update of a param from its current value to the new one, it is able to check the
+// new value against the chain state which ValueValidatorFn can not.
+type UpdateValidatorFn func(ctx sdk.Context, oldValue, newValue interface{}) error
+
 type attribute struct {
 	ty  reflect.Type
 	vfn ValueValidatorFn
+	ufn UpdateValidatorFn
 }
 
 // KeyTable subspaces appropriate type for each parameter key